@@ -368,6 +368,8 @@ var typeMap = map[string]types.TypeID{
 	"xs:decimal":         types.BigFloatID,
 	"geo:geojson":        types.GeoID,
 	"xs:[]float32":       types.VFloatID,
+	"xs:[]int8":          types.VInt8ID,
+	"xs:[]binary":        types.VBinaryID,
 	"http://www.w3.org/2001/XMLSchema#string":          types.StringID,
 	"http://www.w3.org/2001/XMLSchema#dateTime":        types.DateTimeID,
 	"http://www.w3.org/2001/XMLSchema#date":            types.DateTimeID,
@@ -162,6 +162,24 @@ var testNQuads = []struct {
 			ObjectValue: &api.Value{Val: &api.Value_IntVal{IntVal: 13}},
 		},
 	},
+	{
+		input: `_:alice <embedding> "[1, -2]"^^<xs:[]int8> .`,
+		nq: api.NQuad{
+			Subject:     "_:alice",
+			Predicate:   "embedding",
+			ObjectId:    "",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "[1,-2]"}},
+		},
+	},
+	{
+		input: `_:alice <embedding> "[1, 0, 0, 0, 0, 0, 0, 1]"^^<xs:[]binary> .`,
+		nq: api.NQuad{
+			Subject:     "_:alice",
+			Predicate:   "embedding",
+			ObjectId:    "",
+			ObjectValue: &api.Value{Val: &api.Value_StrVal{StrVal: "[1,0,0,0,0,0,0,1]"}},
+		},
+	},
 	{
 		input: `_:alice <secret> "password1"^^<xs:password> .`,
 		nq: api.NQuad{
@@ -284,6 +302,14 @@ var testNQuads = []struct {
 		input:       `_:alice <age> "thirteen"^^<xs:int> .`,
 		expectedErr: true,
 	},
+	{
+		input:       `_:alice <embedding> "[1, 200]"^^<xs:[]int8> .`,
+		expectedErr: true,
+	},
+	{
+		input:       `_:alice <embedding> "[1, 0, 1]"^^<xs:[]binary> .`,
+		expectedErr: true,
+	},
 	{
 		input:       `<alice> <knows> <*> .`,
 		expectedErr: true,
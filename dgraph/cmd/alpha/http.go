@@ -156,6 +156,21 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
 		return
 	}
+	isExplain, err := parseBool(r, "explain")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	isProfile, err := parseBool(r, "profile")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
+	isStream, err := parseBool(r, "stream")
+	if err != nil {
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		return
+	}
 	queryTimeout, err := parseDuration(r, "timeout")
 	if err != nil {
 		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
@@ -205,6 +220,15 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := context.WithValue(r.Context(), query.DebugKey, isDebugMode)
+	switch {
+	case isExplain:
+		ctx = context.WithValue(ctx, query.ProfileKey, query.Explain)
+	case isProfile:
+		ctx = context.WithValue(ctx, query.ProfileKey, query.Profile)
+	}
+	if asOf := r.URL.Query().Get("asOf"); asOf != "" {
+		ctx = context.WithValue(ctx, edgraph.AsOf, asOf)
+	}
 	ctx = x.AttachAccessJwt(ctx, r)
 	ctx = x.AttachRemoteIP(ctx, r)
 
@@ -244,20 +268,28 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// If rdf is set true, then response will be in rdf format.
+	// respFormat is the format of the response, json by default.
 	respFormat := r.URL.Query().Get("respFormat")
 	switch respFormat {
 	case "", "json":
 		req.RespFormat = api.Request_JSON
 	case "rdf":
 		req.RespFormat = api.Request_RDF
+	case query.RespFormatNDJSON, query.RespFormatCSV, query.RespFormatGraphSON:
+		req.RespFormat = api.Request_JSON
+		ctx = context.WithValue(ctx, edgraph.RespFormat, respFormat)
 	default:
 		x.SetStatus(w, x.ErrorInvalidRequest, fmt.Sprintf("invalid value [%v] for parameter respFormat", respFormat))
 		return
 	}
 
+	if isStream {
+		streamQuery(ctx, w, &req)
+		return
+	}
+
 	// Core processing happens here.
-	resp, err := (&edgraph.Server{}).QueryNoGrpc(ctx, &req)
+	resp, profile, err := (&edgraph.Server{}).QueryWithProfile(ctx, &req)
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
@@ -265,10 +297,22 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	// Add cost to the header.
 	w.Header().Set(x.DgraphCostHeader, fmt.Sprint(resp.Metrics.NumUids["_total"]))
 
+	// NDJSON and CSV can't hold the extensions, they are written as is for the tools reading
+	// them.
+	switch respFormat {
+	case query.RespFormatNDJSON:
+		writeRaw(w, r, "application/x-ndjson", resp.Json)
+		return
+	case query.RespFormatCSV:
+		writeRaw(w, r, "text/csv", resp.Json)
+		return
+	}
+
 	e := query.Extensions{
 		Txn:     resp.Txn,
 		Latency: resp.Latency,
 		Metrics: resp.Metrics,
+		Profile: profile,
 	}
 	js, err := json.Marshal(e)
 	if err != nil {
@@ -307,6 +351,61 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func writeRaw(w http.ResponseWriter, r *http.Request, contentType string, b []byte) {
+	w.Header().Set("Content-Type", contentType)
+	if _, err := x.WriteResponse(w, r, b); err != nil {
+		glog.Errorln("Unable to write response: ", err)
+	}
+}
+
+// streamQuery writes the response to a query as it is encoded, one JSON object per line:
+// {"data": {...}} for every chunk of root-level results, see query.StreamJson, and then
+// {"extensions": {...}}. An error after the first chunk is written as a last line with
+// the errors, as the status was already sent.
+func streamQuery(ctx context.Context, w http.ResponseWriter, req *api.Request) {
+	flusher, _ := w.(http.Flusher)
+	var started bool
+	resp, err := (&edgraph.Server{}).QueryStream(ctx, req, func(js []byte) error {
+		if !started {
+			w.Header().Set("Content-Type", "application/x-ndjson")
+			started = true
+		}
+		for _, b := range [][]byte{[]byte(`{"data":`), js, []byte("}\n")} {
+			if _, err := w.Write(b); err != nil {
+				return err
+			}
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err != nil {
+		if !started {
+			x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
+			return
+		}
+		x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		if _, err := w.Write([]byte("\n")); err != nil {
+			glog.Errorln("Unable to write response: ", err)
+		}
+		return
+	}
+
+	js, err := json.Marshal(query.Extensions{
+		Txn:     resp.Txn,
+		Latency: resp.Latency,
+		Metrics: resp.Metrics,
+	})
+	if err != nil {
+		x.SetStatusWithData(w, x.Error, err.Error())
+		return
+	}
+	if _, err := fmt.Fprintf(w, "{\"extensions\":%s}\n", js); err != nil {
+		glog.Errorln("Unable to write response: ", err)
+	}
+}
+
 func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	if commonHandler(w, r) {
 		return
@@ -433,7 +532,7 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	req.CommitNow = commitNow
 
 	ctx := x.AttachAccessJwt(context.Background(), r)
-	resp, err := (&edgraph.Server{}).QueryNoGrpc(ctx, req)
+	resp, profile, err := (&edgraph.Server{}).QueryWithProfile(ctx, req)
 	if err != nil {
 		x.SetStatusWithData(w, x.ErrorInvalidRequest, err.Error())
 		return
@@ -445,6 +544,7 @@ func mutationHandler(w http.ResponseWriter, r *http.Request) {
 	e := query.Extensions{
 		Txn:     resp.Txn,
 		Latency: resp.Latency,
+		Profile: profile,
 	}
 	sort.Strings(e.Txn.Keys)
 	sort.Strings(e.Txn.Preds)
@@ -55,7 +55,9 @@ import (
 	"github.com/dgraph-io/dgraph/v24/ee/audit"
 	"github.com/dgraph-io/dgraph/v24/ee/enc"
 	"github.com/dgraph-io/dgraph/v24/graphql/admin"
+	"github.com/dgraph-io/dgraph/v24/graphql/embedding"
 	"github.com/dgraph-io/dgraph/v24/posting"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
 	"github.com/dgraph-io/dgraph/v24/schema"
 	"github.com/dgraph-io/dgraph/v24/tok"
 	"github.com/dgraph-io/dgraph/v24/worker"
@@ -123,6 +125,8 @@ they form a Raft group and provide synchronous replication.
 	// Custom plugins.
 	flag.String("custom_tokenizers", "",
 		"Comma separated list of tokenizer plugins for custom indices.")
+	flag.String("custom_embedding_providers", "",
+		"Comma separated list of embedding provider plugins for @embedding(provider: ...).")
 
 	// By default Go GRPC traces all requests.
 	grpc.EnableTracing = false
@@ -205,6 +209,10 @@ they form a Raft group and provide synchronous replication.
 				"worker in a failed state. Use -1 to retry infinitely.").
 		Flag("txn-abort-after", "Abort any pending transactions older than this duration."+
 			" The liveness of a transaction is determined by its last mutation.").
+		Flag("history-retention",
+			"How far back in time queries can read with the as-of option. The versions of the data"+
+				" needed within this window are kept on disk, which uses more space. If set to 0,"+
+				" reading as of a past timestamp is disabled.").
 		Flag("shared-instance", "When set to true, it disables ACLs for non-galaxy users. "+
 			"It expects the access JWT to be constructed outside dgraph for non-galaxy users as "+
 			"login is denied to them. Additionally, this disables access to environment variables for minio, aws, etc.").
@@ -227,6 +235,15 @@ they form a Raft group and provide synchronous replication.
 			"The polling interval for GraphQL subscription.").
 		Flag("lambda-url",
 			"The URL of a lambda server that implements custom GraphQL Javascript resolvers.").
+		Flag("embedding-endpoints",
+			"Comma separated list of the URLs that @embedding(provider: ...) can call. An URL "+
+				"ending with / allows every URL under it. No URL can be called if not set.").
+		Flag("embedding-timeout",
+			"Maximum time taken to compute the @embedding fields of a mutation.").
+		Flag("embedding-batch-size",
+			"Maximum number of texts sent at once to an @embedding provider.").
+		Flag("embedding-max-reply-mb",
+			"Maximum size in MB of the reply of an @embedding provider called over HTTP.").
 		String())
 
 	flag.String("cdc", worker.CDCDefaults, z.NewSuperFlagHelp(worker.CDCDefaults).
@@ -283,6 +300,16 @@ func setupCustomTokenizers() {
 	}
 }
 
+func setupCustomEmbeddingProviders() {
+	customProviders := Alpha.Conf.GetString("custom_embedding_providers")
+	if customProviders == "" {
+		return
+	}
+	for _, soFile := range strings.Split(customProviders, ",") {
+		embedding.LoadCustomProvider(soFile)
+	}
+}
+
 // Parses a comma-delimited list of IP addresses, IP ranges, CIDR blocks, or hostnames
 // and returns a slice of []IPRange.
 //
@@ -465,6 +492,8 @@ func serveGRPC(l net.Listener, tlsCfg *tls.Config, closer *z.Closer) {
 
 	s := grpc.NewServer(opt...)
 	api.RegisterDgraphServer(s, &edgraph.Server{})
+	// The streamed queries aren't in the public api, they are served by an internal service.
+	pb.RegisterQueryStreamerServer(s, &edgraph.Server{})
 	hapi.RegisterHealthServer(s, health.NewServer())
 	worker.RegisterZeroProxyServer(s)
 
@@ -728,18 +757,46 @@ func run() {
 	x.WorkerConfig.EncryptionKey = keys.EncKey
 
 	setupCustomTokenizers()
+	setupCustomEmbeddingProviders()
 	x.Config.PortOffset = Alpha.Conf.GetInt("port_offset")
 	x.Config.LimitMutationsNquad = int(x.Config.Limit.GetInt64("mutations-nquad"))
 	x.Config.LimitQueryEdge = x.Config.Limit.GetUint64("query-edge")
 	x.Config.BlockClusterWideDrop = x.Config.Limit.GetBool("disallow-drop")
 	x.Config.LimitNormalizeNode = int(x.Config.Limit.GetInt64("normalize-node"))
 	x.Config.QueryTimeout = x.Config.Limit.GetDuration("query-timeout")
+	x.Config.HistoryRetention = x.Config.Limit.GetDuration("history-retention")
 	x.Config.MaxRetries = x.Config.Limit.GetInt64("max-retries")
 	x.Config.SharedInstance = x.Config.Limit.GetBool("shared-instance")
 
 	x.Config.GraphQL = z.NewSuperFlag(Alpha.Conf.GetString("graphql")).MergeAndCheckDefault(
 		worker.GraphQLDefaults)
 	x.Config.GraphQLDebug = x.Config.GraphQL.GetBool("debug")
+	var embeddingEndpoints []string
+	for _, endpoint := range strings.Split(x.Config.GraphQL.GetString("embedding-endpoints"), ",") {
+		if endpoint = strings.TrimSpace(endpoint); endpoint == "" {
+			continue
+		}
+		if u, err := url.Parse(endpoint); err != nil || !u.IsAbs() {
+			glog.Errorf("expecting --graphql embedding-endpoints to be absolute URLs, got: %s",
+				endpoint)
+			return
+		}
+		embeddingEndpoints = append(embeddingEndpoints, endpoint)
+	}
+	embeddingBatchSize := int(x.Config.GraphQL.GetInt64("embedding-batch-size"))
+	if embeddingBatchSize <= 0 {
+		glog.Errorf("expecting --graphql embedding-batch-size to be positive, got: %d",
+			embeddingBatchSize)
+		return
+	}
+	embeddingMaxReplyMB := x.Config.GraphQL.GetInt64("embedding-max-reply-mb")
+	if embeddingMaxReplyMB <= 0 {
+		glog.Errorf("expecting --graphql embedding-max-reply-mb to be positive, got: %d",
+			embeddingMaxReplyMB)
+		return
+	}
+	embedding.Configure(embeddingEndpoints, x.Config.GraphQL.GetDuration("embedding-timeout"),
+		embeddingBatchSize, embeddingMaxReplyMB<<20)
 	if x.Config.GraphQL.GetString("lambda-url") != "" {
 		graphqlLambdaUrl, err := url.Parse(x.Config.GraphQL.GetString("lambda-url"))
 		if err != nil {
@@ -19,6 +19,7 @@ package zero
 import (
 	"context"
 	"math/rand"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -50,6 +51,16 @@ type Oracle struct {
 	doneUntil   y.WaterMark
 }
 
+const (
+	// markInterval is how often the max assigned timestamp is recorded, hence the precision
+	// of timestampAt for recent times.
+	markInterval = 10 * time.Second
+	// maxMarks bounds the number of time marks kept. Once reached, every other mark of the
+	// older half is forgotten, so the marks cover all the times since the first one with a
+	// precision decreasing with their age.
+	maxMarks = 1 << 12
+)
+
 // Init initializes the oracle.
 func (o *Oracle) Init() {
 	o.commits = make(map[uint64]uint64)
@@ -500,6 +511,104 @@ func (s *Server) TryAbort(ctx context.Context,
 	return delta, nil
 }
 
+// TimestampAt returns the max timestamp assigned at the given time, see timestampAt. If
+// the time is older than the oldest one known, the timestamp at the latter is returned with
+// its time instead.
+func (s *Server) TimestampAt(ctx context.Context, in *pb.TimeTs) (*pb.TimeTs, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if !s.Node.AmLeader() {
+		return nil, errors.Errorf("Only the Zero leader knows the timestamps at a given time")
+	}
+	return s.timestampAt(time.Unix(0, in.UnixNano), time.Now())
+}
+
+// timestampAt returns the max timestamp assigned at t, from the time marks of the state.
+// As the timestamps are only marked every markInterval, it can be up to markInterval older
+// than the actual one, and more for the older times whose marks were thinned out. If t is
+// older than the first mark, the first mark is returned. Without any, that's now.
+func (s *Server) timestampAt(t, now time.Time) (*pb.TimeTs, error) {
+	s.RLock()
+	defer s.RUnlock()
+	if t.After(now) {
+		return nil, errors.Errorf("Time %s is in the future", t.Format(time.RFC3339Nano))
+	}
+	marks := s.state.GetTimeMarks()
+	nanos := t.UnixNano()
+	i := sort.Search(len(marks), func(i int) bool { return marks[i].UnixNano > nanos })
+	switch {
+	case i == len(marks) && now.Sub(t) < markInterval:
+		// No timestamp assigned since t would have been marked yet.
+		return &pb.TimeTs{UnixNano: nanos, Ts: s.orc.MaxPending()}, nil
+	case i == 0 && len(marks) == 0:
+		return &pb.TimeTs{UnixNano: now.UnixNano(), Ts: s.orc.MaxPending()}, nil
+	case i == 0:
+		return marks[0], nil
+	}
+	return &pb.TimeTs{UnixNano: nanos, Ts: marks[i-1].Ts}, nil
+}
+
+// markTimestampsPeriodically proposes the max assigned timestamp every markInterval, when
+// it has changed, for the Zeros to keep the time marks in their state. See addTimeMark.
+func (n *node) markTimestampsPeriodically(closer *z.Closer) {
+	defer closer.Done()
+	ticker := time.NewTicker(markInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			if !n.AmLeader() {
+				continue
+			}
+			mark := &pb.TimeTs{UnixNano: time.Now().UnixNano(), Ts: n.server.orc.MaxPending()}
+			if last := n.server.lastTimeMark(); last != nil && last.Ts >= mark.Ts {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(n.ctx, markInterval)
+			if err := n.proposeAndWait(ctx, &pb.ZeroProposal{TimeMark: mark}); err != nil {
+				glog.Warningf("While proposing the time mark %+v: %v", mark, err)
+			}
+			cancel()
+		case <-closer.HasBeenClosed():
+			return
+		}
+	}
+}
+
+// lastTimeMark returns the latest time mark of the state, if any.
+func (s *Server) lastTimeMark() *pb.TimeTs {
+	s.RLock()
+	defer s.RUnlock()
+	if n := len(s.state.GetTimeMarks()); n > 0 {
+		return s.state.TimeMarks[n-1]
+	}
+	return nil
+}
+
+// addTimeMark appends mark to the time marks of state, unless it doesn't come after the last
+// one. Once there are maxMarks, every other mark of the older half is forgotten.
+func addTimeMark(state *pb.MembershipState, mark *pb.TimeTs) {
+	if n := len(state.TimeMarks); n > 0 {
+		last := state.TimeMarks[n-1]
+		if mark.Ts <= last.Ts || mark.UnixNano <= last.UnixNano {
+			return
+		}
+	}
+	if len(state.TimeMarks) >= maxMarks {
+		half := len(state.TimeMarks) / 2
+		kept := state.TimeMarks[:0]
+		for i, m := range state.TimeMarks {
+			if i >= half || i%2 == 0 {
+				kept = append(kept, m)
+			}
+		}
+		state.TimeMarks = kept
+	}
+	state.TimeMarks = append(state.TimeMarks, mark)
+}
+
 // Timestamps is used to assign startTs for a new transaction
 func (s *Server) Timestamps(ctx context.Context, num *pb.Num) (*pb.AssignedIds, error) {
 	ctx, span := otrace.StartSpan(ctx, "Zero.Timestamps")
@@ -0,0 +1,91 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package zero
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+)
+
+func TestTimestampAt(t *testing.T) {
+	s := &Server{state: &pb.MembershipState{}, orc: &Oracle{}}
+	start := time.Unix(1000, 0)
+	// Without any mark, only the timestamp assigned now is known.
+	s.orc.maxAssigned = 5
+	res, err := s.timestampAt(start, start.Add(time.Minute))
+	require.NoError(t, err)
+	require.Equal(t, &pb.TimeTs{UnixNano: start.Add(time.Minute).UnixNano(), Ts: 5}, res)
+
+	mark := func(ts uint64, at time.Duration) {
+		addTimeMark(s.state, &pb.TimeTs{UnixNano: start.Add(at).UnixNano(), Ts: ts})
+	}
+	mark(10, 0)
+	mark(20, 10*time.Second)
+	// Nothing assigned since the last mark.
+	mark(20, 20*time.Second)
+	mark(30, 30*time.Second)
+	require.Len(t, s.state.TimeMarks, 3)
+
+	now := start.Add(time.Minute)
+	at := func(d time.Duration) uint64 {
+		res, err := s.timestampAt(start.Add(d), now)
+		require.NoError(t, err)
+		require.Equal(t, start.Add(d).UnixNano(), res.UnixNano)
+		return res.Ts
+	}
+	require.Equal(t, uint64(10), at(0))
+	require.Equal(t, uint64(10), at(time.Second))
+	require.Equal(t, uint64(20), at(25*time.Second))
+	require.Equal(t, uint64(30), at(40*time.Second))
+
+	// The timestamps assigned since the last mark are the ones of the oracle.
+	s.orc.maxAssigned = 35
+	require.Equal(t, uint64(35), at(time.Minute))
+
+	// Before the first mark, the first mark is returned.
+	res, err = s.timestampAt(start.Add(-time.Second), now)
+	require.NoError(t, err)
+	require.Equal(t, &pb.TimeTs{UnixNano: start.UnixNano(), Ts: 10}, res)
+	_, err = s.timestampAt(now.Add(time.Second), now)
+	require.Error(t, err)
+
+	// The marks are part of the state, but aren't sent to the Alphas.
+	require.Len(t, s.fullMembershipState().TimeMarks, 3)
+	require.Empty(t, s.membershipState().TimeMarks)
+}
+
+func TestAddTimeMarkBounded(t *testing.T) {
+	state := &pb.MembershipState{}
+	start := time.Unix(1000, 0)
+	for i := 0; i <= maxMarks; i++ {
+		addTimeMark(state, &pb.TimeTs{
+			UnixNano: start.Add(time.Duration(i) * markInterval).UnixNano(),
+			Ts:       uint64(i + 1),
+		})
+	}
+	// Every other mark of the older half is forgotten, the first one is kept to cover
+	// all the times since.
+	require.Len(t, state.TimeMarks, maxMarks-maxMarks/4+1)
+	require.Equal(t, uint64(1), state.TimeMarks[0].Ts)
+	require.Equal(t, uint64(3), state.TimeMarks[1].Ts)
+	require.Equal(t, uint64(maxMarks/2+1), state.TimeMarks[maxMarks/4].Ts)
+	require.Equal(t, uint64(maxMarks+1), state.TimeMarks[len(state.TimeMarks)-1].Ts)
+}
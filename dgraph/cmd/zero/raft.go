@@ -484,6 +484,9 @@ func (n *node) applyProposal(e raftpb.Entry) (uint64, error) {
 			glog.Errorf("While applying snapshot: %v\n", err)
 		}
 	}
+	if p.TimeMark != nil {
+		addTimeMark(state, p.TimeMark)
+	}
 	if p.DeleteNs != nil {
 		if err := n.deleteNamespace(p.DeleteNs.Namespace); err != nil {
 			glog.Errorf("While deleting namespace %+v", err)
@@ -879,7 +882,7 @@ func (n *node) calculateAndProposeSnapshot() error {
 		return nil
 	}
 	span.Annotatef(nil, "Taking snapshot at index: %d", snapshotIndex)
-	state := n.server.membershipState()
+	state := n.server.fullMembershipState()
 
 	zs := &pb.ZeroSnapshot{
 		Index:        snapshotIndex,
@@ -915,7 +918,7 @@ func (n *node) Run() {
 	// snapshot can cause select loop to block while deleting entries, so run
 	// it in goroutine
 	readStateCh := make(chan raft.ReadState, 100)
-	closer := z.NewCloser(5)
+	closer := z.NewCloser(6)
 	defer func() {
 		closer.SignalAndWait()
 		n.closer.Done()
@@ -926,6 +929,7 @@ func (n *node) Run() {
 	go n.updateEnterpriseState(closer)
 	go n.updateZeroMembershipPeriodically(closer)
 	go n.checkQuorum(closer)
+	go n.markTimestampsPeriodically(closer)
 	go n.RunReadIndexLoop(closer, readStateCh)
 	if !x.WorkerConfig.HardSync {
 		closer.AddRunning(1)
@@ -267,7 +267,21 @@ func (s *Server) MarshalMembershipState() ([]byte, error) {
 	return s.state.Marshal()
 }
 
+// membershipState returns a copy of the membership state, without the time marks that only
+// Zero needs.
 func (s *Server) membershipState() *pb.MembershipState {
+	s.RLock()
+	defer s.RUnlock()
+	if s.state == nil {
+		return nil
+	}
+	state := *s.state
+	state.TimeMarks = nil
+	return proto.Clone(&state).(*pb.MembershipState)
+}
+
+// fullMembershipState returns a copy of the whole membership state, to be snapshotted.
+func (s *Server) fullMembershipState() *pb.MembershipState {
 	s.RLock()
 	defer s.RUnlock()
 	return proto.Clone(s.state).(*pb.MembershipState)
@@ -62,6 +62,36 @@ type MathTree struct {
 	Const types.Val // This will always be parsed as a float value
 	Val   map[uint64]types.Val
 	Child []*MathTree
+
+	// nargs is the number of arguments the function was called with, for the value
+	// functions which take a variable number of them.
+	nargs int
+}
+
+// valueFuncArity maps the math functions over strings and datetimes to their min and max
+// number of arguments. A max of -1 means that there is no max.
+var valueFuncArity = map[string][2]int{
+	"concat":       {2, -1},
+	"lower":        {1, 1},
+	"upper":        {1, 1},
+	"len":          {1, 1},
+	"substr":       {2, 3},
+	"date_trunc":   {2, 2},
+	"date_diff":    {3, 3},
+	"add_duration": {2, 2},
+	"year":         {1, 1},
+	"month":        {1, 1},
+	"day":          {1, 1},
+	"hour":         {1, 1},
+	"minute":       {1, 1},
+	"second":       {1, 1},
+}
+
+// isValueFunc returns true for the math functions over strings and datetimes. Their names
+// are only reserved when they are called, not to break the variables with the same name.
+func isValueFunc(f string) bool {
+	_, ok := valueFuncArity[f]
+	return ok
 }
 
 func isUnary(f string) bool {
@@ -116,6 +146,19 @@ func evalMathStack(opStack, valueStack *mathTreeStack) error {
 		return errors.Errorf("Invalid Math expression")
 	}
 	switch {
+	case isValueFunc(topOp.Fn):
+		arity := valueFuncArity[topOp.Fn]
+		if topOp.nargs < arity[0] || (arity[1] >= 0 && topOp.nargs > arity[1]) {
+			return errors.Errorf("Invalid number of arguments for %v: %d", topOp.Fn, topOp.nargs)
+		}
+		if valueStack.size() < topOp.nargs {
+			return errors.Errorf("Invalid math statement. Expected %d operands", topOp.nargs)
+		}
+		topOp.Child = make([]*MathTree, topOp.nargs)
+		for i := topOp.nargs - 1; i >= 0; i-- {
+			topOp.Child[i] = valueStack.popAssert()
+		}
+
 	case isUnary(topOp.Fn):
 		// Since "not" is a unary operator, just pop one value.
 		topVal, err := valueStack.pop()
@@ -167,6 +210,12 @@ func isMathFunc(f string) bool {
 		f == "since" || f == "dot"
 }
 
+// isCall tells whether the current item is followed by a (, as in a function call.
+func isCall(it *lex.ItemIterator) bool {
+	peekIt, err := it.Peek(1)
+	return err == nil && peekIt[0].Typ == itemLeftRound
+}
+
 func parseMathFunc(gq *GraphQuery, it *lex.ItemIterator, again bool) (*MathTree, bool, error) {
 	if !again {
 		it.Next()
@@ -187,7 +236,7 @@ loop:
 		item := it.Item()
 		lval := strings.ToLower(item.Val)
 		switch {
-		case isMathFunc(lval):
+		case isMathFunc(lval) || (isValueFunc(lval) && isCall(it)):
 			op := lval
 			it.Prev()
 			lastItem := it.Item()
@@ -209,7 +258,8 @@ loop:
 					return nil, false, err
 				}
 			}
-			opStack.push(&MathTree{Fn: op}) // Push current operator.
+			fn := &MathTree{Fn: op}
+			opStack.push(fn) // Push current operator.
 			peekIt, err := it.Peek(1)
 			if err != nil {
 				return nil, false, err
@@ -223,6 +273,7 @@ loop:
 						return nil, false, err
 					}
 					valueStack.push(child)
+					fn.nargs++
 					if !again {
 						break
 					}
@@ -251,8 +302,17 @@ loop:
 				}
 				continue
 			}
-			// We will try to parse the constant as an Int first, if that fails we move to float
 			child := &MathTree{}
+			if len(item.Val) >= 2 && item.Val[0] == '"' {
+				s, err := strconv.Unquote(item.Val)
+				if err != nil {
+					return nil, false, errors.Wrapf(err, "while parsing %s in math", item.Val)
+				}
+				child.Const = types.Val{Tid: types.StringID, Value: s}
+				valueStack.push(child)
+				continue
+			}
+			// We will try to parse the constant as an Int first, if that fails we move to float
 			i, err := strconv.ParseInt(item.Val, 10, 64)
 			if err != nil {
 				v, err := strconv.ParseFloat(item.Val, 64)
@@ -403,6 +463,8 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 				t.Const.Value.(float64), 'E', -1, 64))
 		case types.IntID:
 			leafStr, err = buf.WriteString(strconv.FormatInt(t.Const.Value.(int64), 10))
+		case types.StringID:
+			leafStr, err = buf.WriteString(strconv.Quote(t.Const.Value.(string)))
 		}
 		x.Check2(leafStr, err)
 		return
@@ -414,6 +476,9 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 		"sqrt", "max", "<", ">", "<=", ">=", "==", "!=", "u-",
 		"logbase", "pow", "dot":
 		x.Check2(buf.WriteString(t.Fn))
+	case "concat", "lower", "upper", "len", "substr", "date_trunc", "date_diff",
+		"add_duration", "year", "month", "day", "hour", "minute", "second":
+		x.Check2(buf.WriteString(t.Fn))
 	default:
 		x.Fatalf("Unknown operator: %q", t.Fn)
 	}
@@ -40,8 +40,33 @@ const (
 	countFunc   = "count"
 	uidInFunc   = "uid_in"
 	similarToFn = "similar_to"
+	hybridFn    = "hybrid_search"
 )
 
+// VectorDistanceAttr is the pseudo-predicate that exposes, for every node
+// returned by similar_to, its distance from the query vector.
+const VectorDistanceAttr = "_distance_"
+
+// HybridScoreAttr is the pseudo-predicate that exposes, for every node
+// returned by hybrid_search, its fused score. Higher scores rank first.
+const HybridScoreAttr = "_score_"
+
+// The pseudo-predicates below expose, for every node reached by a @recurse block with
+// path: true, the number of hops from the root it was first reached at, the nodes of the
+// path taken to first reach it and the nodes of that path its edges lead back to. The
+// latter two are uid edges, which can be assigned to uid variables.
+const (
+	RecurseDepthAttr = "_depth_"
+	RecursePathAttr  = "_recurse_path_"
+	RecurseCycleAttr = "_cycle_"
+)
+
+// IsRecursePathAttr returns true if attr is one of the pseudo-predicates filled by the path
+// tracking of a @recurse block.
+func IsRecursePathAttr(attr string) bool {
+	return attr == RecurseDepthAttr || attr == RecursePathAttr || attr == RecurseCycleAttr
+}
+
 var (
 	errExpandType = "expand is only compatible with type filters"
 )
@@ -76,6 +101,7 @@ type GraphQuery struct {
 	Facets           *pb.FacetParams
 	FacetsFilter     *FilterTree
 	GroupbyAttrs     []GroupByAttr
+	GroupbyArgs      GroupByArgs
 	FacetVar         map[string]string
 	FacetsOrder      []*FacetOrder
 
@@ -95,7 +121,13 @@ type GraphQuery struct {
 type RecurseArgs struct {
 	Depth     uint64
 	AllowLoop bool
-	varMap    map[string]string //varMap holds the variable args name. So, that we can substitute the
+	// MinDepth is the number of hops from the root below which the nodes first reached there
+	// are only kept if some node at MinDepth or deeper is reached through them.
+	MinDepth uint64
+	// Path enables the tracking of the paths taken to reach the nodes, see RecursePathAttr.
+	Path bool
+
+	varMap map[string]string //varMap holds the variable args name. So, that we can substitute the
 	// argument in the substitution part.
 }
 
@@ -116,6 +148,17 @@ type GroupByAttr struct {
 	Langs []string
 }
 
+// GroupByArgs stores the arguments of the @groupby directive filtering, ordering and
+// paginating the groups, e.g. @groupby(author, orderdesc: count, first: 10). They refer
+// to the keys and aggregates of a group by their name in the result, so an aggregate
+// other than count(uid) needs an alias to be used.
+type GroupByArgs struct {
+	Order  []*pb.Order
+	First  int // No limit if zero, the last groups if negative.
+	Offset int
+	Having *FilterTree
+}
+
 // FacetOrder stores ordering for single facet key.
 type FacetOrder struct {
 	Key  string
@@ -197,7 +240,25 @@ var filterOpPrecedence = map[string]int{
 	"or":  1,
 }
 var mathOpPrecedence = map[string]int{
-	"u-":      500,
+	"u-": 500,
+
+	// The functions over strings and datetimes are always called with their arguments, so
+	// they only need to be evaluated before the operators.
+	"concat":       110,
+	"lower":        110,
+	"upper":        110,
+	"len":          110,
+	"substr":       110,
+	"date_trunc":   110,
+	"date_diff":    110,
+	"add_duration": 110,
+	"year":         110,
+	"month":        110,
+	"day":          110,
+	"hour":         110,
+	"minute":       110,
+	"second":       110,
+
 	"floor":   105,
 	"ceil":    104,
 	"since":   103,
@@ -368,6 +429,28 @@ func parseValue(v varInfo) (types.Val, error) {
 				}, nil
 			}
 		}
+	case "int8vector":
+		{
+			if i, err := types.ParseVInt8(v.Value); err != nil {
+				return types.Val{}, errors.Wrapf(err, "Expected an int8vector but got %v", v.Value)
+			} else {
+				return types.Val{
+					Tid:   types.VInt8ID,
+					Value: i,
+				}, nil
+			}
+		}
+	case "binaryvector":
+		{
+			if i, err := types.ParseVBinary(v.Value); err != nil {
+				return types.Val{}, errors.Wrapf(err, "Expected a binaryvector but got %v", v.Value)
+			} else {
+				return types.Val{
+					Tid:   types.VBinaryID,
+					Value: i,
+				}, nil
+			}
+		}
 	case "string": // Value is a valid string. No checks required.
 		return types.Val{
 			Tid:   types.StringID,
@@ -421,6 +504,18 @@ func checkValueType(vm varMap) error {
 						return errors.Wrapf(err, "Expected a vector32float but got %v", v.Value)
 					}
 				}
+			case "int8vector":
+				{
+					if _, err := types.ParseVInt8(v.Value); err != nil {
+						return errors.Wrapf(err, "Expected an int8vector but got %v", v.Value)
+					}
+				}
+			case "binaryvector":
+				{
+					if _, err := types.ParseVBinary(v.Value); err != nil {
+						return errors.Wrapf(err, "Expected a binaryvector but got %v", v.Value)
+					}
+				}
 			case "string": // Value is a valid string. No checks required.
 			default:
 				return errors.Errorf("Type %q not supported", typ)
@@ -537,6 +632,33 @@ func substituteVariables(gq *GraphQuery, vmap varMap) error {
 			gq.RecurseArgs.AllowLoop = allowLoop
 		}
 
+		// Update the mindepth if the get the mindepth as a variable in the query.
+		varName, ok = gq.RecurseArgs.varMap["mindepth"]
+		if ok {
+			val, ok := vmap[varName]
+			if !ok {
+				return errors.Errorf("variable %s not defined", varName)
+			}
+			minDepth, err := strconv.ParseUint(val.Value, 0, 64)
+			if err != nil {
+				return errors.Wrapf(err, varName+" should be type of integer")
+			}
+			gq.RecurseArgs.MinDepth = minDepth
+		}
+
+		// Update the path if the get the path as a variable in the query.
+		varName, ok = gq.RecurseArgs.varMap["path"]
+		if ok {
+			val, ok := vmap[varName]
+			if !ok {
+				return errors.Errorf("variable %s not defined", varName)
+			}
+			path, err := strconv.ParseBool(val.Value)
+			if err != nil {
+				return errors.Wrapf(err, varName+" should be type of boolean")
+			}
+			gq.RecurseArgs.Path = path
+		}
 	}
 	return nil
 }
@@ -619,6 +741,9 @@ type Result struct {
 	Query     []*GraphQuery
 	QueryVars []*Vars
 	Schema    *pb.SchemaRequest
+	// AsOf is the timestamp or the RFC3339 time the query reads as of, given by the @asof
+	// directive of the query block.
+	AsOf string
 }
 
 // Parse initializes and runs the lexer. It also constructs the GraphQuery subgraph
@@ -691,9 +816,16 @@ func ParseWithNeedVars(r Request, needVars []string) (res Result, rerr error) {
 				if res.Schema != nil {
 					return res, item.Errorf("Schema block is not allowed with query block")
 				}
-				if qu, rerr = getVariablesAndQuery(it, vmap); rerr != nil {
+				var asOf string
+				if qu, asOf, rerr = getVariablesAndQuery(it, vmap); rerr != nil {
 					return res, rerr
 				}
+				if asOf != "" {
+					if res.AsOf != "" && res.AsOf != asOf {
+						return res, item.Errorf("All the query blocks must read as of the same time")
+					}
+					res.AsOf = asOf
+				}
 				res.Query = append(res.Query, qu)
 			}
 		case itemLeftCurl:
@@ -751,7 +883,7 @@ func ParseWithNeedVars(r Request, needVars []string) (res Result, rerr error) {
 func validateResult(res *Result) error {
 	seenQueryAliases := make(map[string]bool)
 	for _, q := range res.Query {
-		if q.Alias == "var" || q.Alias == "shortest" {
+		if q.Alias == "var" || q.Alias == "shortest" || IsGraphAlgorithm(q.Alias) {
 			continue
 		}
 		if _, found := seenQueryAliases[q.Alias]; found {
@@ -870,8 +1002,10 @@ func (f *FilterTree) hasVars() bool {
 
 // getVariablesAndQuery checks if the query has a variable list and stores it in
 // vmap. For variable list to be present, the query should have a name which is
-// also checked for. It also calls getQuery to create the GraphQuery object tree.
-func getVariablesAndQuery(it *lex.ItemIterator, vmap varMap) (gq *GraphQuery, rerr error) {
+// also checked for. It also calls getQuery to create the GraphQuery object tree, and
+// returns what the query reads as of, if it has the @asof directive.
+func getVariablesAndQuery(it *lex.ItemIterator, vmap varMap) (gq *GraphQuery, asOf string,
+	rerr error) {
 	var name string
 L2:
 	for it.Next() {
@@ -879,30 +1013,94 @@ L2:
 		switch item.Typ {
 		case itemName:
 			if name != "" {
-				return nil, item.Errorf("Multiple word query name not allowed.")
+				return nil, "", item.Errorf("Multiple word query name not allowed.")
 			}
 			name = item.Val
 		case itemLeftRound:
 			if name == "" {
-				return nil, item.Errorf("Variables can be defined only in named queries.")
+				return nil, "", item.Errorf("Variables can be defined only in named queries.")
 			}
 
 			if rerr = parseDqlVariables(it, vmap); rerr != nil {
-				return nil, rerr
+				return nil, "", rerr
 			}
 
 			if rerr = checkValueType(vmap); rerr != nil {
-				return nil, rerr
+				return nil, "", rerr
+			}
+		case itemAt:
+			if asOf, rerr = parseAsOf(it, vmap); rerr != nil {
+				return nil, "", rerr
 			}
 		case itemLeftCurl:
 			if gq, rerr = getQuery(it); rerr != nil {
-				return nil, rerr
+				return nil, "", rerr
 			}
 			break L2
 		}
 	}
 
-	return gq, nil
+	return gq, asOf, nil
+}
+
+// parseAsOf parses the @asof directive of a query block, like @asof(ts: 100) or
+// @asof(time: "2024-01-02T03:04:05Z"), and returns the timestamp or the time. The value can
+// also be a variable.
+func parseAsOf(it *lex.ItemIterator, vmap varMap) (string, error) {
+	if !it.Next() || it.Item().Typ != itemName || it.Item().Val != "asof" {
+		return "", it.Errorf("Only the @asof directive is allowed on a query block")
+	}
+	if ok := trySkipItemTyp(it, itemLeftRound); !ok {
+		return "", it.Errorf("Expected ( after @asof")
+	}
+	if !it.Next() || it.Item().Typ != itemName {
+		return "", it.Errorf("Expected ts or time inside @asof()")
+	}
+	key := strings.ToLower(it.Item().Val)
+	if key != "ts" && key != "time" {
+		return "", it.Item().Errorf("Expected ts or time inside @asof(), got: %s", key)
+	}
+	if ok := trySkipItemTyp(it, itemColon); !ok {
+		return "", it.Errorf("Expected colon(:) after %s", key)
+	}
+	if !it.Next() {
+		return "", it.Errorf("Expected value inside @asof() for key: %s", key)
+	}
+
+	item := it.Item()
+	var val string
+	switch item.Typ {
+	case itemDollar:
+		varName, err := parseVarName(it)
+		if err != nil {
+			return "", err
+		}
+		v, ok := vmap[varName]
+		if !ok {
+			return "", it.Errorf("Variable %s not defined", varName)
+		}
+		val = v.Value
+	case itemName:
+		uq, err := unquoteIfQuoted(item.Val)
+		if err != nil {
+			return "", err
+		}
+		val = uq
+	default:
+		return "", item.Errorf("Expected value inside @asof() for key: %s", key)
+	}
+	if val == "" {
+		return "", item.Errorf("Empty value inside @asof() for key: %s", key)
+	}
+	if key == "ts" {
+		if _, err := strconv.ParseUint(val, 10, 64); err != nil {
+			return "", item.Errorf("Value inside @asof() for ts should be a timestamp, got: %s", val)
+		}
+	}
+	if ok := trySkipItemTyp(it, itemRightRound); !ok {
+		return "", it.Errorf("Expected ) after the value of @asof()")
+	}
+	return val, nil
 }
 
 // parseVarName returns the variable name.
@@ -996,6 +1194,45 @@ func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
 				}
 				gq.RecurseArgs.AllowLoop = allowLoop
 			}
+		case "mindepth":
+			if item.Typ == itemDollar {
+				// Consume the variable name.
+				varName, err := parseVarName(it)
+				if err != nil {
+					return err
+				}
+				if gq.RecurseArgs.varMap == nil {
+					gq.RecurseArgs.varMap = make(map[string]string)
+				}
+				gq.RecurseArgs.varMap["mindepth"] = varName
+			} else {
+				if item.Typ != itemName {
+					return item.Errorf("Expected value inside @recurse() for key: %s", key)
+				}
+				minDepth, err := strconv.ParseUint(val, 0, 64)
+				if err != nil {
+					return errors.New("Value inside mindepth should be type of integer")
+				}
+				gq.RecurseArgs.MinDepth = minDepth
+			}
+		case "path":
+			if item.Typ == itemDollar {
+				// Consume the variable name.
+				varName, err := parseVarName(it)
+				if err != nil {
+					return err
+				}
+				if gq.RecurseArgs.varMap == nil {
+					gq.RecurseArgs.varMap = make(map[string]string)
+				}
+				gq.RecurseArgs.varMap["path"] = varName
+			} else {
+				path, err := strconv.ParseBool(val)
+				if err != nil {
+					return errors.New("Value inside path should be type of boolean")
+				}
+				gq.RecurseArgs.Path = path
+			}
 		default:
 			return item.Errorf("Unexpected key: [%s] inside @recurse block", key)
 		}
@@ -1712,7 +1949,7 @@ func validFuncName(name string) bool {
 
 	switch name {
 	case "regexp", "anyofterms", "allofterms", "alloftext", "anyoftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to", "hybrid_search":
 		return true
 	}
 	return false
@@ -1745,7 +1982,7 @@ func parseRegexArgs(val string) (regexArgs, error) {
 
 func parseFunction(it *lex.ItemIterator, gq *GraphQuery) (*Function, error) {
 	function := &Function{}
-	var expectArg, seenFuncArg, expectLang, isDollar bool
+	var expectArg, seenFuncArg, expectLang, isDollar, expectOptVal bool
 L:
 	for it.Next() {
 		item := it.Item()
@@ -1770,6 +2007,10 @@ L:
 			var val string
 			switch itemInFunc.Typ {
 			case itemRightRound:
+				if expectOptVal {
+					return nil, itemInFunc.Errorf("Missing value of option %s in %s",
+						function.Args[len(function.Args)-1].Value, function.Name)
+				}
 				break L
 			case itemComma:
 				if expectArg {
@@ -1865,8 +2106,27 @@ L:
 				if isDollar {
 					return nil, itemInFunc.Errorf("Invalid use of $ in func args")
 				}
+				if expectOptVal {
+					return nil, itemInFunc.Errorf("Value of option %s in %s must be a constant",
+						function.Args[len(function.Args)-1].Value, function.Name)
+				}
 				isDollar = true
 				continue
+			case itemColon:
+				if function.Name != similarToFn {
+					return nil, itemInFunc.Errorf("Expected arg after func [%s], but got item %v",
+						function.Name, itemInFunc)
+				}
+				// Options of similar_to are given as name: value, e.g. ef: 200.
+				// They are kept as a single "name:value" argument.
+				if expectArg || expectOptVal ||
+					len(function.Args) < 3 || function.Args[len(function.Args)-1].IsDQLVar ||
+					function.Args[len(function.Args)-1].IsValueVar {
+					return nil, itemInFunc.Errorf("Invalid use of : in function %s", function.Name)
+				}
+				expectOptVal = true
+				expectArg = true
+				continue
 			case itemRegex:
 				ra, err := parseRegexArgs(itemInFunc.Val)
 				if err != nil {
@@ -1885,7 +2145,8 @@ L:
 				case IsInequalityFn(function.Name):
 					err = parseFuncArgs(it, function)
 
-				case function.Name == "uid_in" || function.Name == "similar_to":
+				case function.Name == "uid_in" || function.Name == similarToFn ||
+					function.Name == hybridFn:
 					err = parseFuncArgs(it, function)
 
 				default:
@@ -1964,6 +2225,9 @@ L:
 				}
 				function.Attr = val
 				attrItemsAgo = 0
+			case expectOptVal:
+				function.Args[len(function.Args)-1].Value += ":" + val
+				expectOptVal = false
 			case expectLang:
 				if val == "*" {
 					return nil, errors.Errorf(
@@ -2315,6 +2579,14 @@ loop:
 				if alias != "" {
 					return item.Errorf("Expected predicate after %s:", alias)
 				}
+				if isGroupbyArg(val) {
+					it.Next() // Consume the itemColon
+					if err := parseGroupbyArg(it, gq, val); err != nil {
+						return err
+					}
+					expectArg = false
+					continue
+				}
 				if validKey(val) {
 					return item.Errorf("Can't use keyword %s as alias in groupby", val)
 				}
@@ -2354,6 +2626,75 @@ loop:
 	return nil
 }
 
+func isGroupbyArg(key string) bool {
+	switch key {
+	case "orderasc", "orderdesc", "first", "offset", "having":
+		return true
+	}
+	return false
+}
+
+// parseGroupbyArg parses the value of the argument key of the groupby directive. The
+// value of having is a single function or a parenthesized filter expression, e.g.
+// having: (ge(count, 10) and lt(count, 100)).
+func parseGroupbyArg(it *lex.ItemIterator, gq *GraphQuery, key string) error {
+	args := &gq.GroupbyArgs
+	if key == "having" {
+		if args.Having != nil {
+			return it.Errorf("Only one having allowed in groupby")
+		}
+		items, err := it.Peek(1)
+		if err != nil {
+			return err
+		}
+		if items[0].Typ == itemLeftRound {
+			args.Having, err = parseFilter(it)
+			return err
+		}
+		f, err := parseFunction(it, nil)
+		if err != nil {
+			return err
+		}
+		args.Having = &FilterTree{Func: f}
+		return nil
+	}
+
+	it.Next()
+	item := it.Item()
+	var sign string
+	if key == "first" && item.Typ == itemMathOp && item.Val == "-" {
+		sign = "-"
+		it.Next()
+		item = it.Item()
+	}
+	if item.Typ != itemName {
+		return item.Errorf("Expected a value for %s in groupby but got: %v", key, item.Val)
+	}
+	val := sign + collectName(it, item.Val)
+	if items, err := it.Peek(1); err == nil && items[0].Typ == itemLeftRound {
+		return item.Errorf("Expected a key or an aliased aggregate for %s in groupby"+
+			" but got: %s(", key, val)
+	}
+	switch key {
+	case "orderasc", "orderdesc":
+		args.Order = append(args.Order, &pb.Order{Attr: val, Desc: key == "orderdesc"})
+	case "first":
+		n, err := strconv.Atoi(val)
+		if err != nil {
+			return item.Errorf("Expected an integer for first in groupby but got: %s", val)
+		}
+		args.First = n
+	case "offset":
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 0 {
+			return item.Errorf("Expected a non-negative integer for offset in groupby"+
+				" but got: %s", val)
+		}
+		args.Offset = n
+	}
+	return nil
+}
+
 // parseFilter parses the filter directive to produce a QueryFilter / parse tree.
 func parseFilter(it *lex.ItemIterator) (*FilterTree, error) {
 	it.Next()
@@ -2702,11 +3043,38 @@ func validKeyAtRoot(k string) bool {
 	switch k {
 	case "func", "orderasc", "orderdesc", "first", "offset", "after":
 		return true
-	case "from", "to", "numpaths", "minweight", "maxweight":
+	case "from", "to", "numpaths", "minweight", "maxweight", "allpaths", "maxhops", "alternate",
+		"bidirectional":
 		// Specific to shortest path
 		return true
 	case "depth":
 		return true
+	case "damping", "iterations", "tolerance":
+		// Specific to graph algorithms
+		return true
+	}
+	return false
+}
+
+// IsGraphAlgorithm returns true if alias is the name of a block running a graph
+// algorithm over the predicates in its body, e.g.
+// pr as pagerank(func: uid(people), damping: 0.85) { follows }.
+func IsGraphAlgorithm(alias string) bool {
+	switch alias {
+	case "pagerank", "components", "communities":
+		return true
+	}
+	return false
+}
+
+func isGraphAlgorithmKey(key string) bool {
+	return key == "damping" || key == "iterations" || key == "tolerance"
+}
+
+func isShortestPathKey(key string) bool {
+	switch key {
+	case "allpaths", "maxhops", "alternate", "bidirectional":
+		return true
 	}
 	return false
 }
@@ -2802,6 +3170,12 @@ loop:
 		if !validKeyAtRoot(key) {
 			return nil, item.Errorf("Got invalid keyword: %s at root", key)
 		}
+		if isGraphAlgorithmKey(key) && !IsGraphAlgorithm(gq.Alias) {
+			return nil, item.Errorf("%s only allowed for graph algorithm blocks", key)
+		}
+		if isShortestPathKey(key) && gq.Alias != "shortest" {
+			return nil, item.Errorf("%s only allowed for shortest path queries", key)
+		}
 
 		if !it.Next() {
 			return nil, item.Errorf("Invalid query")
@@ -3147,6 +3521,11 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 					Name:     valLower,
 					NeedsVar: child.NeedsVar,
 				}
+				if isPercentileAggregator(valLower) {
+					if child.Func.Args, err = parsePercentileArg(it, valLower); err != nil {
+						return err
+					}
+				}
 				it.Next() // Skip the closing ')'
 				gq.Children = append(gq.Children, child)
 				curp = nil
@@ -3403,7 +3782,35 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 }
 
 func isAggregator(fname string) bool {
-	return fname == "min" || fname == "max" || fname == "sum" || fname == "avg"
+	switch fname {
+	case "min", "max", "sum", "avg", "median", "stddev", "variance", "count_distinct",
+		"approx_count_distinct":
+		return true
+	}
+	return isPercentileAggregator(fname)
+}
+
+// isPercentileAggregator returns true for the aggregators taking the percentile
+// to compute as second argument, e.g. percentile(val(latency), 95).
+func isPercentileAggregator(fname string) bool {
+	return fname == "percentile" || fname == "approx_percentile"
+}
+
+// parsePercentileArg parses the percentile argument of the aggregator fname,
+// a number between 0 and 100 following a comma.
+func parsePercentileArg(it *lex.ItemIterator, fname string) ([]Arg, error) {
+	it.Next()
+	if item := it.Item(); item.Typ != itemComma {
+		return nil, item.Errorf("Expected a percentile as second argument of %s", fname)
+	}
+	it.Next()
+	item := it.Item()
+	p, err := strconv.ParseFloat(item.Val, 64)
+	if err != nil || p < 0 || p > 100 {
+		return nil, item.Errorf("Percentile of %s should be a number between 0 and 100. Got: %s",
+			fname, item.Val)
+	}
+	return []Arg{{Value: item.Val}}, nil
 }
 
 func isExpandFunc(name string) bool {
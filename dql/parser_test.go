@@ -67,6 +67,58 @@ func TestParseMathSubs(t *testing.T) {
 	require.Contains(t, err.Error(), "Expected an int but got 3.3")
 }
 
+func TestParseAsOf(t *testing.T) {
+	res, err := Parse(Request{Str: `query @asof(ts: 100) {
+	q(func: uid(0x1)) {
+		name
+	}
+}`})
+	require.NoError(t, err)
+	require.Equal(t, "100", res.AsOf)
+	require.Len(t, res.Query, 1)
+
+	res, err = Parse(Request{
+		Str: `query test($t: string) @asof(time: $t) {
+	q(func: uid(0x1)) {
+		name
+	}
+}`,
+		Variables: map[string]string{"$t": "2024-01-02T03:04:05Z"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "2024-01-02T03:04:05Z", res.AsOf)
+
+	res, err = Parse(Request{Str: `query @asof(time: "2024-01-02T03:04:05Z") {
+	q(func: uid(0x1)) {
+		name
+	}
+}`})
+	require.NoError(t, err)
+	require.Equal(t, "2024-01-02T03:04:05Z", res.AsOf)
+
+	res, err = Parse(Request{Str: `{
+	q(func: uid(0x1)) {
+		name
+	}
+}`})
+	require.NoError(t, err)
+	require.Empty(t, res.AsOf)
+
+	_, err = Parse(Request{Str: `query @asof(ts: yesterday) {
+	q(func: uid(0x1)) {
+		name
+	}
+}`})
+	require.ErrorContains(t, err, "should be a timestamp")
+
+	_, err = Parse(Request{Str: `query @filter(ts: 10) {
+	q(func: uid(0x1)) {
+		name
+	}
+}`})
+	require.ErrorContains(t, err, "Only the @asof directive is allowed")
+}
+
 func TestParseCountValError(t *testing.T) {
 	query := `
 {
@@ -512,6 +564,50 @@ func TestParseQueryWithVarValAggNested(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseMathValueFuncs(t *testing.T) {
+	query := `
+	{
+		me(func: uid(L)) {
+			val(c)
+			val(d)
+		}
+
+		var(func: uid(0x0a)) {
+			L as friends {
+				a as name
+				b as dob
+				day as age
+				c as math(concat(a, " - ", lower(a)) + len(a))
+				d as math(date_diff("day", b, add_duration(b, "48h")) * day)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.EqualValues(t, `(+ (concat a " - " (lower a)) (len a))`,
+		res.Query[1].Children[0].Children[3].MathExp.debugString())
+	require.EqualValues(t, `(* (date_diff "day" b (add_duration b "48h")) day)`,
+		res.Query[1].Children[0].Children[4].MathExp.debugString())
+}
+
+func TestParseMathValueFuncArgs(t *testing.T) {
+	query := `
+	{
+		me(func: uid(0x0a)) {
+			a as name
+			b as math(lower(a, a))
+		}
+		you(func: uid(a, b)) {
+			uid
+		}
+	}
+`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid number of arguments for lower: 2")
+}
+
 func TestParseQueryWithVarValAggNested2(t *testing.T) {
 	query := `
 	{
@@ -1364,6 +1460,69 @@ func TestParseShortestPathInvalidFnError(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestParseShortestPathOptions(t *testing.T) {
+	query := `{
+		blocked as var(func: eq(status, "blocked"))
+
+		shortest(from: 0x01, to: 0x05, allpaths: true, maxhops: 4, alternate: true,
+			bidirectional: true) @filter(not uid(blocked)) {
+			follows
+			likes
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	sp := res.Query[1]
+	require.Equal(t, "true", sp.Args["allpaths"])
+	require.Equal(t, "4", sp.Args["maxhops"])
+	require.Equal(t, "true", sp.Args["alternate"])
+	require.Equal(t, "true", sp.Args["bidirectional"])
+	require.NotNil(t, sp.Filter)
+
+	_, err = Parse(Request{Str: `{ me(func: uid(1), maxhops: 2) { follows } }`})
+	require.ErrorContains(t, err, "maxhops only allowed for shortest path queries")
+}
+
+func TestParseGraphAlgorithm(t *testing.T) {
+	query := `{
+		people as var(func: has(follows))
+
+		pr as pagerank(func: uid(people), damping: 0.9, iterations: 50, tolerance: 1e-8) {
+			follows
+			~follows
+		}
+		cc as components(func: uid(people), depth: 3) {
+			follows
+		}
+
+		top(func: uid(pr), orderdesc: val(pr), first: 10) {
+			name
+			rank: val(pr)
+			component: val(cc)
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	pr := res.Query[1]
+	require.Equal(t, "pagerank", pr.Alias)
+	require.Equal(t, "pr", pr.Var)
+	require.Equal(t, "0.9", pr.Args["damping"])
+	require.Equal(t, "50", pr.Args["iterations"])
+	require.Equal(t, "1e-8", pr.Args["tolerance"])
+	require.Len(t, pr.Children, 2)
+	require.Equal(t, "3", res.Query[2].Args["depth"])
+}
+
+func TestParseGraphAlgorithmArgError(t *testing.T) {
+	query := `{
+		me(func: uid(1), damping: 0.5) {
+			follows
+		}
+	}`
+	_, err := Parse(Request{Str: query})
+	require.ErrorContains(t, err, "damping only allowed for graph algorithm blocks")
+}
+
 func TestParseMultipleQueries(t *testing.T) {
 	query := `
 	{
@@ -3360,7 +3519,7 @@ func TestParseGroupbyWithAliasForError(t *testing.T) {
 	query := `
 	query {
 		me(func: uid(0x1)) {
-			friends @groupby(first: 10, SchooL: school) {
+			friends @groupby(after: 10, SchooL: school) {
 				count(uid)
 			}
 			hometown
@@ -3369,7 +3528,56 @@ func TestParseGroupbyWithAliasForError(t *testing.T) {
 	}
 `
 	_, err := Parse(Request{Str: query})
-	require.Contains(t, err.Error(), "Can't use keyword first as alias in groupby")
+	require.Contains(t, err.Error(), "Can't use keyword after as alias in groupby")
+}
+
+func TestParseGroupbyArgs(t *testing.T) {
+	query := `
+	query {
+		me(func: uid(0x1)) {
+			friends @groupby(school, orderdesc: count, orderasc: school, first: -2, offset: 1,
+				having: (ge(count, 2) and not eq(oldest, 30, 40))) {
+				count(uid)
+				oldest: max(age)
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	friends := res.Query[0].Children[0]
+	require.Equal(t, []GroupByAttr{{Attr: "school"}}, friends.GroupbyAttrs)
+	args := friends.GroupbyArgs
+	require.Len(t, args.Order, 2)
+	require.Equal(t, "count", args.Order[0].Attr)
+	require.True(t, args.Order[0].Desc)
+	require.Equal(t, "school", args.Order[1].Attr)
+	require.False(t, args.Order[1].Desc)
+	require.Equal(t, -2, args.First)
+	require.Equal(t, 1, args.Offset)
+	require.Equal(t, "(AND (ge count \"2\") (NOT (eq oldest \"30\" \"40\")))",
+		args.Having.debugString())
+
+	query = `{ me(func: uid(0x1)) { friends @groupby(school, having: gt(count, 1)) { count(uid) } } }`
+	res, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "(gt count \"1\")", res.Query[0].Children[0].GroupbyArgs.Having.debugString())
+}
+
+func TestParseGroupbyArgsError(t *testing.T) {
+	for _, tc := range []struct {
+		args string
+		err  string
+	}{
+		{"school, first: ten", "Expected an integer for first in groupby but got: ten"},
+		{"school, offset: -1", "Expected a value for offset in groupby but got: -"},
+		{"school, orderdesc: max(age)", "Expected a key or an aliased aggregate for orderdesc"},
+		{"school, having: gt(count, 1), having: lt(count, 5)", "Only one having allowed in groupby"},
+	} {
+		query := `{ me(func: uid(0x1)) { friends @groupby(` + tc.args + `) { count(uid) } } }`
+		_, err := Parse(Request{Str: query})
+		require.ErrorContains(t, err, tc.err, tc.args)
+	}
 }
 
 func TestParseGroupbyError(t *testing.T) {
@@ -4473,6 +4681,59 @@ func TestAggRoot1(t *testing.T) {
 	require.Equal(t, true, dql.Query[1].IsEmpty)
 }
 
+func TestAggRootPercentile(t *testing.T) {
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				median(val(a))
+				p95 : percentile(val(a), 95)
+				approx_percentile(val(a), 99.9)
+				stddev(val(a))
+			}
+		}
+	`
+	dql, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	children := dql.Query[1].Children
+	require.Len(t, children, 4)
+	require.Equal(t, "median", children[0].Func.Name)
+	require.Empty(t, children[0].Func.Args)
+	require.Equal(t, "p95", children[1].Alias)
+	require.Equal(t, "percentile", children[1].Func.Name)
+	require.Equal(t, []Arg{{Value: "95"}}, children[1].Func.Args)
+	require.Equal(t, []Arg{{Value: "99.9"}}, children[2].Func.Args)
+	require.Equal(t, "stddev", children[3].Func.Name)
+}
+
+func TestAggRootPercentileError(t *testing.T) {
+	for _, tc := range []struct {
+		agg string
+		err string
+	}{
+		{"percentile(val(a))", "Expected a percentile as second argument of percentile"},
+		{"percentile(val(a), 101)", "should be a number between 0 and 100. Got: 101"},
+		{"approx_percentile(val(a), p)", "should be a number between 0 and 100. Got: p"},
+	} {
+		query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				` + tc.agg + `
+			}
+		}
+	`
+		_, err := Parse(Request{Str: query})
+		require.ErrorContains(t, err, tc.err, tc.agg)
+	}
+}
+
 func TestAggRootError(t *testing.T) {
 	query := `
 		{
@@ -5357,6 +5618,53 @@ func TestRecurse(t *testing.T) {
 	require.Equal(t, gq.Query[0].RecurseArgs.AllowLoop, true)
 }
 
+func TestRecurseMinDepthAndPath(t *testing.T) {
+	query := `
+	{
+		me(func: eq(name, "sad"))@recurse(mindepth: 2, path: true) {
+			friend
+			_depth_
+			_recurse_path_
+			_cycle_
+		}
+	}`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), gq.Query[0].RecurseArgs.MinDepth)
+	require.True(t, gq.Query[0].RecurseArgs.Path)
+	require.Len(t, gq.Query[0].Children, 4)
+	require.True(t, IsRecursePathAttr(gq.Query[0].Children[1].Attr))
+	require.True(t, IsRecursePathAttr(gq.Query[0].Children[2].Attr))
+
+	query = `
+	{
+		me(func: eq(name, "sad"))@recurse(mindepth: $min, path: $path) {
+		}
+	}`
+	gq, err = Parse(Request{Str: query, Variables: map[string]string{"$min": "3", "$path": "true"}})
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), gq.Query[0].RecurseArgs.MinDepth)
+	require.True(t, gq.Query[0].RecurseArgs.Path)
+
+	query = `
+	{
+		me(func: eq(name, "sad"))@recurse(mindepth: two) {
+		}
+	}`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Value inside mindepth should be type of integer")
+
+	query = `
+	{
+		me(func: eq(name, "sad"))@recurse(path: yes) {
+		}
+	}`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Value inside path should be type of boolean")
+}
+
 func TestRecurseWithError(t *testing.T) {
 	query := `
 	{
@@ -5578,3 +5886,87 @@ func TestEmptyId(t *testing.T) {
 	_, err := Parse(r)
 	require.Error(t, err, "ID cannot be empty")
 }
+
+func TestParseHybridSearch(t *testing.T) {
+	query := `
+	query test($v: float32vector = "[0.1, 0.2]") {
+		me(func: hybrid_search(embedding, 5, $v, description, "red shoes", "weighted", 0.7)) {
+			uid
+			score as _score_
+			vec as embedding
+		}
+		other(func: hybrid_search(embedding, 5, val(vec), description, "red shoes")) {
+			val(score)
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "hybrid_search", res.Query[0].Func.Name)
+	require.Equal(t, "embedding", res.Query[0].Func.Attr)
+	var args []string
+	for _, arg := range res.Query[0].Func.Args {
+		args = append(args, arg.Value)
+	}
+	require.Equal(t, []string{"5", "[0.1, 0.2]", "description", "red shoes", "weighted", "0.7"}, args)
+	require.Equal(t, HybridScoreAttr, res.Query[0].Children[1].Attr)
+	require.Equal(t, "score", res.Query[0].Children[1].Var)
+
+	require.Len(t, res.Query[1].Func.Args, 4)
+	require.True(t, res.Query[1].Func.Args[1].IsValueVar)
+	require.Equal(t, "vec", res.Query[1].Func.Args[1].Value)
+}
+
+func TestParseSimilarToOptions(t *testing.T) {
+	query := `
+	query test($v: float32vector = "[0.1, 0.2]") {
+		me(func: similar_to(embedding, 5, $v, ef: 200, exact: true)) {
+			uid
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	var args []string
+	for _, arg := range res.Query[0].Func.Args {
+		args = append(args, arg.Value)
+	}
+	require.Equal(t, []string{"5", "[0.1, 0.2]", "ef:200", "exact:true"}, args)
+
+	for _, bad := range []string{
+		`{ me(func: similar_to(embedding, 5, "[0.1, 0.2]", ef:)) { uid } }`,
+		`{ me(func: similar_to(embedding, 5: 3, "[0.1, 0.2]")) { uid } }`,
+		`{ me(func: eq(name, ef: 200)) { uid } }`,
+		`query test($ef: int = 200) {
+			me(func: similar_to(embedding, 5, "[0.1, 0.2]", ef: $ef)) { uid }
+		}`,
+	} {
+		_, err := Parse(Request{Str: bad})
+		require.Error(t, err, bad)
+	}
+}
+
+func TestParseQuantizedVectorVariables(t *testing.T) {
+	query := `
+	query test($i: int8vector = "[1, -2]", $b: binaryvector = "[1, 0, 0, 0, 0, 0, 0, 1]") {
+		me(func: similar_to(embedding, 5, $i)) {
+			uid
+		}
+		other(func: similar_to(bits, 5, $b)) {
+			uid
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, "[1, -2]", res.Query[0].Func.Args[1].Value)
+	require.Equal(t, "[1, 0, 0, 0, 0, 0, 0, 1]", res.Query[1].Func.Args[1].Value)
+
+	for _, bad := range []string{
+		`query test($i: int8vector = "[1.5, 2]") { me(func: similar_to(e, 5, $i)) { uid } }`,
+		`query test($b: binaryvector = "[1, 2]") { me(func: similar_to(e, 5, $b)) { uid } }`,
+	} {
+		_, err := Parse(Request{Str: bad})
+		require.Error(t, err, bad)
+	}
+}
@@ -875,13 +875,15 @@ func parsePredsFromQuery(dqls []*dql.GraphQuery) predsAndvars {
 	predsMap := make(map[string]struct{})
 	varsMap := make(map[string]string)
 	for _, gq := range dqls {
-		if gq.Func != nil {
-			predsMap[gq.Func.Attr] = struct{}{}
+		for _, pred := range funcPreds(gq.Func) {
+			predsMap[pred] = struct{}{}
 		}
 		if len(gq.Var) > 0 {
 			varsMap[gq.Var] = gq.Attr
 		}
-		if len(gq.Attr) > 0 && gq.Attr != "uid" && gq.Attr != "expand" && gq.Attr != "val" {
+		if len(gq.Attr) > 0 && gq.Attr != "uid" && gq.Attr != "expand" && gq.Attr != "val" &&
+			gq.Attr != dql.VectorDistanceAttr && gq.Attr != dql.HybridScoreAttr &&
+			!dql.IsRecursePathAttr(gq.Attr) {
 			predsMap[gq.Attr] = struct{}{}
 
 		}
@@ -915,6 +917,19 @@ func parsePredsFromQuery(dqls []*dql.GraphQuery) predsAndvars {
 	return pv
 }
 
+// funcPreds returns the predicates read by the function f. hybrid_search reads
+// a text predicate besides its vector predicate.
+func funcPreds(f *dql.Function) []string {
+	if f == nil {
+		return nil
+	}
+	preds := []string{f.Attr}
+	if f.Name == "hybrid_search" && len(f.Args) > 2 {
+		preds = append(preds, f.Args[2].Value)
+	}
+	return preds
+}
+
 func parsePredsFromFilter(f *dql.FilterTree) []string {
 	var preds []string
 	if f == nil {
@@ -1330,9 +1345,9 @@ func removePredsFromQuery(gqs []*dql.GraphQuery,
 	filteredGQs := gqs[:0]
 L:
 	for _, gq := range gqs {
-		if gq.Func != nil && len(gq.Func.Attr) > 0 {
-			if _, ok := blockedPreds[gq.Func.Attr]; ok {
-				continue
+		for _, pred := range funcPreds(gq.Func) {
+			if _, ok := blockedPreds[pred]; ok {
+				continue L
 			}
 		}
 		if len(gq.Attr) > 0 {
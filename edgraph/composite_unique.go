@@ -0,0 +1,459 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package edgraph
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/dgryski/go-farm"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgo/v230/protos/api"
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/query"
+	"github.com/dgraph-io/dgraph/v24/schema"
+	"github.com/dgraph-io/dgraph/v24/types"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+// uniqueSubject is a node changed by a mutation, in a namespace.
+type uniqueSubject struct {
+	ns      uint64
+	subject string
+}
+
+// uniqueChanges holds the values set and the predicates deleted by a mutation for a node.
+type uniqueChanges struct {
+	set map[string]*api.NQuad
+	// del holds the predicates deleted, x.Star if all of them are.
+	del map[string]bool
+}
+
+// uniqueTuple is the tuple of values of a composite unique constraint that a node has once
+// a mutation is applied. The values are converted to the type of their predicate, then to
+// strings, so that they can be compared and used in queries.
+type uniqueTuple struct {
+	subject uniqueSubject
+	// uid is zero for a blank node.
+	uid        uint64
+	constraint []string
+	values     []string
+	// missing holds the indexes of the values that aren't set by the mutation.
+	missing []int
+}
+
+// verifyCompositeUnique verifies that the mutations of qc don't break any composite unique
+// constraint, declared with @unique(pred1, pred2) on a predicate. For every node changed by
+// the mutations, the tuple of values it ends up with for the predicates of a constraint
+// must not be the tuple of another node, either in the data or in the mutations. The
+// values that the mutations don't set are read from the data. The nodes without a value for
+// some of the predicates aren't constrained.
+func verifyCompositeUnique(ctx context.Context, qc *queryContext) error {
+	namespace, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		// Same as addQueryIfUnique, the namespace is either 0 or the mutation would fail.
+		namespace = 0
+	}
+	isGalaxyQuery := x.IsGalaxyOperation(ctx)
+
+	changes := make(map[uniqueSubject]*uniqueChanges)
+	change := func(ns uint64, nq *api.NQuad) *uniqueChanges {
+		key := uniqueSubject{ns: ns, subject: nq.Subject}
+		c, ok := changes[key]
+		if !ok {
+			c = &uniqueChanges{set: make(map[string]*api.NQuad), del: make(map[string]bool)}
+			changes[key] = c
+		}
+		return c
+	}
+	constraints := make(map[uint64][][]string)
+	for _, gmu := range qc.gmuList {
+		for _, nq := range gmu.Del {
+			ns := namespace
+			if isGalaxyQuery {
+				ns = nq.Namespace
+			}
+			if nq.Predicate == x.Star {
+				change(ns, nq).del[x.Star] = true
+			} else {
+				change(ns, nq).del[x.NamespaceAttr(ns, nq.Predicate)] = true
+			}
+		}
+		for _, nq := range gmu.Set {
+			ns := namespace
+			if isGalaxyQuery {
+				ns = nq.Namespace
+			}
+			if nq.Lang != "" {
+				continue
+			}
+			change(ns, nq).set[x.NamespaceAttr(ns, nq.Predicate)] = nq
+			if _, ok := constraints[ns]; !ok {
+				constraints[ns] = schema.State().CompositeUniques(ns)
+			}
+		}
+	}
+
+	subjects := make([]uniqueSubject, 0, len(changes))
+	for key := range changes {
+		subjects = append(subjects, key)
+	}
+	sort.Slice(subjects, func(i, j int) bool {
+		if subjects[i].ns != subjects[j].ns {
+			return subjects[i].ns < subjects[j].ns
+		}
+		return subjects[i].subject < subjects[j].subject
+	})
+
+	var tuples []*uniqueTuple
+	for _, key := range subjects {
+		for _, constraint := range constraints[key.ns] {
+			t, err := newUniqueTuple(key, changes[key], constraint)
+			if err != nil {
+				return err
+			}
+			if t != nil {
+				tuples = append(tuples, t)
+			}
+		}
+	}
+	if len(tuples) == 0 {
+		return nil
+	}
+
+	byNs := make(map[uint64][]*uniqueTuple)
+	for _, t := range tuples {
+		byNs[t.subject.ns] = append(byNs[t.subject.ns], t)
+	}
+	for ns, tuples := range byNs {
+		nsCtx := x.AttachNamespace(ctx, ns)
+		tuples, err := fillUniqueTuples(nsCtx, qc, tuples)
+		if err != nil {
+			return err
+		}
+		if err := verifyUniqueTuples(tuples); err != nil {
+			return err
+		}
+		if err := queryUniqueTuples(nsCtx, qc, tuples, changes); err != nil {
+			return err
+		}
+		qc.uniqueKeys = append(qc.uniqueKeys, uniqueConflictKeys(ns, tuples)...)
+	}
+	return nil
+}
+
+// newUniqueTuple returns the tuple of values of the node key for the constraint, if the
+// changes of the node set the value of one of its predicates and don't delete any of the
+// others.
+func newUniqueTuple(key uniqueSubject, c *uniqueChanges,
+	constraint []string) (*uniqueTuple, error) {
+	touched := false
+	for _, pred := range constraint {
+		if _, ok := c.set[pred]; ok {
+			touched = true
+			continue
+		}
+		if c.del[pred] || c.del[x.Star] {
+			return nil, nil
+		}
+	}
+	if !touched {
+		return nil, nil
+	}
+
+	uid, err := parseSubject(key.subject)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error while parsing [%v]", key.subject)
+	}
+	t := &uniqueTuple{
+		subject:    key,
+		uid:        uid,
+		constraint: constraint,
+		values:     make([]string, len(constraint)),
+	}
+	for i, pred := range constraint {
+		nq, ok := c.set[pred]
+		if !ok {
+			if uid == 0 {
+				// A new node without a value for the predicate.
+				return nil, nil
+			}
+			t.missing = append(t.missing, i)
+			continue
+		}
+		if t.values[i], err = uniqueValue(pred, nq); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// uniqueValue returns the value set by nq for pred, as a string. The uids are formatted in
+// hex, and the blank nodes are kept as is.
+func uniqueValue(pred string, nq *api.NQuad) (string, error) {
+	if nq.ObjectId != "" {
+		if strings.HasPrefix(nq.ObjectId, "_:") {
+			return nq.ObjectId, nil
+		}
+		uid, err := dql.ParseUid(nq.ObjectId)
+		if err != nil {
+			return "", errors.Wrapf(err, "error while parsing [%v]", nq.ObjectId)
+		}
+		return fmt.Sprintf("%#x", uid), nil
+	}
+	return uniqueString(pred, dql.TypeValFrom(nq.ObjectValue))
+}
+
+// uniqueString converts val to the type of pred, then to a string. The type of val is kept
+// if pred isn't in the schema yet.
+func uniqueString(pred string, val types.Val) (string, error) {
+	var err error
+	if typ, terr := schema.State().TypeOf(pred); terr == nil && val.Tid != typ {
+		if val, err = types.Convert(val, typ); err != nil {
+			return "", errors.Wrapf(err, "while converting the value of [%v]", x.ParseAttr(pred))
+		}
+	}
+	str, err := types.Convert(val, types.StringID)
+	if err != nil {
+		return "", errors.Wrapf(err, "while converting the value of [%v]", x.ParseAttr(pred))
+	}
+	return str.Value.(string), nil
+}
+
+// processUniqueQuery runs the DQL query q at the start timestamp of the mutations.
+func processUniqueQuery(ctx context.Context, qc *queryContext, q string) (*query.Request, error) {
+	res, err := dql.Parse(dql.Request{Str: q})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing the composite unique query")
+	}
+	qr := &query.Request{
+		Latency:  &query.Latency{},
+		DqlQuery: &res,
+		ReadTs:   qc.req.StartTs,
+	}
+	if _, err := qr.Process(ctx); err != nil {
+		return nil, errors.Wrapf(err, "while checking the composite unique constraints")
+	}
+	return qr, nil
+}
+
+// fillUniqueTuples reads the values of the tuples that the mutations don't set from the
+// data. The tuples that still miss some values are dropped.
+func fillUniqueTuples(ctx context.Context, qc *queryContext,
+	tuples []*uniqueTuple) ([]*uniqueTuple, error) {
+	var buildQuery strings.Builder
+	for i, t := range tuples {
+		for _, j := range t.missing {
+			// For example, for the value of tenant of 0x5:
+			//   var(func: uid(0x5)) {
+			//     __dgraph_compositecheck_val_1_0__ as <tenant>
+			//   }
+			fmt.Fprintf(&buildQuery, "var(func: uid(%#x)) { __dgraph_compositecheck_val_%d_%d__ as <%s> }\n",
+				t.uid, i, j, x.ParseAttr(t.constraint[j]))
+		}
+	}
+	if buildQuery.Len() == 0 {
+		return tuples, nil
+	}
+	qr, err := processUniqueQuery(ctx, qc, "{"+buildQuery.String()+"}")
+	if err != nil {
+		return nil, err
+	}
+
+	out := tuples[:0]
+	for i, t := range tuples {
+		complete := true
+		for _, j := range t.missing {
+			pred := t.constraint[j]
+			v := qr.Vars[fmt.Sprintf("__dgraph_compositecheck_val_%d_%d__", i, j)]
+			typ, err := schema.State().TypeOf(pred)
+			if err != nil {
+				// The predicate isn't in the schema, so no node has a value for it.
+				complete = false
+				continue
+			}
+			if typ == types.UidID {
+				if uids := v.Uids.GetUids(); len(uids) > 0 {
+					t.values[j] = fmt.Sprintf("%#x", uids[0])
+				} else {
+					complete = false
+				}
+				continue
+			}
+			val, ok := v.Vals[t.uid]
+			if !ok {
+				complete = false
+				continue
+			}
+			if t.values[j], err = uniqueString(pred, val); err != nil {
+				return nil, err
+			}
+		}
+		if complete {
+			out = append(out, t)
+		}
+	}
+	return out, nil
+}
+
+// verifyUniqueTuples verifies that no two nodes end up with the same tuple of values for a
+// constraint in the mutations.
+func verifyUniqueTuples(tuples []*uniqueTuple) error {
+	seen := make(map[string]*uniqueTuple)
+	for _, t := range tuples {
+		key := t.key()
+		other, ok := seen[key]
+		if !ok {
+			seen[key] = t
+			continue
+		}
+		if other.subject != t.subject {
+			return errors.Errorf("could not insert duplicate values [%v] for predicates [%v],"+
+				" they are set for both [%v] and [%v]", t.valueList(), t.predList(),
+				other.subject.subject, t.subject.subject)
+		}
+	}
+	return nil
+}
+
+// key identifies the constraint and the tuple of values of t.
+func (t *uniqueTuple) key() string {
+	return strings.Join(t.constraint, "\x00") + "\x01" + strings.Join(t.values, "\x00")
+}
+
+// uniqueConflictKeys returns a conflict key for every tuple of values of a constraint set by
+// the mutations in the namespace ns. Two transactions giving the same tuple to different
+// nodes then conflict, even if they set its values on different predicates, which their
+// own conflict keys wouldn't catch. The tuples pointing to a new node can't be set by any
+// other transaction.
+func uniqueConflictKeys(ns uint64, tuples []*uniqueTuple) []string {
+	keys := make([]string, 0, len(tuples))
+	for _, t := range tuples {
+		if slices.ContainsFunc(t.values, func(v string) bool { return strings.HasPrefix(v, "_:") }) {
+			continue
+		}
+		fp := farm.Fingerprint64([]byte(fmt.Sprintf("unique|%d|%s", ns, t.key())))
+		keys = append(keys, strconv.FormatUint(fp, 36))
+	}
+	return keys
+}
+
+func (t *uniqueTuple) valueList() string {
+	return strings.Join(t.values, ", ")
+}
+
+func (t *uniqueTuple) predList() string {
+	preds := make([]string, 0, len(t.constraint))
+	for _, pred := range t.constraint {
+		preds = append(preds, x.ParseAttr(pred))
+	}
+	return strings.Join(preds, ", ")
+}
+
+// queryUniqueTuples verifies that no other node has the tuple of values of a constraint in
+// the data. The nodes whose tuple is changed by the mutations are ignored, their new tuple
+// is verified by verifyUniqueTuples.
+func queryUniqueTuples(ctx context.Context, qc *queryContext, tuples []*uniqueTuple,
+	changes map[uniqueSubject]*uniqueChanges) error {
+	var buildQuery strings.Builder
+	queried := make(map[int]bool)
+	for i, t := range tuples {
+		// For example, for the constraint email @unique(tenant, org):
+		//   __dgraph_compositecheck_1__ as var(func: eq(<email>, "a@b.c"))
+		//       @filter(eq(<tenant>, "acme") AND uid_in(<org>, 0x5))
+		var funcs []string
+		skip := false
+		for j, pred := range t.constraint {
+			typ, err := schema.State().TypeOf(pred)
+			if strings.HasPrefix(t.values[j], "_:") || err != nil {
+				// A new node or a predicate that isn't in the schema, no other node can
+				// have this value yet.
+				skip = true
+				break
+			}
+			if typ == types.UidID {
+				funcs = append(funcs, fmt.Sprintf("uid_in(<%s>, %s)", x.ParseAttr(pred), t.values[j]))
+			} else {
+				funcs = append(funcs, fmt.Sprintf("eq(<%s>, %s)", x.ParseAttr(pred),
+					strconv.Quote(t.values[j])))
+			}
+		}
+		if skip {
+			continue
+		}
+		queried[i] = true
+		fmt.Fprintf(&buildQuery, "__dgraph_compositecheck_%d__ as var(func: %s)", i, funcs[0])
+		if len(funcs) > 1 {
+			fmt.Fprintf(&buildQuery, " @filter(%s)", strings.Join(funcs[1:], " AND "))
+		}
+		buildQuery.WriteString("\n")
+	}
+	if buildQuery.Len() == 0 {
+		return nil
+	}
+	qr, err := processUniqueQuery(ctx, qc, "{"+buildQuery.String()+"}")
+	if err != nil {
+		return err
+	}
+
+	for i, t := range tuples {
+		if !queried[i] {
+			continue
+		}
+		res := qr.Vars[fmt.Sprintf("__dgraph_compositecheck_%d__", i)]
+		for _, uid := range res.Uids.GetUids() {
+			if uid == t.uid || changesTuple(changes, t, uid) {
+				continue
+			}
+			if len(res.Uids.Uids) > 2 {
+				glog.Errorf("composite unique constraint violated for predicates [%v]. uids: [%v]."+
+					" namespace: [%v]", t.predList(), res.Uids.Uids, t.subject.ns)
+			}
+			return errors.Errorf("could not insert duplicate values [%v] for predicates [%v],"+
+				" they are already used by [%#x]", t.valueList(), t.predList(), uid)
+		}
+	}
+	return nil
+}
+
+// changesTuple returns true if the mutations change the tuple of values of uid for the
+// constraint of t.
+func changesTuple(changes map[uniqueSubject]*uniqueChanges, t *uniqueTuple, uid uint64) bool {
+	for key, c := range changes {
+		if key.ns != t.subject.ns {
+			continue
+		}
+		if subjectUid, err := parseSubject(key.subject); err != nil || subjectUid != uid {
+			continue
+		}
+		if c.del[x.Star] {
+			return true
+		}
+		for _, pred := range t.constraint {
+			if _, ok := c.set[pred]; ok || c.del[pred] {
+				return true
+			}
+		}
+	}
+	return false
+}
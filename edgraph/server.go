@@ -48,6 +48,7 @@ import (
 	"github.com/dgraph-io/dgraph/v24/chunker"
 	"github.com/dgraph-io/dgraph/v24/conn"
 	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/graphql/embedding"
 	gqlSchema "github.com/dgraph-io/dgraph/v24/graphql/schema"
 	"github.com/dgraph-io/dgraph/v24/posting"
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
@@ -80,6 +81,11 @@ const (
 	IsGraphql GraphqlContextKey = iota
 	// Authorize is used to set if the request requires validation.
 	Authorize
+	// AsOf is used to set the timestamp or the RFC3339 time a query reads as of.
+	AsOf
+	// RespFormat is used to set one of the response formats of the query package that
+	// api.Request_RespFormat doesn't name, like query.RespFormatCSV.
+	RespFormat
 )
 
 type AuthMode int
@@ -581,6 +587,10 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 		return err
 	}
 
+	if err := verifyCompositeUnique(ctx, qc); err != nil {
+		return err
+	}
+
 	newUids, err := query.AssignUids(ctx, qc.gmuList)
 	if err != nil {
 		return err
@@ -630,6 +640,10 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 
 	qc.span.Annotatef(nil, "Applying mutations: %+v", m)
 	resp.Txn, err = query.ApplyMutations(ctx, m)
+	if err == nil && len(qc.uniqueKeys) > 0 {
+		// Zero detects the conflicts of the composite unique tuples like the others.
+		resp.Txn.Keys = x.Unique(append(resp.Txn.Keys, qc.uniqueKeys...))
+	}
 	qc.span.Annotatef(nil, "Txn Context: %+v. Err=%v", resp.Txn, err)
 
 	// calculateMutationMetrics calculate cost for the mutation.
@@ -1068,6 +1082,20 @@ type queryContext struct {
 	// otherwise it would be nil. (Eg. nil cases: in case of a DQL query,
 	// a mutation being executed from GraphQL layer).
 	gqlField gqlSchema.Field
+	// profile tells whether the plan or the profile of the query is returned.
+	profile query.ProfileMode
+	// profileJSON is the plan or the profile of the query, once it is processed.
+	profileJSON json.RawMessage
+	// asOf is the timestamp or the RFC3339 time the query reads as of, if any.
+	asOf string
+	// respFormat is the response format of the query package to use instead of the one of
+	// req, if any.
+	respFormat string
+	// uniqueKeys are the conflict keys of the composite unique tuples set by the mutations,
+	// see uniqueConflictKeys.
+	uniqueKeys []string
+	// stream, if set, is sent the JSON response in chunks instead of returning it at once.
+	stream func([]byte) error
 	// nquadsCount maintains numbers of nquads which would be inserted as part of this request.
 	// In some cases(mostly upserts), numbers of nquads to be inserted can to huge(we have seen upto
 	// 1B) and resulting in OOM. We are limiting number of nquads which can be inserted in
@@ -1087,6 +1115,16 @@ type Request struct {
 	gqlField gqlSchema.Field
 	// doAuth tells whether this request needs ACL authorization or not
 	doAuth AuthMode
+	// profile tells whether the plan or the profile of the query is returned
+	profile query.ProfileMode
+	// asOf is the timestamp or the RFC3339 time the query reads as of, if any
+	asOf string
+	// respFormat is the response format of the query package to use, if any
+	respFormat string
+	// stream, if set, is sent the JSON response in chunks, see query.StreamJson
+	stream func([]byte) error
+	// profileJSON is set to the plan or the profile of the query, if asked for by profile
+	profileJSON json.RawMessage
 }
 
 // Health handles /health and /health?all requests.
@@ -1210,8 +1248,11 @@ func (s *Server) QueryGraphQL(ctx context.Context, req *api.Request,
 	return s.doQuery(ctx, &Request{req: req, gqlField: field, doAuth: getAuthMode(ctx)})
 }
 
+// Query handles queries or mutations sent over gRPC. The api.Response has no room for
+// the plan or the profile of the query (see query.ProfileMode), which is sent in the
+// x.DgraphProfileTrailer trailer instead.
 func (s *Server) Query(ctx context.Context, req *api.Request) (*api.Response, error) {
-	resp, err := s.QueryNoGrpc(ctx, req)
+	resp, profile, err := s.QueryWithProfile(ctx, req)
 	if err != nil {
 		return resp, err
 	}
@@ -1219,20 +1260,70 @@ func (s *Server) Query(ctx context.Context, req *api.Request) (*api.Response, er
 	if err := grpc.SendHeader(ctx, md); err != nil {
 		glog.Warningf("error in sending grpc headers: %v", err)
 	}
+	if len(profile) > 0 {
+		if err := grpc.SetTrailer(ctx, metadata.Pairs(x.DgraphProfileTrailer,
+			string(profile))); err != nil {
+			glog.Warningf("error in setting grpc trailers: %v", err)
+		}
+	}
 	return resp, nil
 }
 
 // Query handles queries or mutations
 func (s *Server) QueryNoGrpc(ctx context.Context, req *api.Request) (*api.Response, error) {
+	resp, _, err := s.QueryWithProfile(ctx, req)
+	return resp, err
+}
+
+// QueryWithProfile handles a query like QueryNoGrpc, and also returns the plan or the
+// profile of the query, see query.ProfileMode.
+func (s *Server) QueryWithProfile(ctx context.Context,
+	req *api.Request) (*api.Response, json.RawMessage, error) {
+	return s.queryNoGrpc(ctx, req, nil)
+}
+
+// streamBatchSize is the number of root-level results sent in every chunk of a streamed
+// query.
+const streamBatchSize = 1000
+
+// StreamQuery handles the queries whose results are streamed over gRPC, with the internal
+// pb.QueryStreamer service rather than api.Dgraph, which can't be extended.
+func (s *Server) StreamQuery(req *api.Request, srv pb.QueryStreamer_StreamQueryServer) error {
+	resp, err := s.QueryStream(srv.Context(), req, func(js []byte) error {
+		return srv.Send(&pb.QueryChunk{Json: js})
+	})
+	if err != nil {
+		return err
+	}
+	return srv.Send(&pb.QueryChunk{Txn: resp.Txn, Latency: resp.Latency, Metrics: resp.Metrics})
+}
+
+// QueryStream handles a query like QueryNoGrpc, but sends the JSON response to send in
+// chunks of root-level results, as soon as they are encoded. The returned response has no
+// JSON. Only read-only DQL queries returning JSON can be streamed.
+func (s *Server) QueryStream(ctx context.Context, req *api.Request,
+	send func([]byte) error) (*api.Response, error) {
+	if len(req.GetMutations()) > 0 || req.GetCommitNow() {
+		return nil, errors.Errorf("Mutations can't be streamed")
+	}
+	if req.GetRespFormat() != api.Request_JSON || getRespFormat(ctx) != "" {
+		return nil, errors.Errorf("Only JSON responses can be streamed")
+	}
+	resp, _, err := s.queryNoGrpc(ctx, req, send)
+	return resp, err
+}
+
+func (s *Server) queryNoGrpc(ctx context.Context, req *api.Request,
+	send func([]byte) error) (*api.Response, json.RawMessage, error) {
 	ctx = x.AttachJWTNamespace(ctx)
 	if x.WorkerConfig.AclEnabled && req.GetStartTs() != 0 {
 		// A fresh StartTs is assigned if it is 0.
 		ns, err := x.ExtractNamespace(ctx)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if req.GetHash() != getHash(ns, req.GetStartTs()) {
-			return nil, x.ErrHashMismatch
+			return nil, nil, x.ErrHashMismatch
 		}
 	}
 	// Add a timeout for queries which don't have a deadline set. We don't want to
@@ -1245,7 +1336,78 @@ func (s *Server) QueryNoGrpc(ctx context.Context, req *api.Request) (*api.Respon
 			defer cancel()
 		}
 	}
-	return s.doQuery(ctx, &Request{req: req, doAuth: getAuthMode(ctx)})
+	r := &Request{req: req, doAuth: getAuthMode(ctx), asOf: getAsOf(ctx),
+		respFormat: getRespFormat(ctx), stream: send}
+	if send == nil {
+		// The plan or the profile is returned in the extensions, which a stream doesn't
+		// have.
+		r.profile = query.GetProfileMode(ctx)
+	}
+	resp, err := s.doQuery(ctx, r)
+	return resp, r.profileJSON, err
+}
+
+// getAsOf returns what the query of ctx reads as of. gRPC clients set it with the "as-of"
+// metadata, and HTTP with AsOf.
+func getAsOf(ctx context.Context) string {
+	return fromContextOrMetadata(ctx, AsOf, "as-of")
+}
+
+// getRespFormat returns the response format of the query package asked for by ctx, if any.
+// gRPC clients set it with the "resp-format" metadata, and HTTP with RespFormat.
+func getRespFormat(ctx context.Context) string {
+	return fromContextOrMetadata(ctx, RespFormat, "resp-format")
+}
+
+// fromContextOrMetadata returns the string value of key in ctx, or else the first value of
+// the incoming gRPC metadata mdKey.
+func fromContextOrMetadata(ctx context.Context, key GraphqlContextKey, mdKey string) string {
+	if v, ok := ctx.Value(key).(string); ok && v != "" {
+		return v
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md[mdKey]) > 0 {
+		return md[mdKey][0]
+	}
+	return ""
+}
+
+// validateAsOf checks that req can read as of a past timestamp, which it can only if it's a
+// query outside of any transaction.
+func validateAsOf(req *api.Request) error {
+	if len(req.Mutations) > 0 {
+		return errors.Errorf("As-of can't be used with mutations")
+	}
+	if req.StartTs != 0 {
+		return errors.Errorf("As-of can't be used within a transaction")
+	}
+	return nil
+}
+
+// resolveAsOf returns the timestamp to read as of, given as a timestamp or an RFC3339 time.
+// It must be within the history retention window, for its versions not to be discarded.
+func resolveAsOf(ctx context.Context, asOf string) (uint64, error) {
+	if x.Config.HistoryRetention == 0 {
+		return 0, errors.Errorf("Reading as of a past timestamp is disabled," +
+			" set --limit history-retention to enable it")
+	}
+	ts, err := strconv.ParseUint(asOf, 10, 64)
+	if err != nil {
+		t, terr := time.Parse(time.RFC3339Nano, asOf)
+		if terr != nil {
+			return 0, errors.Errorf("Invalid as-of %q, expected a timestamp or an RFC3339 time", asOf)
+		}
+		if ts, err = worker.TimestampAt(ctx, t); err != nil {
+			return 0, err
+		}
+	}
+	if historyTs := worker.HistoryTs(); historyTs == 0 || ts < historyTs {
+		return 0, errors.Errorf("Timestamp %d is outside the history retention window of %s",
+			ts, x.Config.HistoryRetention)
+	}
+	if ts > posting.Oracle().MaxAssigned() {
+		return 0, errors.Errorf("Timestamp %d is in the future", ts)
+	}
+	return ts, nil
 }
 
 var pendingQueries int64
@@ -1281,6 +1443,14 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 	// }
 
 	isMutation := len(req.req.Mutations) > 0
+	if isMutation && req.profile == query.Explain {
+		return nil, errors.Errorf("Explain can't be used with mutations")
+	}
+	if req.asOf != "" {
+		if err := validateAsOf(req.req); err != nil {
+			return nil, err
+		}
+	}
 	methodRequest := methodQuery
 	if isMutation {
 		methodRequest = methodMutate
@@ -1339,15 +1509,30 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 	}
 
 	qc := &queryContext{
-		req:      req.req,
-		latency:  l,
-		span:     span,
-		graphql:  isGraphQL,
-		gqlField: req.gqlField,
+		req:        req.req,
+		latency:    l,
+		span:       span,
+		graphql:    isGraphQL,
+		gqlField:   req.gqlField,
+		profile:    req.profile,
+		asOf:       req.asOf,
+		respFormat: req.respFormat,
+		stream:     req.stream,
 	}
 	if rerr = parseRequest(ctx, qc); rerr != nil {
 		return
 	}
+	if asOf := qc.dqlRes.AsOf; asOf != "" {
+		// The query can also say what it reads as of, with the @asof directive.
+		if qc.asOf != "" && qc.asOf != asOf {
+			rerr = errors.Errorf("The as-of option and the @asof directive differ")
+			return
+		}
+		if rerr = validateAsOf(qc.req); rerr != nil {
+			return
+		}
+		qc.asOf = asOf
+	}
 
 	if req.doAuth == NeedAuthorize {
 		if rerr = authorizeRequest(ctx, qc); rerr != nil {
@@ -1395,6 +1580,7 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 	if rerr = s.doMutate(ctx, qc, resp); rerr != nil {
 		return
 	}
+	req.profileJSON = qc.profileJSON
 
 	// TODO(Ahsan): resp.Txn.Preds contain predicates of form gid-namespace|attr.
 	// Remove the namespace from the response.
@@ -1427,6 +1613,8 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 	qr := query.Request{
 		Latency:  qc.latency,
 		DqlQuery: &qc.dqlRes,
+		Profile:  qc.profile,
+		Stream:   qc.stream != nil,
 	}
 
 	// Here we try our best effort to not contact Zero for a timestamp. If we succeed,
@@ -1441,6 +1629,17 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 		qc.span.Annotate([]otrace.Attribute{otrace.BoolAttribute("no", true)}, "")
 	}
 
+	if qc.asOf != "" {
+		ts, err := resolveAsOf(ctx, qc.asOf)
+		if err != nil {
+			return resp, err
+		}
+		// The past versions aren't cached, and nothing can be written at that timestamp.
+		qc.req.StartTs = ts
+		qc.req.ReadOnly = true
+		qr.Cache = worker.NoCache
+	}
+
 	if qc.req.BestEffort {
 		// Sanity: check that request is read-only too.
 		if !qc.req.ReadOnly {
@@ -1495,10 +1694,34 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 			respMap["types"] = formatTypes(er.Types)
 		}
 		resp.Json, err = json.Marshal(respMap)
-	} else if qc.req.RespFormat == api.Request_RDF {
-		resp.Rdf, err = query.ToRDF(qc.latency, er.Subgraphs)
+		if err == nil && qc.stream != nil {
+			// The schema is small, it's sent in a single chunk.
+			err = qc.stream(resp.Json)
+			resp.Json = nil
+		}
+	} else if qc.stream != nil {
+		err = query.StreamJson(ctx, qc.latency, er.Subgraphs, streamBatchSize, er.Metrics,
+			qc.stream)
 	} else {
-		resp.Json, err = query.ToJson(ctx, qc.latency, er.Subgraphs, qc.gqlField)
+		switch {
+		case qc.respFormat != "" && qc.req.RespFormat != api.Request_JSON:
+			err = errors.Errorf("Response format %s can't be used with %s",
+				qc.respFormat, qc.req.RespFormat)
+		case qc.respFormat == query.RespFormatNDJSON:
+			resp.Json, err = query.ToNDJSON(qc.latency, er.Subgraphs)
+		case qc.respFormat == query.RespFormatCSV:
+			resp.Json, err = query.ToCSV(qc.latency, er.Subgraphs)
+		case qc.respFormat == query.RespFormatGraphSON:
+			resp.Json, err = query.ToGraphSON(qc.latency, er.Subgraphs)
+		case qc.respFormat != "":
+			err = errors.Errorf("Invalid response format %q", qc.respFormat)
+		case qc.req.RespFormat == api.Request_JSON:
+			resp.Json, err = query.ToJson(ctx, qc.latency, er.Subgraphs, qc.gqlField)
+		case qc.req.RespFormat == api.Request_RDF:
+			resp.Rdf, err = query.ToRDF(qc.latency, er.Subgraphs)
+		default:
+			err = errors.Errorf("Invalid response format %d", qc.req.RespFormat)
+		}
 	}
 	// if err is just some error from GraphQL encoding, then we need to continue the normal
 	// execution ignoring the error as we still need to assign metrics and latency info to resp.
@@ -1507,6 +1730,12 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 	}
 	qc.span.Annotatef(nil, "Response = %s", resp.Json)
 
+	if len(er.Profile) > 0 {
+		if qc.profileJSON, err = json.Marshal(er.Profile); err != nil {
+			return resp, errors.Wrapf(err, "while marshalling profile")
+		}
+	}
+
 	// varToUID contains a map of variable name to the uids corresponding to it.
 	// It is used later for constructing set and delete mutations by replacing
 	// variables with the actual uids they correspond to.
@@ -1582,6 +1811,11 @@ func parseRequest(ctx context.Context, qc *queryContext) error {
 			if err != nil {
 				return err
 			}
+			if !qc.graphql {
+				if err := validateEmbeddingSources(ctx, gmu); err != nil {
+					return err
+				}
+			}
 
 			qc.gmuList = append(qc.gmuList, gmu)
 		}
@@ -2014,6 +2248,33 @@ func validateForGraphql(nq *api.NQuad, isGraphql bool) error {
 	return nil
 }
 
+// validateEmbeddingSources rejects the DQL mutations of the source predicate of
+// an @embedding field. The embedding is only computed by GraphQL mutations, a DQL
+// mutation of its source would leave it out of date.
+func validateEmbeddingSources(ctx context.Context, gmu *dql.Mutation) error {
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return err
+	}
+	for _, nqs := range [][]*api.NQuad{gmu.Set, gmu.Del} {
+		for _, nq := range nqs {
+			pred, ok, err := embedding.ComputedFrom(ns, nq.Predicate)
+			if err != nil {
+				// A GraphQL schema which can't be loaded doesn't serve any
+				// GraphQL mutation either.
+				glog.Warningf("Unable to check the @embedding sources of namespace %#x: %v",
+					ns, err)
+				return nil
+			}
+			if ok {
+				return errors.Errorf("Cannot mutate %s with DQL, it is the @embedding source of"+
+					" %s which is only computed by GraphQL mutations", nq.Predicate, pred)
+			}
+		}
+	}
+	return nil
+}
+
 func validateNQuads(set, del []*api.NQuad, qc *queryContext) error {
 
 	for _, nq := range set {
@@ -2113,11 +2374,17 @@ func formatTypes(typeList []*pb.TypeUpdate) []map[string]interface{} {
 	for _, typ := range typeList {
 		typeMap := make(map[string]interface{})
 		typeMap["name"] = typ.TypeName
-		fields := make([]map[string]string, len(typ.Fields))
+		if typ.Strict {
+			typeMap["strict"] = true
+		}
+		fields := make([]map[string]interface{}, len(typ.Fields))
 
 		for i, field := range typ.Fields {
-			m := make(map[string]string, 1)
+			m := make(map[string]interface{}, 1)
 			m["name"] = field.Predicate
+			if field.NonNullable {
+				m["required"] = true
+			}
 			fields[i] = m
 		}
 		typeMap["fields"] = fields
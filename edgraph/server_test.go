@@ -21,6 +21,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/metadata"
@@ -28,6 +29,8 @@ import (
 	"github.com/dgraph-io/badger/v4"
 	"github.com/dgraph-io/dgo/v230/protos/api"
 	"github.com/dgraph-io/dgraph/v24/chunker"
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/graphql/embedding"
 	"github.com/dgraph-io/dgraph/v24/schema"
 	"github.com/dgraph-io/dgraph/v24/worker"
 	"github.com/dgraph-io/dgraph/v24/x"
@@ -217,3 +220,72 @@ func TestGetHash(t *testing.T) {
 	worker.Config.AclSecretKeyBytes = x.Sensitive("123456789")
 	require.Equal(t, hex.EncodeToString(h.Sum(nil)), getHash(10, 20))
 }
+
+func TestGetAsOf(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, "", getAsOf(ctx))
+	require.Equal(t, "10", getAsOf(context.WithValue(ctx, AsOf, "10")))
+	md := metadata.Pairs("as-of", "2024-01-02T03:04:05Z")
+	require.Equal(t, "2024-01-02T03:04:05Z", getAsOf(metadata.NewIncomingContext(ctx, md)))
+}
+
+func TestGetRespFormat(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, "", getRespFormat(ctx))
+	require.Equal(t, "csv", getRespFormat(context.WithValue(ctx, RespFormat, "csv")))
+	md := metadata.Pairs("resp-format", "ndjson")
+	require.Equal(t, "ndjson", getRespFormat(metadata.NewIncomingContext(ctx, md)))
+}
+
+func TestResolveAsOf(t *testing.T) {
+	defer func(retention time.Duration) {
+		x.Config.HistoryRetention = retention
+	}(x.Config.HistoryRetention)
+	ctx := context.Background()
+
+	x.Config.HistoryRetention = 0
+	_, err := resolveAsOf(ctx, "10")
+	require.ErrorContains(t, err, "disabled")
+
+	x.Config.HistoryRetention = time.Hour
+	_, err = resolveAsOf(ctx, "yesterday")
+	require.ErrorContains(t, err, "Invalid as-of")
+	// The start of the retention window isn't known yet.
+	_, err = resolveAsOf(ctx, "10")
+	require.ErrorContains(t, err, "outside the history retention window")
+}
+
+func TestValidateAsOf(t *testing.T) {
+	require.NoError(t, validateAsOf(&api.Request{Query: "{}"}))
+	require.ErrorContains(t, validateAsOf(&api.Request{StartTs: 10}), "within a transaction")
+	require.ErrorContains(t, validateAsOf(&api.Request{Mutations: []*api.Mutation{{}}}),
+		"with mutations")
+}
+
+func TestUniqueConflictKeys(t *testing.T) {
+	tuple := func(values ...string) *uniqueTuple {
+		return &uniqueTuple{uid: 1, constraint: []string{"first", "last"}, values: values}
+	}
+	keys := uniqueConflictKeys(0, []*uniqueTuple{tuple("a", "b"), tuple("a", "b")})
+	require.Len(t, keys, 2)
+	// The same tuple set on another node or through other predicates has the same key.
+	require.Equal(t, keys[0], keys[1])
+	require.NotEqual(t, keys[0], uniqueConflictKeys(1, []*uniqueTuple{tuple("a", "b")})[0])
+	require.NotEqual(t, keys[0], uniqueConflictKeys(0, []*uniqueTuple{tuple("a", "c")})[0])
+	// A tuple pointing to a new node can't conflict.
+	require.Empty(t, uniqueConflictKeys(0, []*uniqueTuple{tuple("a", "_:b")}))
+}
+
+func TestValidateEmbeddingSources(t *testing.T) {
+	embedding.SetSources(5, map[string]string{"Product.description": "Product.vector"})
+	defer embedding.SetSources(5, nil)
+	ctx := x.AttachNamespace(context.Background(), 5)
+
+	gmu := &dql.Mutation{Set: []*api.NQuad{{Subject: "0x1", Predicate: "Product.name"}}}
+	require.NoError(t, validateEmbeddingSources(ctx, gmu))
+	gmu.Del = []*api.NQuad{{Subject: "0x1", Predicate: "Product.description"}}
+	require.ErrorContains(t, validateEmbeddingSources(ctx, gmu),
+		"Cannot mutate Product.description with DQL, it is the @embedding source of Product.vector")
+	// The sources of other namespaces don't matter.
+	require.NoError(t, validateEmbeddingSources(x.AttachNamespace(context.Background(), 6), gmu))
+}
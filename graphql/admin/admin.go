@@ -28,6 +28,7 @@ import (
 
 	badgerpb "github.com/dgraph-io/badger/v4/pb"
 	"github.com/dgraph-io/dgraph/v24/edgraph"
+	"github.com/dgraph-io/dgraph/v24/graphql/embedding"
 	"github.com/dgraph-io/dgraph/v24/graphql/resolve"
 	"github.com/dgraph-io/dgraph/v24/graphql/schema"
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
@@ -277,6 +278,50 @@ const (
 		lastUpdated: DateTime
 	}
 
+	input VectorIndexStatsInput {
+		"""
+		Predicates to report on. All predicates with a vector index are reported if not given.
+		"""
+		predicates: [String!]
+
+		"""
+		Number of indexed vectors used as queries to measure the recall of the index against
+		a brute force search, at most 100. The recall is not measured if not given or 0.
+		Every query is compared with all the vectors, so fewer queries are used beyond
+		100000 vectors, and the recall is not measured beyond 10000000 vectors.
+		"""
+		recallSample: Int
+
+		"""
+		Number of nearest neighbors compared when measuring the recall, 10 if not given.
+		"""
+		k: Int
+	}
+
+	type VectorIndexStats {
+		predicate: String
+		index: String
+		vectors: UInt64
+		layers: Int
+		avgDegree: Float
+		estimatedMemoryBytes: UInt64
+		recall: Float
+		recallSample: Int
+		k: Int
+		recallCandidates: Int
+
+		"""
+		True while the index is being built. The graph statistics and the recall are only
+		reported once it is built.
+		"""
+		building: Boolean
+
+		"""
+		Fraction of the nodes indexed so far, while the index is being built.
+		"""
+		buildProgress: Float
+	}
+
 	enum TaskStatus {
 		Queued
 		Running
@@ -398,6 +443,7 @@ const (
 		state: MembershipState
 		config: Config
 		task(input: TaskInput!): TaskPayload
+		vectorIndexStats(input: VectorIndexStatsInput): [VectorIndexStats]
 		` + adminQueries + `
 	}
 
@@ -501,11 +547,12 @@ var (
 		resolve.LoggingMWMutation,
 	}
 	adminQueryMWConfig = map[string]resolve.QueryMiddlewares{
-		"health":       minimalAdminQryMWs, // dgraph checks Guardian auth for health
-		"state":        minimalAdminQryMWs, // dgraph checks Guardian auth for state
-		"config":       gogQryMWs,
-		"listBackups":  gogQryMWs,
-		"getGQLSchema": stdAdminQryMWs,
+		"health":           minimalAdminQryMWs, // dgraph checks Guardian auth for health
+		"state":            minimalAdminQryMWs, // dgraph checks Guardian auth for state
+		"config":           gogQryMWs,
+		"listBackups":      gogQryMWs,
+		"getGQLSchema":     stdAdminQryMWs,
+		"vectorIndexStats": stdAdminQryMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"queryUser":      minimalAdminQryMWs,
@@ -655,6 +702,7 @@ func newAdminResolver(
 		gqlServer:         defaultGqlServer,
 	}
 	adminServerVar = server // store the admin server in package variable
+	embedding.SetSourcesLoader(LazyLoadSchema)
 
 	prefix := x.DataKey(x.GalaxyAttr(worker.GqlSchemaPred), 0)
 	// Remove uid from the key, to get the correct prefix
@@ -782,6 +830,9 @@ func newAdminResolverFactory() resolve.ResolverFactory {
 		WithQueryResolver("task", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(resolveTask)
 		}).
+		WithQueryResolver("vectorIndexStats", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveVectorIndexStats)
+		}).
 		WithQueryResolver("getGQLSchema", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(
 				func(ctx context.Context, query schema.Query) *resolve.Resolved {
@@ -1020,6 +1071,7 @@ func (as *adminServer) resetSchema(ns uint64, gqlSchema schema.Schema) {
 
 	resolvers := resolve.New(gqlSchema, resolverFactory)
 	as.gqlServer.Set(ns, as.getGlobalEpoch(ns), resolvers)
+	embedding.SetSources(ns, gqlSchema.EmbeddingSources())
 
 	// reset status to up, as now we are serving the new schema
 	mainHealthStore.up()
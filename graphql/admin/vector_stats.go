@@ -0,0 +1,137 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v24/graphql/resolve"
+	"github.com/dgraph-io/dgraph/v24/graphql/schema"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/worker"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+type vectorIndexStatsInput struct {
+	Predicates   []string
+	RecallSample int
+	K            int
+}
+
+func resolveVectorIndexStats(ctx context.Context, q schema.Query) *resolve.Resolved {
+	input, err := getVectorIndexStatsInput(q)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	if input.RecallSample < 0 || input.K < 0 {
+		return resolve.EmptyResult(q, errors.Errorf("recallSample and k must not be negative"))
+	}
+
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	attrs := make([]string, 0, len(input.Predicates))
+	for _, pred := range input.Predicates {
+		attrs = append(attrs, x.NamespaceAttr(ns, pred))
+	}
+	if len(attrs) == 0 {
+		if attrs, err = vectorIndexedAttrs(ctx, ns); err != nil {
+			return resolve.EmptyResult(q, err)
+		}
+	}
+
+	stats := make([]map[string]interface{}, 0, len(attrs))
+	for _, attr := range attrs {
+		req := &pb.VectorIndexStatsRequest{
+			Predicate:    attr,
+			RecallSample: uint32(input.RecallSample),
+			K:            uint32(input.K),
+		}
+		resp, err := worker.VectorIndexStatsOverNetwork(ctx, req)
+		if err != nil {
+			return resolve.EmptyResult(q, errors.Wrapf(err,
+				"couldn't get vector index stats of %s", x.ParseAttr(attr)))
+		}
+		stat := map[string]interface{}{
+			"predicate":            x.ParseAttr(resp.GetPredicate()),
+			"index":                resp.GetIndex(),
+			"vectors":              json.Number(strconv.FormatUint(resp.GetVectors(), 10)),
+			"layers":               resp.GetLayers(),
+			"avgDegree":            resp.GetAvgDegree(),
+			"estimatedMemoryBytes": json.Number(strconv.FormatUint(resp.GetEstimatedMemoryBytes(), 10)),
+			"recall":               nil,
+			"recallSample":         resp.GetRecallSample(),
+			"k":                    nil,
+			"recallCandidates":     nil,
+			"building":             resp.GetBuilding(),
+			"buildProgress":        nil,
+		}
+		if resp.GetK() > 0 {
+			stat["k"] = resp.GetK()
+		}
+		if resp.GetRecallSample() > 0 {
+			stat["recall"] = resp.GetRecall()
+			stat["recallCandidates"] = resp.GetRecallCandidates()
+		}
+		if resp.GetBuilding() {
+			stat["buildProgress"] = resp.GetBuildProgress()
+		}
+		stats = append(stats, stat)
+	}
+
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): stats}, nil)
+}
+
+// vectorIndexedAttrs returns the predicates of namespace ns that have a vector
+// index, sorted by name.
+func vectorIndexedAttrs(ctx context.Context, ns uint64) ([]string, error) {
+	nodes, err := worker.GetSchemaOverNetwork(ctx, &pb.SchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	var attrs []string
+	for _, node := range nodes {
+		if len(node.GetIndexSpecs()) == 0 || x.ParseNamespace(node.GetPredicate()) != ns {
+			continue
+		}
+		attrs = append(attrs, node.GetPredicate())
+	}
+	sort.Strings(attrs)
+	return attrs, nil
+}
+
+func getVectorIndexStatsInput(q schema.Query) (*vectorIndexStatsInput, error) {
+	var input vectorIndexStatsInput
+	inputArg := q.ArgValue(schema.InputArgName)
+	if inputArg == nil {
+		return &input, nil
+	}
+	inputBytes, err := json.Marshal(inputArg)
+	if err != nil {
+		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+	if err := json.Unmarshal(inputBytes, &input); err != nil {
+		return nil, schema.GQLWrapf(err, "couldn't get input argument")
+	}
+	return &input, nil
+}
@@ -159,7 +159,8 @@ func writeUIDFunc(b *strings.Builder, uids []uint64, args []dql.Arg) {
 // writeRoot writes the root function as well as any ordering and paging
 // specified in q.
 //
-// Only uid(0x123, 0x124), type(...) and eq(Type.Predicate, ...) functions are supported at root.
+// Only uid(0x123, 0x124), type(...), eq(Type.Predicate, ...), similar_to(...) and
+// hybrid_search(...) functions are supported at root.
 // Multiple arguments for `eq` filter will be required in case of resolving `entities` query.
 func writeRoot(b *strings.Builder, q *dql.GraphQuery) {
 	if q.Func == nil {
@@ -179,8 +180,8 @@ func writeRoot(b *strings.Builder, q *dql.GraphQuery) {
 		x.Check2(b.WriteString("(func: eq("))
 		writeFilterArguments(b, q.Func.Args)
 		x.Check2(b.WriteRune(')'))
-	case q.Func.Name == "similar_to":
-		x.Check2(b.WriteString("(func: similar_to("))
+	case q.Func.Name == "similar_to" || q.Func.Name == "hybrid_search":
+		x.Check2(b.WriteString(fmt.Sprintf("(func: %s(", q.Func.Name)))
 		writeFilterArguments(b, q.Func.Args)
 		x.Check2(b.WriteRune(')'))
 	}
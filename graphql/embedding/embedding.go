@@ -0,0 +1,336 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package embedding computes the embeddings of the fields declared with
+// @embedding(source: ..., provider: ...) in a GraphQL schema.
+//
+// The embeddings are computed by GraphQL mutations only, as the providers
+// are declared in the GraphQL schema. DQL mutations of a source predicate are
+// rejected, as they would leave its embedding out of date, see ComputedFrom.
+package embedding
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"plugin"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/dgryski/go-farm"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+// StubName is the name of the provider computing embeddings locally, see
+// StubProvider.
+const StubName = "stub"
+
+// StubDimension is the number of dimensions of the embeddings computed by
+// StubProvider.
+const StubDimension = 8
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]Provider{StubName: StubProvider{}}
+
+	httpClient = &http.Client{Timeout: time.Minute}
+
+	configMu sync.RWMutex
+	// allowedEndpoints are the URLs the HTTP providers can call, see
+	// Configure.
+	allowedEndpoints []string
+	// timeout bounds the time taken by EmbedAll.
+	timeout = 30 * time.Second
+	// batchSize is the maximum number of texts sent to a provider at once.
+	batchSize = 64
+	// maxReplySize is the maximum number of bytes read from the reply of an
+	// HTTP provider.
+	maxReplySize int64 = 32 << 20
+
+	sourcesMu sync.RWMutex
+	// sources maps a namespace to the predicates which are the source of an
+	// @embedding field of its GraphQL schema, each to the predicate of the
+	// embedding, see SetSources.
+	sources = make(map[uint64]map[string]string)
+	// loadSources loads the GraphQL schema of a namespace, see SetSourcesLoader.
+	loadSources func(ns uint64) error
+)
+
+// Provider computes the embeddings of texts.
+type Provider interface {
+	// Embed returns the embedding of each of the texts, in the same order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// PluginProvider is implemented by the providers loaded from a Go plugin,
+// see LoadCustomProvider.
+type PluginProvider interface {
+	// Name is the name used for the provider in @embedding(provider: ...).
+	Name() string
+	// Embed returns the embedding of each of the texts, in the same order.
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// Configure sets the endpoints that HTTP providers are allowed to call, how
+// long EmbedAll may take, how many texts are sent to a provider at once and
+// how many bytes are read from the reply of an HTTP provider. An endpoint allows the URL equal to it, or every URL under it if it ends
+// with a /. No HTTP provider can be used until endpoints are allowed, as the
+// URLs come from the GraphQL schema, and calling any of them would let whoever
+// can change the schema send requests from the alpha to any host it can reach.
+func Configure(endpoints []string, t time.Duration, size int, replySize int64) {
+	configMu.Lock()
+	defer configMu.Unlock()
+	allowedEndpoints, timeout, batchSize, maxReplySize = endpoints, t, size, replySize
+}
+
+// endpointAllowed returns whether url is allowed by Configure.
+func endpointAllowed(url string) bool {
+	configMu.RLock()
+	defer configMu.RUnlock()
+	for _, endpoint := range allowedEndpoints {
+		if url == endpoint || (strings.HasSuffix(endpoint, "/") && strings.HasPrefix(url, endpoint)) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSources records the source predicates of the @embedding fields of the
+// GraphQL schema of namespace ns, each mapped to the predicate of its embedding.
+func SetSources(ns uint64, preds map[string]string) {
+	sourcesMu.Lock()
+	defer sourcesMu.Unlock()
+	sources[ns] = preds
+}
+
+// SetSourcesLoader sets the function loading the GraphQL schema of a
+// namespace, which must call SetSources. ComputedFrom calls it for the
+// namespaces whose GraphQL schema hasn't been loaded yet.
+func SetSourcesLoader(load func(ns uint64) error) {
+	sourcesMu.Lock()
+	defer sourcesMu.Unlock()
+	loadSources = load
+}
+
+// ComputedFrom returns the predicate of the embedding computed from pred in
+// namespace ns, and whether pred is the source of an embedding.
+func ComputedFrom(ns uint64, pred string) (string, bool, error) {
+	sourcesMu.RLock()
+	preds, loaded := sources[ns]
+	load := loadSources
+	sourcesMu.RUnlock()
+	if !loaded && load != nil {
+		if err := load(ns); err != nil {
+			return "", false, err
+		}
+		sourcesMu.RLock()
+		preds = sources[ns]
+		sourcesMu.RUnlock()
+	}
+	embedding, ok := preds[pred]
+	return embedding, ok, nil
+}
+
+// Request asks for the embedding of Text by the provider named Provider.
+type Request struct {
+	Provider string
+	Text     string
+}
+
+// EmbedAll computes the embeddings of all the requests. The texts of each
+// provider are sent in batches of the size set by Configure, and computing all
+// the embeddings fails if it takes longer than the timeout set by Configure.
+func EmbedAll(ctx context.Context, reqs []Request) (map[Request][]float32, error) {
+	configMu.RLock()
+	t, size := timeout, batchSize
+	configMu.RUnlock()
+	if t > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, t)
+		defer cancel()
+	}
+
+	texts := make(map[string][]string)
+	var names []string
+	res := make(map[Request][]float32, len(reqs))
+	for _, req := range reqs {
+		if _, ok := res[req]; ok {
+			continue
+		}
+		res[req] = nil
+		if _, ok := texts[req.Provider]; !ok {
+			names = append(names, req.Provider)
+		}
+		texts[req.Provider] = append(texts[req.Provider], req.Text)
+	}
+	for _, name := range names {
+		p, err := Get(name)
+		if err != nil {
+			return nil, err
+		}
+		all := texts[name]
+		for start := 0; start < len(all); start += size {
+			batch := all[start:min(start+size, len(all))]
+			vecs, err := p.Embed(ctx, batch)
+			if err != nil {
+				if ctx.Err() == context.DeadlineExceeded {
+					return nil, errors.Errorf("computing embeddings took longer than %s", t)
+				}
+				return nil, err
+			}
+			if len(vecs) != len(batch) {
+				return nil, errors.Errorf("embedding provider %s returned %d embeddings for %d"+
+					" texts", name, len(vecs), len(batch))
+			}
+			for i, text := range batch {
+				res[Request{Provider: name, Text: text}] = vecs[i]
+			}
+		}
+	}
+	return res, nil
+}
+
+// Register makes p available under name. It replaces any provider that was
+// registered with the same name before.
+func Register(name string, p Provider) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	providers[name] = p
+}
+
+// Get returns the provider for name. A name that is an http or https URL gives
+// a provider calling that endpoint, see HTTPProvider.
+func Get(name string) (Provider, error) {
+	if strings.HasPrefix(name, "http://") || strings.HasPrefix(name, "https://") {
+		return HTTPProvider{URL: name}, nil
+	}
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+	p, ok := providers[name]
+	if !ok {
+		return nil, errors.Errorf("unknown embedding provider %q", name)
+	}
+	return p, nil
+}
+
+// LoadCustomProvider reads and registers an embedding provider from the given
+// plugin file. The plugin must export a function named EmbeddingProvider,
+// returning a PluginProvider.
+func LoadCustomProvider(soFile string) {
+	glog.Infof("Loading custom embedding provider from %q", soFile)
+	pl, err := plugin.Open(soFile)
+	x.Checkf(err, "could not open custom embedding provider plugin file")
+	symb, err := pl.Lookup("EmbeddingProvider")
+	x.Checkf(err, `could not find symbol "EmbeddingProvider" while loading custom embedding `+
+		`provider: %v`, err)
+
+	// Let any type assertion panics occur, like for custom tokenizers.
+	provider := symb.(func() interface{})().(PluginProvider)
+	name := provider.Name()
+	x.AssertTruef(name != StubName && !strings.Contains(name, "://"),
+		"custom embedding provider can't be named %q", name)
+	Register(name, provider)
+}
+
+// StubProvider computes embeddings locally, without any model. Every word of
+// the text, ignoring case, adds one to a dimension picked by hashing the word.
+// Texts sharing words hence get similar embeddings, which is enough to use
+// @embedding(source: ...) in tests.
+type StubProvider struct{}
+
+// Embed implements Provider.
+func (StubProvider) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vecs := make([][]float32, len(texts))
+	for i, text := range texts {
+		vecs[i] = make([]float32, StubDimension)
+		words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+		for _, word := range words {
+			vecs[i][farm.Fingerprint64([]byte(word))%StubDimension]++
+		}
+	}
+	return vecs, nil
+}
+
+// HTTPProvider computes embeddings by calling an HTTP endpoint, which must be
+// allowed by Configure. The endpoint receives a POST request with the body
+// {"texts": ["...", ...]} and must reply with {"embeddings": [[...], ...]},
+// holding the embedding of each text in the same order.
+type HTTPProvider struct {
+	URL string
+}
+
+// Embed implements Provider.
+func (h HTTPProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	if !endpointAllowed(h.URL) {
+		return nil, errors.Errorf("embedding provider %s is not an allowed endpoint, see"+
+			" --graphql embedding-endpoints", h.URL)
+	}
+	body, err := json.Marshal(map[string][]string{"texts": texts})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while calling embedding provider %s", h.URL)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			glog.Warningf("error closing body: %v", err)
+		}
+	}()
+	configMu.RLock()
+	limit := maxReplySize
+	configMu.RUnlock()
+	b, err := io.ReadAll(io.LimitReader(resp.Body, limit+1))
+	if err != nil {
+		return nil, errors.Wrapf(err, "while reading reply of embedding provider %s", h.URL)
+	}
+	if int64(len(b)) > limit {
+		return nil, errors.Errorf("reply of embedding provider %s is larger than %d bytes, see"+
+			" --graphql embedding-max-reply-mb", h.URL, limit)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("embedding provider %s replied with status %d: %s", h.URL,
+			resp.StatusCode, b)
+	}
+	var reply struct {
+		Embeddings [][]float32 `json:"embeddings"`
+	}
+	if err := json.Unmarshal(b, &reply); err != nil {
+		return nil, errors.Wrapf(err, "while decoding reply of embedding provider %s", h.URL)
+	}
+	for _, vec := range reply.Embeddings {
+		if len(vec) == 0 {
+			return nil, errors.Errorf("embedding provider %s replied without an embedding", h.URL)
+		}
+	}
+	return reply.Embeddings, nil
+}
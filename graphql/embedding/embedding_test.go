@@ -0,0 +1,184 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package embedding
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type constProvider []float32
+
+func (c constProvider) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vecs := make([][]float32, len(texts))
+	for i := range vecs {
+		vecs[i] = c
+	}
+	return vecs, nil
+}
+
+// batchProvider records the texts of each call to Embed, and embeds a text
+// as its length.
+type batchProvider struct {
+	batches [][]string
+	delay   time.Duration
+}
+
+func (b *batchProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	b.batches = append(b.batches, texts)
+	select {
+	case <-time.After(b.delay):
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	vecs := make([][]float32, len(texts))
+	for i, text := range texts {
+		vecs[i] = []float32{float32(len(text))}
+	}
+	return vecs, nil
+}
+
+func TestStubProvider(t *testing.T) {
+	p, err := Get(StubName)
+	require.NoError(t, err)
+
+	vecs, err := p.Embed(context.Background(),
+		[]string{"A red apple, a red car.", "car RED apple a red a", ""})
+	require.NoError(t, err)
+	require.Len(t, vecs, 3)
+	require.Len(t, vecs[0], StubDimension)
+	var words float32
+	for _, v := range vecs[0] {
+		words += v
+	}
+	require.Equal(t, float32(6), words)
+	require.Equal(t, vecs[0], vecs[1])
+	require.Equal(t, make([]float32, StubDimension), vecs[2])
+}
+
+func TestRegister(t *testing.T) {
+	_, err := Get("const")
+	require.Error(t, err)
+
+	Register("const", constProvider{1, 2})
+	p, err := Get("const")
+	require.NoError(t, err)
+	vecs, err := p.Embed(context.Background(), []string{"anything"})
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{1, 2}}, vecs)
+}
+
+func TestEmbedAll(t *testing.T) {
+	defer Configure(nil, 30*time.Second, 64, 32<<20)
+	Configure(nil, time.Second, 2, 32<<20)
+	p := &batchProvider{}
+	Register("batch", p)
+
+	reqs := []Request{
+		{Provider: "batch", Text: "a"},
+		{Provider: StubName, Text: "a"},
+		{Provider: "batch", Text: "bb"},
+		{Provider: "batch", Text: "a"},
+		{Provider: "batch", Text: "ccc"},
+	}
+	res, err := EmbedAll(context.Background(), reqs)
+	require.NoError(t, err)
+	// The texts are sent once, in batches of at most 2.
+	require.Equal(t, [][]string{{"a", "bb"}, {"ccc"}}, p.batches)
+	require.Len(t, res, 4)
+	require.Equal(t, []float32{3}, res[Request{Provider: "batch", Text: "ccc"}])
+	require.Len(t, res[Request{Provider: StubName, Text: "a"}], StubDimension)
+
+	p.delay = time.Minute
+	_, err = EmbedAll(context.Background(), reqs[:1])
+	require.ErrorContains(t, err, "took longer than 1s")
+}
+
+func TestHTTPProvider(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Texts []string `json:"texts"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		if req.Texts[0] == "fail" {
+			http.Error(w, "no model", http.StatusInternalServerError)
+			return
+		}
+		vecs := make([][]float32, len(req.Texts))
+		for i, text := range req.Texts {
+			vecs[i] = []float32{0.5, float32(len(text))}
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]interface{}{"embeddings": vecs}))
+	}))
+	defer srv.Close()
+
+	p, err := Get(srv.URL + "/embed")
+	require.NoError(t, err)
+	// The endpoints have to be allowed.
+	_, err = p.Embed(context.Background(), []string{"abc"})
+	require.ErrorContains(t, err, "not an allowed endpoint")
+
+	defer Configure(nil, 30*time.Second, 64, 32<<20)
+	Configure([]string{srv.URL + "/emb"}, time.Minute, 64, 32<<20)
+	_, err = p.Embed(context.Background(), []string{"abc"})
+	require.ErrorContains(t, err, "not an allowed endpoint")
+
+	Configure([]string{srv.URL + "/"}, time.Minute, 64, 32<<20)
+	vecs, err := p.Embed(context.Background(), []string{"abc", "de"})
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{0.5, 3}, {0.5, 2}}, vecs)
+
+	_, err = p.Embed(context.Background(), []string{"fail"})
+	require.ErrorContains(t, err, "status 500")
+
+	// The reply is read up to the configured size.
+	Configure([]string{srv.URL + "/"}, time.Minute, 64, 16)
+	_, err = p.Embed(context.Background(), []string{"abc", "de"})
+	require.ErrorContains(t, err, "larger than 16 bytes")
+}
+
+func TestComputedFrom(t *testing.T) {
+	defer SetSourcesLoader(nil)
+	var loads []uint64
+	SetSourcesLoader(func(ns uint64) error {
+		loads = append(loads, ns)
+		SetSources(ns, map[string]string{"Product.description": "Product.embedding"})
+		return nil
+	})
+
+	embedding, ok, err := ComputedFrom(7, "Product.description")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "Product.embedding", embedding)
+	_, ok, err = ComputedFrom(7, "Product.name")
+	require.NoError(t, err)
+	require.False(t, ok)
+	// The GraphQL schema of a namespace is only loaded once.
+	require.Equal(t, []uint64{7}, loads)
+
+	SetSources(7, nil)
+	_, ok, err = ComputedFrom(7, "Product.description")
+	require.NoError(t, err)
+	require.False(t, ok)
+	require.Equal(t, []uint64{7}, loads)
+}
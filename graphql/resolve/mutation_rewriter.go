@@ -29,6 +29,7 @@ import (
 
 	dgoapi "github.com/dgraph-io/dgo/v230/protos/api"
 	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/graphql/embedding"
 	"github.com/dgraph-io/dgraph/v24/graphql/schema"
 	"github.com/dgraph-io/dgraph/v24/x"
 )
@@ -103,6 +104,9 @@ type xidMetadata struct {
 	seenAtTopLevel map[string]bool
 	// seenUIDs tells whether the UID is previously been seen during DFS traversal
 	seenUIDs map[string]bool
+	// embeddings stores the embeddings of the @embedding fields of the mutation,
+	// computed in batches before rewriting it, see computeEmbeddings.
+	embeddings map[embedding.Request][]float32
 }
 
 // A mutationBuilder can build a json mutation []byte from a mutationFragment
@@ -473,6 +477,10 @@ func (arw *AddRewriter) Rewrite(
 		mutationType = AddWithUpsert
 	}
 
+	if err := xidMetadata.computeEmbeddings(ctx, mutatedType, val); err != nil {
+		return ret, schema.GQLWrapf(err, "failed to rewrite mutation payload")
+	}
+
 	for _, i := range val {
 		obj := i.(map[string]interface{})
 		fragment, upsertVar, errs := rewriteObject(
@@ -647,6 +655,10 @@ func (urw *UpdateRewriter) Rewrite(
 
 	if setArg != nil {
 		if len(objSet) != 0 {
+			err := xidMetadata.computeEmbeddings(ctx, mutatedType, []interface{}{objSet})
+			if err != nil {
+				return ret, schema.GQLWrapf(err, "failed to rewrite mutation payload")
+			}
 			fragment, _, errs := rewriteObject(
 				ctx,
 				mutatedType,
@@ -1656,6 +1668,12 @@ func rewriteObject(
 		}(parentFragment.check, childFragment.check)
 	}
 
+	obj, err := addEmbeddings(ctx, typ, obj, mutationType, xidMetadata.embeddings)
+	if err != nil {
+		retErrors = append(retErrors, err)
+		return nil, upsertVar, retErrors
+	}
+
 	// Iterate on fields and call the same function recursively.
 	var fields []string
 	for field := range obj {
@@ -1675,7 +1693,7 @@ func rewriteObject(
 			fieldName = fieldName[1 : len(fieldName)-1]
 		}
 
-		if fieldDef.HasEmbeddingDirective() {
+		if fieldDef.HasEmbeddingDirective() && val != nil {
 			// embedding is a JSON array of numbers. Rewrite it as a string, for now
 			var valBytes []byte
 			valBytes, _ = json.Marshal(val)
@@ -1783,6 +1801,113 @@ func rewriteObject(
 	return frag, upsertVar, retErrors
 }
 
+// computeEmbeddings computes the embeddings that the rewriting of objs, the
+// objects of type typ given to a mutation, needs for the fields declared with
+// @embedding(source: ..., provider: ...), see addEmbeddings. They are computed
+// together, which sends them to their providers in batches, rather than one
+// by one as the objects are rewritten.
+func (xidMetadata *xidMetadata) computeEmbeddings(ctx context.Context, typ schema.Type,
+	objs []interface{}) error {
+	var reqs []embedding.Request
+	for _, obj := range objs {
+		if obj, ok := obj.(map[string]interface{}); ok {
+			reqs = embeddingRequests(typ, obj, reqs)
+		}
+	}
+	if len(reqs) == 0 {
+		return nil
+	}
+	embeddings, err := embedding.EmbedAll(ctx, reqs)
+	if err != nil {
+		return err
+	}
+	xidMetadata.embeddings = embeddings
+	return nil
+}
+
+// embeddingRequests appends to reqs the embeddings to compute for obj, an
+// object of type typ, and for the objects nested in it.
+func embeddingRequests(typ schema.Type, obj map[string]interface{},
+	reqs []embedding.Request) []embedding.Request {
+	for _, fd := range typ.Fields() {
+		val, ok := obj[fd.Name()]
+		if source := fd.EmbeddingSource(); source != "" {
+			if text, isText := obj[source].(string); isText && !ok {
+				reqs = append(reqs, embedding.Request{Provider: fd.EmbeddingProvider(), Text: text})
+			}
+			continue
+		}
+		switch val := val.(type) {
+		case map[string]interface{}:
+			reqs = embeddingRequests(fd.Type(), val, reqs)
+		case []interface{}:
+			for _, v := range val {
+				if v, isObj := v.(map[string]interface{}); isObj {
+					reqs = embeddingRequests(fd.Type(), v, reqs)
+				}
+			}
+		}
+	}
+	return reqs
+}
+
+// addEmbeddings returns obj with the embeddings computed for the fields of typ
+// declared with @embedding(source: ..., provider: ...). An embedding is
+// computed when its source is set, unless obj sets the embedding itself.
+// Removing the source with null also removes the embedding. The embeddings
+// are taken from computed, and only the ones missing from it are computed
+// here.
+func addEmbeddings(ctx context.Context, typ schema.Type, obj map[string]interface{},
+	mutationType MutationType, computed map[embedding.Request][]float32) (
+	map[string]interface{}, error) {
+	var res map[string]interface{}
+	for _, fd := range typ.Fields() {
+		source := fd.EmbeddingSource()
+		if source == "" {
+			continue
+		}
+		val, ok := obj[source]
+		if _, set := obj[fd.Name()]; !ok || set {
+			continue
+		}
+		if res == nil {
+			res = make(map[string]interface{}, len(obj)+1)
+			for k, v := range obj {
+				res[k] = v
+			}
+		}
+		if mutationType == UpdateWithRemove {
+			if val == nil {
+				res[fd.Name()] = nil
+			}
+			continue
+		}
+		text, ok := val.(string)
+		if !ok {
+			// A null in set is ignored, so is the embedding.
+			continue
+		}
+		req := embedding.Request{Provider: fd.EmbeddingProvider(), Text: text}
+		vec, ok := computed[req]
+		if !ok {
+			embeddings, err := embedding.EmbedAll(ctx, []embedding.Request{req})
+			if err != nil {
+				return nil, errors.Wrapf(err, "couldn't compute %s from %s", fd.Name(), source)
+			}
+			vec = embeddings[req]
+		}
+		embed := make([]interface{}, len(vec))
+		for i, v := range vec {
+			embed[i] = json.Number(strconv.FormatFloat(float64(v), 'f', -1, 32))
+		}
+		res[fd.Name()] = embed
+	}
+	if res == nil {
+		return obj, nil
+	}
+	return res, nil
+}
+
 func xidErrorForInterfaceType(typ schema.Type, xidString string, xid schema.FieldDefinition,
 	interfaceName string) error {
 
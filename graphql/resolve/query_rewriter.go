@@ -633,8 +633,7 @@ func rewriteAsGet(
 //			        v1 as max(val(vec))
 //			    }
 //			    var(func: similar_to(Product.embedding, 8, val(v1))) {
-//			        v2 as Product.embedding
-//			        distance as math((v2 - v1) dot (v2 - v1))
+//			        distance as _distance_
 //			    }
 //			    querySimilarProductById(func: uid(distance)
 //	             @filter(Product.id != "0528012398"), orderasc: val(distance)) {
@@ -657,15 +656,6 @@ func rewriteAsSimilarByIdQuery(
 	similarBy := query.ArgValue(schema.SimilarByArgName).(string)
 	pred := typ.DgraphPredicate(similarBy)
 	topK := query.ArgValue(schema.SimilarTopKArgName)
-	similarByField := typ.Field(similarBy)
-	metric := similarByField.EmbeddingSearchMetric()
-	distanceFormula := "math(sqrt((v2 - v1) dot (v2 - v1)))" // default - euclidian
-
-	if metric == schema.SimilarSearchMetricDotProduct {
-		distanceFormula = "math((1.0 - (v1 dot v2)) /2.0)"
-	} else if metric == schema.SimilarSearchMetricCosine {
-		distanceFormula = "math((1.0 - ((v1 dot v2) / sqrt( (v1 dot v1) * (v2 dot v2) ) )) / 2.0)"
-	}
 
 	// First generate the query to fetch the uid
 	// for the given id. For Example,
@@ -702,23 +692,18 @@ func rewriteAsSimilarByIdQuery(
 		},
 	}
 
-	// Similar_to query, computes the distance for
-	// ordering the result later.
+	// Similar_to query, remembers the distance computed by the
+	// vector index for ordering the result later.
 	// Example:
 	// var(func: similar_to(Product.embedding, 8, val(v1))) {
-	//	  v2 as Product.embedding
-	//	  distance as math((v2 - v1) dot (v2 - v1))
+	//	  distance as _distance_
 	//  }
 	similarQuery := &dql.GraphQuery{
 		Attr: "var",
 		Children: []*dql.GraphQuery{
-			{
-				Var:  "v2",
-				Attr: pred,
-			},
 			{
 				Var:  "distance",
-				Attr: distanceFormula,
+				Attr: dql.VectorDistanceAttr,
 			},
 		},
 		Func: &dql.Function{
@@ -787,8 +772,7 @@ func rewriteAsSimilarByIdQuery(
 //
 //		query gQLTodQL($search_vector: float32vector = "<json array of float>") {
 //		    var(func: similar_to(Product.embedding, 8, $search_vector)) {
-//		        v2 as Product.embedding
-//		        distance as math((v2 - $search_vector) dot (v2 - $search_vector))
+//		        distance as _distance_
 //		    }
 //		    querySimilarProductById(func: uid(distance),
 //	             @filter(Product.id != "0528012398"), orderasc: val(distance)) {
@@ -817,17 +801,6 @@ func rewriteAsSimilarByEmbeddingQuery(
 	vec := query.ArgValue(schema.SimilarVectorArgName).([]interface{})
 	vecStr, _ := json.Marshal(vec)
 
-	similarByField := typ.Field(similarBy)
-	metric := similarByField.EmbeddingSearchMetric()
-	distanceFormula := "math(sqrt((v2 - $search_vector) dot (v2 - $search_vector)))" // default = euclidian
-
-	if metric == schema.SimilarSearchMetricDotProduct {
-		distanceFormula = "math(( 1.0 - (($search_vector) dot v2)) /2.0)"
-	} else if metric == schema.SimilarSearchMetricCosine {
-		distanceFormula = "math((1.0 - ( (($search_vector) dot v2) / sqrt( (($search_vector) dot ($search_vector))" +
-			" * (v2 dot v2) ) )) / 2.0)"
-	}
-
 	// Save vectorString as a query variable, $search_vector
 	queryArgs := dgQuery[0].Args
 	if queryArgs == nil {
@@ -860,52 +833,89 @@ func rewriteAsSimilarByEmbeddingQuery(
 		},
 	}
 
-	// Compute the euclidian distance between the neighbor
-	// and the search vector
+	// Remember the distance between the neighbor and the search
+	// vector, as computed by the vector index
 	dgQuery[0].Children = []*dql.GraphQuery{
-		{
-			Var:  "v2",
-			Attr: pred,
-		},
 		{
 			Var:  "distance",
-			Attr: distanceFormula,
+			Attr: dql.VectorDistanceAttr,
 		},
 	}
 
-	// Rename distance as <Type>.vector_distance
-	distance := &dql.GraphQuery{
-		Alias: typ.Name() + "." + schema.SimilarQueryDistanceFieldName,
-		Attr:  "val(distance)",
+	// With a text argument, fuse the neighbors with the nodes matching
+	// the text, and order by the fused score instead of the distance.
+	sortVar, desc := "distance", false
+	if text, ok := query.ArgValue(schema.SimilarTextArgName).(map[string]interface{}); ok {
+		dgQuery[0].Func = hybridSearchFunction(typ, pred, topK, "$search_vector", text)
+		dgQuery[0].Children = append(dgQuery[0].Children, &dql.GraphQuery{
+			Var:  "score",
+			Attr: dql.HybridScoreAttr,
+		})
+		result = addValueField(result, typ.Name()+"."+schema.HybridScoreFieldName, "val(score)")
+		sortVar, desc = "score", true
 	}
 
-	var found bool = false
-	for _, child := range result {
-		if child.Alias == typ.Name()+"."+schema.SimilarQueryDistanceFieldName {
-			child.Attr = "val(distance)"
-			found = true
-			break
-		}
-	}
-	if !found {
-		result = append(result, distance)
-	}
+	// Rename distance as <Type>.vector_distance
+	result = addValueField(result, typ.Name()+"."+schema.SimilarQueryDistanceFieldName,
+		"val(distance)")
 
-	// order by distance
+	// order by distance, or by score for a hybrid search
 	sortQuery := &dql.GraphQuery{
 		Attr:     query.DgraphAlias(),
 		Children: result,
 		Func: &dql.Function{
 			Name: "uid",
-			Args: []dql.Arg{{Value: "distance"}},
+			Args: []dql.Arg{{Value: sortVar}},
 		},
-		Order: []*pb.Order{{Attr: "val(distance)", Desc: false}},
+		Order: []*pb.Order{{Attr: "val(" + sortVar + ")", Desc: desc}},
 	}
 
 	dgQuery = append(dgQuery, sortQuery)
 	return dgQuery
 }
 
+// hybridSearchFunction returns the hybrid_search function that fuses the
+// topK nearest neighbors of vec in pred with the nodes matching the text
+// argument of a similarity query on typ. For example, with
+// text: {field: description, terms: "red shoes", fusion: WEIGHTED, weight: 0.7}
+//
+//	hybrid_search(Product.embedding, 8, $search_vector, Product.description,
+//		"red shoes", "weighted", "0.7")
+func hybridSearchFunction(typ schema.Type, pred string, topK interface{}, vec string,
+	text map[string]interface{}) *dql.Function {
+	field, _ := text[schema.HybridTextFieldArgName].(string)
+	terms, _ := text[schema.HybridTextTermsArgName].(string)
+	args := []dql.Arg{
+		{Value: pred},
+		{Value: fmt.Sprintf("%v", topK)},
+		{Value: vec},
+		{Value: typ.DgraphPredicate(field)},
+		{Value: maybeQuoteArg("hybrid_search", terms)},
+	}
+	if matchAll, _ := text[schema.HybridTextMatchAllArgName].(bool); matchAll {
+		args = append(args, dql.Arg{Value: `"alloftext"`})
+	}
+	if fusion, ok := text[schema.HybridTextFusionArgName].(string); ok {
+		args = append(args, dql.Arg{Value: maybeQuoteArg("hybrid_search", strings.ToLower(fusion))})
+	}
+	if weight, ok := text[schema.HybridTextWeightArgName]; ok && weight != nil {
+		args = append(args, dql.Arg{Value: fmt.Sprintf("%q", fmt.Sprintf("%v", weight))})
+	}
+	return &dql.Function{Name: "hybrid_search", Args: args}
+}
+
+// addValueField sets the attribute of the field of result aliased as alias
+// to attr, adding the field if result doesn't have it yet.
+func addValueField(result []*dql.GraphQuery, alias, attr string) []*dql.GraphQuery {
+	for _, child := range result {
+		if child.Alias == alias {
+			child.Attr = attr
+			return result
+		}
+	}
+	return append(result, &dql.GraphQuery{Alias: alias, Attr: attr})
+}
+
 // Adds common RBAC and UID, Type rules to DQL query.
 // This function is used by rewriteAsQuery and aggregateQuery functions
 func addCommonRules(
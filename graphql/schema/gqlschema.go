@@ -34,10 +34,12 @@ const (
 	searchDirective = "search"
 	searchArgs      = "by"
 
-	dgraphDirective    = "dgraph"
-	dgraphTypeArg      = "type"
-	dgraphPredArg      = "pred"
-	embeddingDirective = "embedding"
+	dgraphDirective      = "dgraph"
+	dgraphTypeArg        = "type"
+	dgraphPredArg        = "pred"
+	embeddingDirective   = "embedding"
+	embeddingSourceArg   = "source"
+	embeddingProviderArg = "provider"
 
 	idDirective             = "id"
 	idDirectiveInterfaceArg = "interface"
@@ -278,7 +280,7 @@ input GenerateMutationParams {
 	directiveDefs = `
 directive @hasInverse(field: String!) on FIELD_DEFINITION
 directive @search(by: [String!]) on FIELD_DEFINITION
-directive @embedding on FIELD_DEFINITION
+directive @embedding(source: String, provider: String) on FIELD_DEFINITION
 directive @dgraph(type: String, pred: String) on OBJECT | INTERFACE | FIELD_DEFINITION
 directive @id(interface: Boolean) on FIELD_DEFINITION
 directive @withSubscription on OBJECT | INTERFACE | FIELD_DEFINITION
@@ -310,7 +312,7 @@ directive @generate(
 	apolloSupportedDirectiveDefs = `
 directive @hasInverse(field: String!) on FIELD_DEFINITION
 directive @search(by: [String!]) on FIELD_DEFINITION
-directive @embedding on FIELD_DEFINITION
+directive @embedding(source: String, provider: String) on FIELD_DEFINITION
 directive @dgraph(type: String, pred: String) on OBJECT | INTERFACE | FIELD_DEFINITION
 directive @id(interface: Boolean) on FIELD_DEFINITION
 directive @withSubscription on OBJECT | INTERFACE | FIELD_DEFINITION
@@ -2084,11 +2086,70 @@ func addSimilarByEmbeddingQuery(schema *ast.Schema, defn *ast.Definition) {
 			NonNull: true,
 		},
 	})
+	addHybridTextArgument(schema, defn, qry)
 	addFilterArgument(schema, qry)
 
 	schema.Query.Fields = append(schema.Query.Fields, qry)
 }
 
+// addHybridTextArgument adds the optional text argument to the similarity
+// query qry of defn, if defn has fields with a fulltext search. The nodes
+// whose text matches the given terms are then fused with the nearest
+// neighbors of the vector, and the fused score is added to defn as
+// "hybrid_score".
+func addHybridTextArgument(schema *ast.Schema, defn *ast.Definition, qry *ast.FieldDefinition) {
+	// Define the enum to select from among all the fields with a fulltext search.
+	enumName := defn.Name + FullTextEnumSuffix
+	enum := &ast.Definition{
+		Kind: ast.Enum,
+		Name: enumName,
+	}
+	for _, fld := range defn.Fields {
+		if fld.Type.Name() == "String" && x.HasString(getSearchArgs(fld), "fulltext") {
+			enum.EnumValues = append(enum.EnumValues,
+				&ast.EnumValueDefinition{Name: fld.Name})
+		}
+	}
+	if len(enum.EnumValues) == 0 {
+		return
+	}
+	schema.Types[enumName] = enum
+
+	if defn.Fields.ForName(HybridScoreFieldName) == nil {
+		defn.Fields = append(defn.Fields,
+			&ast.FieldDefinition{
+				Name: HybridScoreFieldName,
+				Type: &ast.Type{NamedType: "Float"}})
+	}
+
+	schema.Types[HybridFusionEnum] = &ast.Definition{
+		Kind: ast.Enum,
+		Name: HybridFusionEnum,
+		EnumValues: ast.EnumValueList{
+			{Name: HybridFusionRRF},
+			{Name: HybridFusionWeighted},
+		},
+	}
+
+	inputName := defn.Name + HybridTextInputSuffix
+	schema.Types[inputName] = &ast.Definition{
+		Kind: ast.InputObject,
+		Name: inputName,
+		Fields: ast.FieldList{
+			{Name: HybridTextFieldArgName, Type: &ast.Type{NamedType: enumName, NonNull: true}},
+			{Name: HybridTextTermsArgName, Type: &ast.Type{NamedType: "String", NonNull: true}},
+			{Name: HybridTextMatchAllArgName, Type: &ast.Type{NamedType: "Boolean"}},
+			{Name: HybridTextFusionArgName, Type: &ast.Type{NamedType: HybridFusionEnum}},
+			{Name: HybridTextWeightArgName, Type: &ast.Type{NamedType: "Float"}},
+		},
+	}
+
+	qry.Arguments = append(qry.Arguments, &ast.ArgumentDefinition{
+		Name: SimilarTextArgName,
+		Type: &ast.Type{NamedType: inputName},
+	})
+}
+
 // addSimilarByIdQuery adds a query that looks up a node based on an id/xid.
 // The query then performs a similarity search based on the value of the
 // selected embedding field to find similar objects
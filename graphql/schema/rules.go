@@ -24,6 +24,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/dgraph-io/dgraph/v24/graphql/embedding"
 	"github.com/dgraph-io/dgraph/v24/x"
 	"github.com/dgraph-io/gqlparser/v2/ast"
 	"github.com/dgraph-io/gqlparser/v2/gqlerror"
@@ -854,6 +855,35 @@ func embeddingValidation(sch *ast.Schema, typ *ast.Definition,
 					" to fields of type [Float!].", typ.Name, field.Name, field.Type.Name()))
 	}
 
+	source := dir.Arguments.ForName(embeddingSourceArg)
+	provider := dir.Arguments.ForName(embeddingProviderArg)
+	if source == nil && provider == nil {
+		return errs
+	}
+	if source == nil || provider == nil {
+		errs = append(errs,
+			gqlerror.ErrorPosf(
+				dir.Position,
+				"Type %s; Field %s: @embedding directive needs both a source and a provider"+
+					" to compute the embedding.", typ.Name, field.Name))
+		return errs
+	}
+
+	sourceField := typ.Fields.ForName(source.Value.Raw)
+	if sourceField == nil || sourceField.Type.Elem != nil || sourceField.Type.Name() != "String" {
+		errs = append(errs,
+			gqlerror.ErrorPosf(
+				source.Position,
+				"Type %s; Field %s: @embedding directive source %s must be a field of type"+
+					" String in type %s.", typ.Name, field.Name, source.Value.Raw, typ.Name))
+	}
+	if _, err := embedding.Get(provider.Value.Raw); err != nil {
+		errs = append(errs,
+			gqlerror.ErrorPosf(
+				provider.Position,
+				"Type %s; Field %s: @embedding directive has %s.", typ.Name, field.Name, err))
+	}
+
 	return errs
 }
 
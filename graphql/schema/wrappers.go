@@ -117,6 +117,18 @@ const (
 	SimilarSearchMetricEuclidian               = "euclidian"
 	SimilarSearchMetricDotProduct              = "dotproduct"
 	SimilarSearchMetricCosine                  = "cosine"
+	SimilarTextArgName                         = "text"
+	FullTextEnumSuffix                         = "FullText"
+	HybridTextInputSuffix                      = "HybridText"
+	HybridTextFieldArgName                     = "field"
+	HybridTextTermsArgName                     = "terms"
+	HybridTextMatchAllArgName                  = "matchAll"
+	HybridTextFusionArgName                    = "fusion"
+	HybridTextWeightArgName                    = "weight"
+	HybridFusionEnum                           = "HybridFusion"
+	HybridFusionRRF                            = "RRF"
+	HybridFusionWeighted                       = "WEIGHTED"
+	HybridScoreFieldName                       = "hybrid_score"
 )
 
 // Schema represents a valid GraphQL schema
@@ -127,6 +139,9 @@ type Schema interface {
 	IsFederated() bool
 	SetMeta(meta *metaInfo)
 	Meta() *metaInfo
+	// EmbeddingSources returns the Dgraph predicates which are the source of an
+	// @embedding field, each mapped to the predicate of the embedding.
+	EmbeddingSources() map[string]string
 }
 
 // An Operation is a single valid GraphQL operation.  It contains either
@@ -285,6 +300,11 @@ type FieldDefinition interface {
 	HasIDDirective() bool
 	HasEmbeddingDirective() bool
 	EmbeddingSearchMetric() string
+	// EmbeddingSource and EmbeddingProvider give the String field the embedding
+	// is computed from, and the provider computing it, see package embedding.
+	// Both are empty if the embedding is set by the client.
+	EmbeddingSource() string
+	EmbeddingProvider() string
 	HasInterfaceArg() bool
 	Inverse() FieldDefinition
 	WithMemberType(string) FieldDefinition
@@ -408,6 +428,22 @@ func (s *schema) IsFederated() bool {
 	return s.schema.Types["_Entity"] != nil
 }
 
+func (s *schema) EmbeddingSources() map[string]string {
+	out := make(map[string]string)
+	for typeName, def := range s.schema.Types {
+		if def.Kind != ast.Object && def.Kind != ast.Interface {
+			continue
+		}
+		for _, fd := range def.Fields {
+			source := embeddingArg(fd, embeddingSourceArg)
+			if pred := s.dgraphPredicate[typeName][source]; source != "" && pred != "" {
+				out[pred] = s.dgraphPredicate[typeName][fd.Name]
+			}
+		}
+	}
+	return out
+}
+
 func (s *schema) SetMeta(meta *metaInfo) {
 	s.meta = meta
 }
@@ -2367,6 +2403,29 @@ func (fd *fieldDefinition) EmbeddingSearchMetric() string {
 	return kvMap["metric"]
 }
 
+func (fd *fieldDefinition) EmbeddingSource() string {
+	return embeddingArg(fd.fieldDef, embeddingSourceArg)
+}
+
+func (fd *fieldDefinition) EmbeddingProvider() string {
+	return embeddingArg(fd.fieldDef, embeddingProviderArg)
+}
+
+func embeddingArg(fd *ast.FieldDefinition, name string) string {
+	if fd == nil {
+		return ""
+	}
+	dir := fd.Directives.ForName(embeddingDirective)
+	if dir == nil {
+		return ""
+	}
+	arg := dir.Arguments.ForName(name)
+	if arg == nil {
+		return ""
+	}
+	return arg.Value.Raw
+}
+
 func hasEmbeddingDirective(fd *ast.FieldDefinition) bool {
 	id := fd.Directives.ForName(embeddingDirective)
 	return id != nil
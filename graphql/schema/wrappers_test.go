@@ -1358,3 +1358,24 @@ func TestParseSecrets(t *testing.T) {
 		})
 	}
 }
+
+func TestEmbeddingSources(t *testing.T) {
+	schemaStr := `
+	type Product {
+			id: ID!
+			description: String
+			title: String @dgraph(pred: "product.title")
+			descVector: [Float!] @embedding(source: "description", provider: "stub")
+			titleVector: [Float!] @embedding(source: "title", provider: "stub")
+			vector: [Float!] @embedding
+	}`
+
+	schHandler, errs := NewHandler(schemaStr, false)
+	require.NoError(t, errs)
+	sch, err := FromString(schHandler.GQLSchema(), x.GalaxyNamespace)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"Product.description": "Product.descVector",
+		"product.title":       "Product.titleVector",
+	}, sch.EmbeddingSources())
+}
@@ -0,0 +1,221 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"context"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/schema"
+	"github.com/dgraph-io/dgraph/v24/tok"
+	"github.com/dgraph-io/dgraph/v24/types"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+// A composite index, set by @composite(pred1, pred2) on a predicate, indexes the nodes by
+// the tuple of values they have for the predicate, pred1 and pred2. It's kept under the
+// index keys of the predicate, with the tok.IdentComposite identifier, and its tokens are
+// the encodings of the values of the tuple, see tok.CompositeToken. A node without a value
+// for one of the predicates isn't indexed. As the values of all the predicates are read to
+// update the index, they must be served by the same group, and can't be moved to another one.
+
+// compositeToken returns the token of the composite index kept under attr for the values
+// uid has in txn, or false if uid misses some of them.
+func (txn *Txn) compositeToken(ctx context.Context, attr string, uid uint64) (
+	string, bool, error) {
+	preds := append([]string{attr}, schema.State().CompositeWith(ctx, attr)...)
+	encoded := make([]string, 0, len(preds))
+	for _, pred := range preds {
+		pl, err := txn.Get(x.DataKey(pred, uid))
+		if err != nil {
+			return "", false, err
+		}
+		val, err := pl.Value(txn.StartTs)
+		switch {
+		case err == ErrNoValue:
+			return "", false, nil
+		case err != nil:
+			return "", false, err
+		}
+		typ, err := schema.State().TypeOf(pred)
+		if err != nil || !tok.IsCompositeType(typ) {
+			return "", false, nil
+		}
+		sv, err := types.Convert(val, typ)
+		if err != nil {
+			return "", false, nil
+		}
+		enc, err := tok.EncodeCompositeValue(sv)
+		if err != nil {
+			return "", false, nil
+		}
+		encoded = append(encoded, enc)
+	}
+	return tok.CompositeToken(encoded...), true, nil
+}
+
+// addCompositeIndexMutations runs mutate, that applies edge, and updates the composite
+// indexes that edge.Attr is part of for the node edge.Entity. It adds a conflict key for every index, even if its token
+// doesn't change.
+func (txn *Txn) addCompositeIndexMutations(ctx context.Context, edge *pb.DirectedEdge,
+	mutate func() error) error {
+	var attrs []string
+	for _, attr := range schema.State().CompositeIndexesOf(edge.Attr) {
+		if len(schema.State().CompositeWith(ctx, attr)) > 0 {
+			attrs = append(attrs, attr)
+		}
+	}
+	if len(attrs) == 0 {
+		return mutate()
+	}
+
+	before := make([]string, len(attrs))
+	found := make([]bool, len(attrs))
+	for i, attr := range attrs {
+		// The index is updated from the values read by txn, so two transactions changing
+		// different predicates of the index for the same node must conflict.
+		txn.addConflictKey(farm.Fingerprint64(x.IndexKey(attr, tok.CompositeToken())) ^
+			edge.Entity)
+		var err error
+		if before[i], found[i], err = txn.compositeToken(ctx, attr, edge.Entity); err != nil {
+			return err
+		}
+	}
+	if err := mutate(); err != nil {
+		return err
+	}
+	for i, attr := range attrs {
+		after, ok, err := txn.compositeToken(ctx, attr, edge.Entity)
+		if err != nil {
+			return err
+		}
+		if ok == found[i] && after == before[i] {
+			continue
+		}
+		if found[i] {
+			del := &pb.DirectedEdge{ValueId: edge.Entity, Attr: attr, Op: pb.DirectedEdge_DEL}
+			if err := txn.addIndexMutation(ctx, del, before[i]); err != nil {
+				return err
+			}
+		}
+		if ok {
+			set := &pb.DirectedEdge{ValueId: edge.Entity, Attr: attr, Op: pb.DirectedEdge_SET}
+			if err := txn.addIndexMutation(ctx, set, after); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// prefixesForCompositeIndex returns the prefixes of the keys of the composite index kept
+// under attr.
+func prefixesForCompositeIndex(attr string) [][]byte {
+	pk := x.ParsedKey{Attr: attr}
+	prefix := append(pk.IndexPrefix(), tok.IdentComposite)
+
+	// All the parts of any list that has been split into multiple parts.
+	// Such keys have a different prefix (the last byte is set to 1).
+	splitPrefix := append(pk.IndexPrefix(), tok.IdentComposite)
+	splitPrefix[0] = x.ByteSplit
+	return [][]byte{prefix, splitPrefix}
+}
+
+func (rb *IndexRebuild) needsCompositeIndexRebuild() indexOp {
+	x.AssertTruef(rb.CurrentSchema != nil, "Current schema cannot be nil.")
+
+	// If old schema is nil, treat it as an empty schema. Copy it to avoid
+	// overwriting it in rb.
+	old := rb.OldSchema
+	if old == nil {
+		old = &pb.SchemaUpdate{}
+	}
+
+	currIndex := len(rb.CurrentSchema.CompositeWith) > 0
+	prevIndex := len(old.CompositeWith) > 0
+	if !currIndex && !prevIndex {
+		return indexNoop
+	}
+	if !currIndex {
+		return indexDelete
+	}
+	if !prevIndex || rb.CompositeChanged || rb.CurrentSchema.ValueType != old.ValueType ||
+		len(rb.CurrentSchema.CompositeWith) != len(old.CompositeWith) {
+		return indexRebuild
+	}
+	for i, pred := range rb.CurrentSchema.CompositeWith {
+		if old.CompositeWith[i] != pred {
+			return indexRebuild
+		}
+	}
+	return indexNoop
+}
+
+func prefixesToDropCompositeIndex(rb *IndexRebuild) [][]byte {
+	// Exit early if indices do not need to be rebuilt.
+	if rb.needsCompositeIndexRebuild() == indexNoop {
+		return nil
+	}
+	return prefixesForCompositeIndex(rb.Attr)
+}
+
+// rebuildCompositeIndex rebuilds the composite index kept under the given attribute.
+func rebuildCompositeIndex(ctx context.Context, rb *IndexRebuild) error {
+	if rb.needsCompositeIndexRebuild() != indexRebuild {
+		return nil
+	}
+
+	glog.Infof("Rebuilding composite index for %s", rb.Attr)
+	pk := x.ParsedKey{Attr: rb.Attr}
+	builder := rebuilder{attr: rb.Attr, prefix: pk.DataPrefix(), startTs: rb.StartTs}
+	builder.fn = func(uid uint64, pl *List, txn *Txn) ([]*pb.DirectedEdge, error) {
+		token, ok, err := txn.compositeToken(ctx, rb.Attr, uid)
+		if err != nil || !ok {
+			return []*pb.DirectedEdge{}, err
+		}
+		edge := &pb.DirectedEdge{ValueId: uid, Attr: rb.Attr, Op: pb.DirectedEdge_SET}
+		for {
+			err := txn.addIndexMutation(ctx, edge, token)
+			switch err {
+			case ErrRetry:
+				time.Sleep(10 * time.Millisecond)
+			default:
+				return []*pb.DirectedEdge{}, err
+			}
+		}
+	}
+	return builder.Run(ctx)
+}
+
+// dropCompositeIndexesOf drops the composite indexes that attr is part of, as they can't
+// hold any node once attr is deleted. The index of attr itself is deleted with it.
+func dropCompositeIndexesOf(attr string) error {
+	var prefixes [][]byte
+	for _, other := range schema.State().CompositeIndexesOf(attr) {
+		if other != attr {
+			prefixes = append(prefixes, prefixesForCompositeIndex(other)...)
+		}
+	}
+	if len(prefixes) == 0 {
+		return nil
+	}
+	return pstore.DropPrefix(prefixes...)
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/schema"
+	"github.com/dgraph-io/dgraph/v24/tok"
+	"github.com/dgraph-io/dgraph/v24/types"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+const compositeSchemaVal = `
+	ccountry: string @composite(cage) .
+	cage: int .
+`
+
+// compositeTokenForTest returns the token of the composite index of ccountry.
+func compositeTokenForTest(t *testing.T, country string, age int64) string {
+	c, err := tok.EncodeCompositeValue(types.Val{Tid: types.StringID, Value: country})
+	require.NoError(t, err)
+	a, err := tok.EncodeCompositeValue(types.Val{Tid: types.IntID, Value: age})
+	require.NoError(t, err)
+	return tok.CompositeToken(c, a)
+}
+
+func compositeUids(t *testing.T, token string, readTs uint64) []uint64 {
+	l, err := GetNoStore(x.IndexKey(x.GalaxyAttr("ccountry"), token), readTs)
+	require.NoError(t, err)
+	return uids(l, readTs)
+}
+
+func setCompositeValue(t *testing.T, attr string, uid uint64, value string, op uint32,
+	startTs uint64) {
+	l, err := GetNoStore(x.DataKey(x.GalaxyAttr(attr), uid), startTs)
+	require.NoError(t, err)
+	edge := &pb.DirectedEdge{
+		Value:  []byte(value),
+		Attr:   x.GalaxyAttr(attr),
+		Entity: uid,
+	}
+	addMutation(t, l, edge, op, startTs, startTs+1, true)
+}
+
+func TestCompositeIndex(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(compositeSchemaVal), 1))
+
+	// Nodes missing a value aren't indexed.
+	setCompositeValue(t, "ccountry", 1, "DE", Set, 10)
+	require.Empty(t, compositeUids(t, compositeTokenForTest(t, "DE", 30), 11))
+
+	setCompositeValue(t, "cage", 1, "30", Set, 12)
+	setCompositeValue(t, "cage", 2, "30", Set, 14)
+	setCompositeValue(t, "ccountry", 2, "DE", Set, 16)
+	require.Equal(t, []uint64{1, 2}, compositeUids(t, compositeTokenForTest(t, "DE", 30), 17))
+
+	// Changing a value moves the node to another token.
+	setCompositeValue(t, "cage", 1, "40", Set, 18)
+	require.Equal(t, []uint64{2}, compositeUids(t, compositeTokenForTest(t, "DE", 30), 19))
+	require.Equal(t, []uint64{1}, compositeUids(t, compositeTokenForTest(t, "DE", 40), 19))
+
+	// Deleting a value removes the node from the index.
+	setCompositeValue(t, "ccountry", 2, "DE", Del, 20)
+	require.Empty(t, compositeUids(t, compositeTokenForTest(t, "DE", 30), 21))
+	require.Equal(t, []uint64{1}, compositeUids(t, compositeTokenForTest(t, "DE", 40), 21))
+}
+
+func TestCompositeIndexConflicts(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(compositeSchemaVal), 1))
+
+	// conflicts returns the conflict keys of a transaction setting the value of attr for uid.
+	conflicts := func(attr string, uid uint64, value string) map[uint64]struct{} {
+		txn := NewTxn(30)
+		l, err := txn.Get(x.DataKey(x.GalaxyAttr(attr), uid))
+		require.NoError(t, err)
+		edge := &pb.DirectedEdge{
+			Value:  []byte(value),
+			Attr:   x.GalaxyAttr(attr),
+			Entity: uid,
+			Op:     pb.DirectedEdge_SET,
+		}
+		require.NoError(t, l.AddMutationWithIndex(context.Background(), edge, txn))
+		return txn.conflicts
+	}
+	shared := func(a, b map[uint64]struct{}) bool {
+		for key := range a {
+			if _, ok := b[key]; ok {
+				return true
+			}
+		}
+		return false
+	}
+
+	// Transactions changing different predicates of the index of the same node conflict.
+	require.True(t, shared(conflicts("ccountry", 41, "DE"), conflicts("cage", 41, "30")))
+	require.False(t, shared(conflicts("ccountry", 41, "DE"), conflicts("cage", 42, "30")))
+}
+
+func TestRebuildCompositeIndex(t *testing.T) {
+	addEdgeToValue(t, x.GalaxyAttr("ccountry"), 91, "FR", 1, 2)
+	addEdgeToValue(t, x.GalaxyAttr("cage"), 91, "25", 1, 2)
+	addEdgeToValue(t, x.GalaxyAttr("ccountry"), 92, "FR", 3, 4)
+
+	require.NoError(t, schema.ParseBytes([]byte(compositeSchemaVal), 1))
+	currentSchema, _ := schema.State().Get(context.Background(), x.GalaxyAttr("ccountry"))
+	rb := IndexRebuild{
+		Attr:          x.GalaxyAttr("ccountry"),
+		StartTs:       5,
+		OldSchema:     nil,
+		CurrentSchema: &currentSchema,
+	}
+	require.Equal(t, indexOp(indexRebuild), rb.needsCompositeIndexRebuild())
+	require.NoError(t, pstore.DropPrefix(prefixesToDropCompositeIndex(&rb)...))
+	require.NoError(t, rebuildCompositeIndex(context.Background(), &rb))
+
+	require.Equal(t, []uint64{91}, compositeUids(t, compositeTokenForTest(t, "FR", 25), 6))
+}
+
+func TestNeedsCompositeIndexRebuild(t *testing.T) {
+	rb := IndexRebuild{}
+	rb.OldSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING}
+	rb.CurrentSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING, CompositeWith: []string{"a"}}
+	require.Equal(t, indexOp(indexRebuild), rb.needsCompositeIndexRebuild())
+
+	rb.OldSchema = nil
+	require.Equal(t, indexOp(indexRebuild), rb.needsCompositeIndexRebuild())
+
+	rb.OldSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING, CompositeWith: []string{"a"}}
+	require.Equal(t, indexNoop, rb.needsCompositeIndexRebuild())
+	// The type of a changes.
+	rb.CompositeChanged = true
+	require.Equal(t, indexOp(indexRebuild), rb.needsCompositeIndexRebuild())
+	rb.CompositeChanged = false
+
+	rb.CurrentSchema = &pb.SchemaUpdate{ValueType: pb.Posting_INT, CompositeWith: []string{"a"}}
+	require.Equal(t, indexOp(indexRebuild), rb.needsCompositeIndexRebuild())
+
+	rb.CurrentSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING,
+		CompositeWith: []string{"a", "b"}}
+	require.Equal(t, indexOp(indexRebuild), rb.needsCompositeIndexRebuild())
+
+	rb.CurrentSchema = &pb.SchemaUpdate{ValueType: pb.Posting_STRING}
+	require.Equal(t, indexOp(indexDelete), rb.needsCompositeIndexRebuild())
+}
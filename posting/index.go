@@ -21,15 +21,16 @@ import (
 	"context"
 	"encoding/binary"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"math"
 	"os"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 	"unsafe"
 
+	"github.com/dgryski/go-farm"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	ostats "go.opencensus.io/stats"
@@ -42,6 +43,8 @@ import (
 	"github.com/dgraph-io/dgraph/v24/schema"
 	"github.com/dgraph-io/dgraph/v24/tok"
 	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v24/tok/index"
+	"github.com/dgraph-io/dgraph/v24/tok/ivf"
 	"github.com/dgraph-io/dgraph/v24/types"
 	"github.com/dgraph-io/dgraph/v24/x"
 	"github.com/dgraph-io/ristretto/z"
@@ -57,6 +60,147 @@ type indexMutationInfo struct {
 	op           pb.DirectedEdge_Op
 }
 
+// multiVectorId returns the id under which the vector value of uid is
+// indexed, for a predicate holding a list of vectors per node. Every (uid,
+// vector) pair gets its own id, as several nodes may hold the same vector.
+func multiVectorId(uid uint64, value []byte) uint64 {
+	buf := make([]byte, 8, 8+len(value))
+	binary.BigEndian.PutUint64(buf, uid)
+	return farm.Fingerprint64(append(buf, value...))
+}
+
+// addMultiVectorIndexMutations maintains the vector index of a predicate
+// holding a list of vectors per node, for the vector info.val of the entity
+// of info.edge. The vector and the uid owning it are stored under the id of
+// the vector (see multiVectorId) in the hnsw.VecMulti and hnsw.VecOwner
+// attributes of the predicate, from which the index and the similar_to
+// function read them.
+func (txn *Txn) addMultiVectorIndexMutations(ctx context.Context,
+	info *indexMutationInfo) ([]*pb.DirectedEdge, error) {
+	// Setting a vector the list already holds deletes it first, the SET that
+	// follows updates the vector index in place.
+	if info.op == pb.DirectedEdge_DEL && info.edge.Op == pb.DirectedEdge_SET {
+		return []*pb.DirectedEdge{}, nil
+	}
+	attr, uid := info.edge.Attr, info.edge.Entity
+	value, ok := info.val.Value.([]byte)
+	if !ok {
+		return []*pb.DirectedEdge{}, errors.Errorf("invalid vector for %s of %#x: %v",
+			x.ParseAttr(attr), uid, info.val.Value)
+	}
+	id := multiVectorId(uid, value)
+	tc := hnsw.NewTxnCache(NewViTxn(txn), txn.StartTs)
+	indexer, err := info.factorySpecs[0].CreateIndex(attr)
+	if err != nil {
+		return []*pb.DirectedEdge{}, err
+	}
+
+	var edges []*index.KeyValue
+	if info.op == pb.DirectedEdge_DEL {
+		if edges, err = indexer.Delete(ctx, tc, id); err != nil {
+			return []*pb.DirectedEdge{}, err
+		}
+		if err := txn.setMultiVectorData(ctx, attr, id, nil, 0); err != nil {
+			return []*pb.DirectedEdge{}, err
+		}
+	} else {
+		// The vector must be stored before it is inserted, as the index reads
+		// it back from hnsw.VecMulti.
+		if err := txn.setMultiVectorData(ctx, attr, id, value, uid); err != nil {
+			return []*pb.DirectedEdge{}, err
+		}
+		var inVec []float32
+		index.DecodeVector(value, &inVec, 32, tok.VectorEncoding(info.val.Tid))
+		if edges, err = indexer.Update(ctx, tc, id, inVec); err != nil {
+			return []*pb.DirectedEdge{}, err
+		}
+	}
+	pbEdges := []*pb.DirectedEdge{}
+	for _, e := range edges {
+		pbEdges = append(pbEdges, indexEdgeToPbEdge(e))
+	}
+	return pbEdges, nil
+}
+
+// setMultiVectorData stores value, and owner as the uid owning it, under the
+// id of a vector of a predicate holding a list of vectors per node. A nil
+// value deletes both.
+func (txn *Txn) setMultiVectorData(ctx context.Context, attr string, id uint64,
+	value []byte, owner uint64) error {
+	for _, e := range []*pb.DirectedEdge{
+		{Attr: hnsw.ConcatStrings(attr, hnsw.VecMulti), Value: value},
+		{Attr: hnsw.ConcatStrings(attr, hnsw.VecOwner), Value: hnsw.Uint64ToBytes(owner)},
+	} {
+		e.Entity, e.Op = id, pb.DirectedEdge_SET
+		if value == nil {
+			e.Value, e.Op = []byte(x.Star), pb.DirectedEdge_DEL
+		}
+		pl, err := txn.Get(x.DataKey(e.Attr, id))
+		if err != nil {
+			return err
+		}
+		if err := pl.addMutation(ctx, txn, e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// vectorTrainer returns the vector index of attr if it has to be trained,
+// see index.Trainer.
+func vectorTrainer(ctx context.Context, attr string) (index.Trainer[float32], bool, error) {
+	specs, err := schema.State().FactoryCreateSpec(ctx, attr)
+	if err != nil || len(specs) == 0 {
+		return nil, false, err
+	}
+	indexer, err := specs[0].CreateIndex(attr)
+	if err != nil {
+		return nil, false, err
+	}
+	trainer, ok := indexer.(index.Trainer[float32])
+	return trainer, ok, nil
+}
+
+// NeedsVectorIndexTraining returns true if the vector index of attr has to
+// run a training step, according to the data committed at readTs.
+func NeedsVectorIndexTraining(ctx context.Context, attr string, readTs uint64) (bool, error) {
+	trainer, ok, err := vectorTrainer(ctx, attr)
+	if err != nil || !ok {
+		return false, err
+	}
+	qc := hnsw.NewQueryCache(NewViLocalCache(NewLocalCache(readTs)), readTs)
+	return trainer.NeedsTraining(ctx, qc)
+}
+
+// TrainVectorIndex runs a training step of the vector index of attr in txn.
+func TrainVectorIndex(ctx context.Context, txn *Txn, attr string) error {
+	trainer, ok, err := vectorTrainer(ctx, attr)
+	if err != nil || !ok {
+		return err
+	}
+	_, err = trainer.Train(ctx, hnsw.NewTxnCache(NewViTxn(txn), txn.StartTs))
+	return err
+}
+
+// vectorIndexBuilds holds the number of nodes indexed so far by each vector
+// index being built by rebuildTokIndex, by predicate.
+var vectorIndexBuilds = struct {
+	sync.Mutex
+	m map[string]*atomic.Uint64
+}{m: make(map[string]*atomic.Uint64)}
+
+// VectorIndexBuildProgress returns the number of nodes indexed so far by the
+// build of the vector index of attr, or false if it isn't being built.
+func VectorIndexBuildProgress(attr string) (uint64, bool) {
+	vectorIndexBuilds.Lock()
+	defer vectorIndexBuilds.Unlock()
+	indexed, ok := vectorIndexBuilds.m[attr]
+	if !ok {
+		return 0, false
+	}
+	return indexed.Load(), true
+}
+
 // indexTokens return tokens, without the predicate prefix and
 // index rune, for specific tokenizers.
 func indexTokens(ctx context.Context, info *indexMutationInfo) ([]string, error) {
@@ -120,45 +264,32 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 		return []*pb.DirectedEdge{}, err
 	}
 
+	if len(info.factorySpecs) > 0 && info.factorySpecs[0].IsMultiVector() &&
+		info.val.Tid.IsVector() {
+		return txn.addMultiVectorIndexMutations(ctx, info)
+	}
+
 	if info.op == pb.DirectedEdge_DEL &&
-		len(data) > 0 && data[0].Tid == types.VFloatID {
-		// TODO look into better alternatives
-		//      The issue here is that we will create dead nodes in the Vector Index
-		//      assuming an HNSW index type. What we should do instead is invoke
-		//      index.Remove(<key to dead index value>). However, we currently do
-		//      not support this in VectorIndex code!!
-		// if a delete & dealing with vfloats, add this to dead node in persistent store.
-		// What we should do instead is invoke the factory.Remove(key) operation.
-		deadAttr := hnsw.ConcatStrings(info.edge.Attr, hnsw.VecDead)
-		deadKey := x.DataKey(deadAttr, 1)
-		pl, err := txn.Get(deadKey)
+		info.val.Tid.IsVector() && len(info.factorySpecs) > 0 {
+		// When the vector is being overwritten, the SET that follows this
+		// DEL updates the vector index in place.
+		if info.edge.Op == pb.DirectedEdge_SET {
+			return []*pb.DirectedEdge{}, nil
+		}
+		tc := hnsw.NewTxnCache(NewViTxn(txn), txn.StartTs)
+		indexer, err := info.factorySpecs[0].CreateIndex(attr)
 		if err != nil {
 			return []*pb.DirectedEdge{}, err
 		}
-		var deadNodes []uint64
-		deadData, _ := pl.Value(txn.StartTs)
-		if deadData.Value == nil {
-			deadNodes = append(deadNodes, uid)
-		} else {
-			deadNodes, err = hnsw.ParseEdges(string(deadData.Value.([]byte)))
-			if err != nil {
-				return []*pb.DirectedEdge{}, err
-			}
-			deadNodes = append(deadNodes, uid)
-		}
-		deadNodesBytes, marshalErr := json.Marshal(deadNodes)
-		if marshalErr != nil {
-			return []*pb.DirectedEdge{}, marshalErr
-		}
-		edge := &pb.DirectedEdge{
-			Entity:    1,
-			Attr:      deadAttr,
-			Value:     deadNodesBytes,
-			ValueType: pb.Posting_ValType(0),
+		edges, err := indexer.Delete(ctx, tc, uid)
+		if err != nil {
+			return []*pb.DirectedEdge{}, err
 		}
-		if err := pl.addMutation(ctx, txn, edge); err != nil {
-			return nil, err
+		pbEdges := []*pb.DirectedEdge{}
+		for _, e := range edges {
+			pbEdges = append(pbEdges, indexEdgeToPbEdge(e))
 		}
+		return pbEdges, nil
 	}
 
 	// TODO: As stated earlier, we need to validate that it is okay to assume
@@ -166,16 +297,17 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 	//       Similarly, the current assumption is that we have at most one
 	//       Vector Index, but this assumption may break later.
 	if info.op == pb.DirectedEdge_SET &&
-		len(data) > 0 && data[0].Tid == types.VFloatID &&
+		len(data) > 0 && data[0].Tid.IsVector() &&
 		len(info.factorySpecs) > 0 {
 		// retrieve vector from inUuid save as inVec
-		inVec := types.BytesAsFloatArray(data[0].Value.([]byte))
+		var inVec []float32
+		index.DecodeVector(data[0].Value.([]byte), &inVec, 32, tok.VectorEncoding(data[0].Tid))
 		tc := hnsw.NewTxnCache(NewViTxn(txn), txn.StartTs)
 		indexer, err := info.factorySpecs[0].CreateIndex(attr)
 		if err != nil {
 			return []*pb.DirectedEdge{}, err
 		}
-		edges, err := indexer.Insert(ctx, tc, uid, inVec)
+		edges, err := indexer.Update(ctx, tc, uid, inVec)
 		if err != nil {
 			return []*pb.DirectedEdge{}, err
 		}
@@ -414,7 +546,13 @@ func (l *List) handleDeleteAll(ctx context.Context, edge *pb.DirectedEdge, txn *
 		}
 	}
 
-	return l.addMutation(ctx, txn, edge)
+	if err := l.addMutation(ctx, txn, edge); err != nil {
+		return err
+	}
+	if isIndexed && hasFullText(schema.State().Tokenizer(ctx, edge.Attr)) {
+		return txn.updateTextStats(ctx, edge.Attr, edge.Entity, l)
+	}
+	return nil
 }
 
 func (txn *Txn) addCountMutation(ctx context.Context, t *pb.DirectedEdge, count uint32,
@@ -559,6 +697,15 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 			" and value: [%v]", edge.Entity, edge.ValueId, edge.Value)
 	}
 
+	if pstore != nil {
+		return txn.addCompositeIndexMutations(ctx, edge, func() error {
+			return l.addMutationWithIndex(ctx, edge, txn)
+		})
+	}
+	return l.addMutationWithIndex(ctx, edge, txn)
+}
+
+func (l *List) addMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge, txn *Txn) error {
 	if edge.Op == pb.DirectedEdge_DEL && string(edge.Value) == x.Star {
 		return l.handleDeleteAll(ctx, edge, txn)
 	}
@@ -610,6 +757,11 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 				return err
 			}
 		}
+		if hasFullText(schema.State().Tokenizer(ctx, edge.Attr)) {
+			if err := txn.updateTextStats(ctx, edge.Attr, edge.Entity, l); err != nil {
+				return err
+			}
+		}
 	}
 	return nil
 }
@@ -902,7 +1054,7 @@ func (r *rebuilder) Run(ctx context.Context) error {
 	stream.LogPrefix = fmt.Sprintf("Rebuilding index for predicate %s (1/2):", r.attr)
 	stream.Prefix = r.prefix
 	//TODO We need to create a single transaction irrespective of the type of the predicate
-	if pred.ValueType == pb.Posting_VFLOAT {
+	if types.TypeID(pred.ValueType).IsVector() {
 		txn = NewTxn(r.startTs)
 	}
 	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
@@ -1056,6 +1208,9 @@ type IndexRebuild struct {
 	StartTs       uint64
 	OldSchema     *pb.SchemaUpdate
 	CurrentSchema *pb.SchemaUpdate
+	// CompositeChanged is set when the type of another predicate of the composite index of
+	// Attr changes, which changes the tokens of the index.
+	CompositeChanged bool
 }
 
 type indexOp int
@@ -1095,6 +1250,9 @@ func (rb *IndexRebuild) GetQuerySchema() *pb.SchemaUpdate {
 	if rb.needsReverseEdgesRebuild() == indexRebuild {
 		querySchema.Directive = pb.SchemaUpdate_NONE
 	}
+	if rb.needsCompositeIndexRebuild() == indexRebuild {
+		querySchema.CompositeWith = nil
+	}
 	return &querySchema
 }
 
@@ -1113,6 +1271,7 @@ func (rb *IndexRebuild) DropIndexes(ctx context.Context) error {
 	prefixes = append(prefixes, prefixesToDropReverseEdges(ctx, rb)...)
 	prefixes = append(prefixes, prefixesToDropCountIndex(ctx, rb)...)
 	prefixes = append(prefixes, prefixesToDropVectorIndexEdges(ctx, rb)...)
+	prefixes = append(prefixes, prefixesToDropCompositeIndex(rb)...)
 	if len(prefixes) > 0 {
 		// This trace message now gets logged only if there are any prefixes to
 		// to be deleted
@@ -1127,12 +1286,13 @@ func (rb *IndexRebuild) BuildData(ctx context.Context) error {
 	return rebuildListType(ctx, rb)
 }
 
-// NeedIndexRebuild returns true if any of the tokenizer, reverse
-// or count indexes need to be rebuilt.
+// NeedIndexRebuild returns true if any of the tokenizer, reverse, count
+// or composite indexes need to be rebuilt.
 func (rb *IndexRebuild) NeedIndexRebuild() bool {
 	return rb.needsTokIndexRebuild().op == indexRebuild ||
 		rb.needsReverseEdgesRebuild() == indexRebuild ||
-		rb.needsCountIndexRebuild() == indexRebuild
+		rb.needsCountIndexRebuild() == indexRebuild ||
+		rb.needsCompositeIndexRebuild() == indexRebuild
 }
 
 // BuildIndexes builds indexes.
@@ -1143,7 +1303,10 @@ func (rb *IndexRebuild) BuildIndexes(ctx context.Context) error {
 	if err := rebuildReverseEdges(ctx, rb); err != nil {
 		return err
 	}
-	return rebuildCountIndex(ctx, rb)
+	if err := rebuildCountIndex(ctx, rb); err != nil {
+		return err
+	}
+	return rebuildCompositeIndex(ctx, rb)
 }
 
 type indexRebuildInfo struct {
@@ -1366,7 +1529,8 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 	var factorySpecs []*tok.FactoryCreateSpec
 	if len(rebuildInfo.vectorIndexesToRebuild) > 0 {
 		factorySpec, err := tok.GetFactoryCreateSpecFromSpec(
-			rebuildInfo.vectorIndexesToRebuild[0])
+			rebuildInfo.vectorIndexesToRebuild[0], types.TypeID(rb.CurrentSchema.ValueType),
+			rb.CurrentSchema.List)
 		if err != nil {
 			return err
 		}
@@ -1410,9 +1574,29 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 		if err != nil {
 			return []*pb.DirectedEdge{}, err
 		}
+		if hasFullText(tokenizers) {
+			if err := txn.updateTextStats(ctx, rb.Attr, uid, pl); err != nil {
+				return []*pb.DirectedEdge{}, err
+			}
+		}
 		return edges, err
 	}
 	if len(factorySpecs) != 0 {
+		indexed := new(atomic.Uint64)
+		vectorIndexBuilds.Lock()
+		vectorIndexBuilds.m[rb.Attr] = indexed
+		vectorIndexBuilds.Unlock()
+		defer func() {
+			vectorIndexBuilds.Lock()
+			delete(vectorIndexBuilds.m, rb.Attr)
+			vectorIndexBuilds.Unlock()
+		}()
+
+		fn := builder.fn
+		builder.fn = func(uid uint64, pl *List, txn *Txn) ([]*pb.DirectedEdge, error) {
+			defer indexed.Add(1)
+			return fn(uid, pl, txn)
+		}
 		return builder.RunWithoutTemp(ctx)
 	}
 	return builder.Run(ctx)
@@ -1528,9 +1712,9 @@ func (rb *IndexRebuild) needsVectorIndexEdgesRebuild() indexOp {
 	}
 
 	currIndex := rb.CurrentSchema.Directive == pb.SchemaUpdate_INDEX &&
-		rb.CurrentSchema.ValueType == pb.Posting_VFLOAT
+		types.TypeID(rb.CurrentSchema.ValueType).IsVector()
 	prevIndex := old.Directive == pb.SchemaUpdate_INDEX &&
-		old.ValueType == pb.Posting_VFLOAT
+		types.TypeID(old.ValueType).IsVector()
 
 	// If the schema directive did not change, return indexNoop.
 	if currIndex == prevIndex {
@@ -1556,6 +1740,11 @@ func prefixesToDropVectorIndexEdges(ctx context.Context, rb *IndexRebuild) [][]b
 	prefixes := append([][]byte{}, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecEntry)))
 	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecDead)))
 	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecKeyword)))
+	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecMulti)))
+	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecOwner)))
+	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, ivf.VecCodebook)))
+	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, ivf.VecList)))
+	prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, ivf.VecAssignment)))
 
 	for i := 0; i < hnsw.VectorIndexMaxLevels; i++ {
 		prefixes = append(prefixes, x.PredicatePrefix(hnsw.ConcatStrings(rb.Attr, hnsw.VecKeyword, fmt.Sprint(i))))
@@ -1735,6 +1924,9 @@ func DeletePredicate(ctx context.Context, attr string, ts uint64) error {
 	ResetCache()
 	preds := schema.State().PredicatesToDelete(attr)
 	for _, pred := range preds {
+		if err := dropCompositeIndexesOf(pred); err != nil {
+			return err
+		}
 		prefix := x.PredicatePrefix(pred)
 		if err := schema.State().Delete(pred, ts); err != nil {
 			return err
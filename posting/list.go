@@ -23,6 +23,7 @@ import (
 	"log"
 	"math"
 	"sort"
+	"strings"
 
 	"github.com/dgryski/go-farm"
 	"github.com/golang/glog"
@@ -35,6 +36,7 @@ import (
 	"github.com/dgraph-io/dgraph/v24/codec"
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
 	"github.com/dgraph-io/dgraph/v24/schema"
+	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
 	"github.com/dgraph-io/dgraph/v24/tok/index"
 	"github.com/dgraph-io/dgraph/v24/types"
 	"github.com/dgraph-io/dgraph/v24/types/facets"
@@ -90,6 +92,18 @@ func indexEdgeToPbEdge(t *index.KeyValue) *pb.DirectedEdge {
 	}
 }
 
+// indexUidEdge returns the edge adding uid to, or removing it from, the uid
+// list of the vector index key built from t.
+func indexUidEdge(t *index.KeyValue, uid uint64, op pb.DirectedEdge_Op) *pb.DirectedEdge {
+	return &pb.DirectedEdge{
+		Entity:    t.Entity,
+		Attr:      t.Attr,
+		ValueId:   uid,
+		ValueType: pb.Posting_UID,
+		Op:        op,
+	}
+}
+
 // NewList returns a new list with an immutable layer set to plist and the
 // timestamp of the immutable layer set to minTs.
 func NewList(key []byte, plist *pb.PostingList, minTs uint64) *List {
@@ -107,6 +121,26 @@ func (l *List) maxVersion() uint64 {
 	return l.maxTs
 }
 
+// CommitTs returns the commit timestamp of the latest change to the list visible at readTs,
+// or readTs if the transaction reading at readTs changed it.
+func (l *List) CommitTs(readTs uint64) uint64 {
+	l.RLock()
+	defer l.RUnlock()
+	var ts uint64
+	if l.minTs <= readTs {
+		ts = l.minTs
+	}
+	for startTs, pl := range l.mutationMap {
+		switch {
+		case pl.CommitTs == 0 && startTs == readTs:
+			return readTs
+		case pl.CommitTs <= readTs:
+			ts = x.Max(ts, pl.CommitTs)
+		}
+	}
+	return ts
+}
+
 type pIterator struct {
 	l          *List
 	plist      *pb.PostingList
@@ -475,6 +509,12 @@ func GetConflictKey(pk x.ParsedKey, key []byte, t *pb.DirectedEdge) uint64 {
 		// that two users don't set the same email id.
 		conflictKey = getKey(key, 0)
 
+	case pk.IsData() && t.ValueType == pb.Posting_UID && strings.Contains(t.Attr, hnsw.VecKeyword):
+		// The uid lists of the vector indexes, like the clusters of an IVF
+		// index, are changed one uid at a time by the mutations of many
+		// nodes, which must not conflict with each other.
+		conflictKey = getKey(key, t.ValueId)
+
 	case pk.IsData() && schema.State().IsList(t.Attr):
 		// Data keys, irrespective of whether they are UID or values, should be judged based on
 		// whether they are lists or not. For UID, t.ValueId = UID. For value, t.ValueId =
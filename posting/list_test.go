@@ -34,6 +34,7 @@ import (
 	"github.com/dgraph-io/dgraph/v24/codec"
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
 	"github.com/dgraph-io/dgraph/v24/schema"
+	"github.com/dgraph-io/dgraph/v24/tok/index"
 	"github.com/dgraph-io/dgraph/v24/x"
 	"github.com/dgraph-io/ristretto/z"
 )
@@ -562,6 +563,21 @@ func TestReadSingleValue(t *testing.T) {
 	}
 }
 
+func TestListCommitTs(t *testing.T) {
+	l := NewList(x.DataKey(x.GalaxyAttr("session"), 1), &pb.PostingList{}, 5)
+	require.Zero(t, l.CommitTs(4))
+	require.EqualValues(t, 5, l.CommitTs(6))
+
+	addMutationHelper(t, l, &pb.DirectedEdge{ValueId: 9}, Set, &Txn{StartTs: 10})
+	require.NoError(t, l.commitMutation(10, 11))
+	addMutationHelper(t, l, &pb.DirectedEdge{ValueId: 10}, Set, &Txn{StartTs: 20})
+	require.EqualValues(t, 5, l.CommitTs(10))
+	require.EqualValues(t, 11, l.CommitTs(15))
+	// The uncommitted change is only seen by its own transaction.
+	require.EqualValues(t, 11, l.CommitTs(25))
+	require.EqualValues(t, 20, l.CommitTs(20))
+}
+
 func TestRollupMaxTsIsSet(t *testing.T) {
 	defer setMaxListSize(maxListSize)
 	maxListSize = math.MaxInt32
@@ -1605,6 +1621,25 @@ func TestRecursiveSplits(t *testing.T) {
 
 var ps *badger.DB
 
+func TestVectorIndexUidListConflicts(t *testing.T) {
+	ctx := context.Background()
+	attr := x.GalaxyAttr("0-vec__vector_ivf_list")
+	key := x.DataKey(attr, 1)
+	conflicts := func(uid uint64) map[uint64]struct{} {
+		txn := NewTxn(10)
+		kv := &index.KeyValue{Entity: 1, Attr: attr}
+		require.NoError(t, NewViTxn(txn).AddUid(ctx, key, kv, uid))
+		uids, err := NewViTxn(txn).Uids(key)
+		require.NoError(t, err)
+		require.Equal(t, []uint64{uid}, uids)
+		return txn.conflicts
+	}
+	// Adding different uids to the same list must not conflict.
+	require.Len(t, conflicts(5), 1)
+	require.NotEqual(t, conflicts(5), conflicts(6))
+	require.Equal(t, conflicts(5), conflicts(5))
+}
+
 func TestMain(m *testing.M) {
 	Config.CommitFraction = 0.10
 
@@ -156,6 +156,18 @@ func (vc *viLocalCache) GetValueFromPostingList(pl *List) (rval index.Value, rer
 	return value.Postings[0].Value, nil
 }
 
+func (vc *viLocalCache) Uids(key []byte) ([]uint64, error) {
+	pl, err := vc.delegate.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	uids, err := pl.Uids(ListOptions{ReadTs: vc.delegate.startTs})
+	if err != nil {
+		return nil, err
+	}
+	return uids.Uids, nil
+}
+
 func NewViLocalCache(delegate *LocalCache) *viLocalCache {
 	return &viLocalCache{delegate: delegate}
 }
@@ -133,6 +133,34 @@ func (vt *viTxn) AddMutationWithLockHeld(ctx context.Context, key []byte, t *ind
 	return pl.addMutationInternal(ctx, vt.delegate, indexEdgeToPbEdge(t))
 }
 
+func (vt *viTxn) AddUid(ctx context.Context, key []byte, t *index.KeyValue, uid uint64) error {
+	pl, err := vt.delegate.cache.Get(key)
+	if err != nil {
+		return err
+	}
+	return pl.addMutation(ctx, vt.delegate, indexUidEdge(t, uid, pb.DirectedEdge_SET))
+}
+
+func (vt *viTxn) DeleteUid(ctx context.Context, key []byte, t *index.KeyValue, uid uint64) error {
+	pl, err := vt.delegate.cache.Get(key)
+	if err != nil {
+		return err
+	}
+	return pl.addMutation(ctx, vt.delegate, indexUidEdge(t, uid, pb.DirectedEdge_DEL))
+}
+
+func (vt *viTxn) Uids(key []byte) ([]uint64, error) {
+	pl, err := vt.delegate.cache.Get(key)
+	if err != nil {
+		return nil, err
+	}
+	uids, err := pl.Uids(ListOptions{ReadTs: vt.delegate.StartTs})
+	if err != nil {
+		return nil, err
+	}
+	return uids.Uids, nil
+}
+
 func (vt *viTxn) LockKey(key []byte) {
 	pl, _ := vt.delegate.cache.Get(key)
 	pl.Lock()
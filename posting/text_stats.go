@@ -0,0 +1,160 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"context"
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/tok"
+	"github.com/dgraph-io/dgraph/v24/types"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+// hasFullText returns whether one of the tokenizers is the full-text one.
+func hasFullText(tokenizers []tok.Tokenizer) bool {
+	for _, t := range tokenizers {
+		if t.Identifier() == tok.IdentFullText {
+			return true
+		}
+	}
+	return false
+}
+
+// TextStatsKey returns the key holding the term counts of the values of uid for the predicate
+// attr, which has a full-text index. See DecodeTextStats.
+func TextStatsKey(attr string, uid uint64) []byte {
+	return x.IndexKey(attr, tok.FullTextStatsToken(uid))
+}
+
+// updateTextStats rewrites the term counts of uid for attr from its values in l, the data
+// list of uid, as of this transaction. The counts are kept next to the full-text index so
+// that matches can be scored with BM25 without tokenizing their values again. The key is
+// only changed by the mutations of uid, which makes concurrent changes to different values
+// of the same node conflict, as the counts cover all of them.
+func (txn *Txn) updateTextStats(ctx context.Context, attr string, uid uint64, l *List) error {
+	counts := make(map[string]int)
+	var length int
+	err := l.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
+		sv, err := types.Convert(types.Val{Tid: types.TypeID(p.ValType), Value: p.Value},
+			types.StringID)
+		if err != nil {
+			return err
+		}
+		c, n := tok.GetFullTextTermCounts(sv.Value.(string), string(p.LangTag))
+		for term, count := range c {
+			counts[term] += count
+		}
+		length += n
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	plist, err := txn.cache.GetFromDelta(TextStatsKey(attr, uid))
+	if err != nil {
+		return err
+	}
+	// The counts are kept as a single value, which is replaced as a whole.
+	edge := &pb.DirectedEdge{Attr: attr, Value: []byte(x.Star), Op: pb.DirectedEdge_DEL}
+	if err := plist.addMutation(ctx, txn, edge); err != nil {
+		return err
+	}
+	if length == 0 {
+		return nil
+	}
+	edge = &pb.DirectedEdge{
+		Attr:      attr,
+		Value:     encodeTextStats(counts, length),
+		ValueType: pb.Posting_BINARY,
+		Op:        pb.DirectedEdge_SET,
+	}
+	return plist.addMutation(ctx, txn, edge)
+}
+
+// encodeTextStats encodes the number of tokens of the values of a node followed by the
+// count of each of their terms.
+func encodeTextStats(counts map[string]int, length int) []byte {
+	terms := make([]string, 0, len(counts))
+	for term := range counts {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	buf := binary.AppendUvarint(nil, uint64(length))
+	buf = binary.AppendUvarint(buf, uint64(len(terms)))
+	for _, term := range terms {
+		buf = binary.AppendUvarint(buf, uint64(len(term)))
+		buf = append(buf, term...)
+		buf = binary.AppendUvarint(buf, uint64(counts[term]))
+	}
+	return buf
+}
+
+// DecodeTextStats decodes the term counts written by updateTextStats. It returns the count
+// of each full-text token and the number of tokens of the values.
+func DecodeTextStats(buf []byte) (map[string]int, int, error) {
+	next := func() (uint64, error) {
+		v, n := binary.Uvarint(buf)
+		if n <= 0 {
+			return 0, errors.New("invalid text stats")
+		}
+		buf = buf[n:]
+		return v, nil
+	}
+	length, err := next()
+	if err != nil {
+		return nil, 0, err
+	}
+	numTerms, err := next()
+	if err != nil {
+		return nil, 0, err
+	}
+	counts := make(map[string]int, numTerms)
+	for i := uint64(0); i < numTerms; i++ {
+		n, err := next()
+		if err != nil {
+			return nil, 0, err
+		}
+		if uint64(len(buf)) < n {
+			return nil, 0, errors.New("invalid text stats")
+		}
+		term := string(buf[:n])
+		buf = buf[n:]
+		count, err := next()
+		if err != nil {
+			return nil, 0, err
+		}
+		counts[term] = int(count)
+	}
+	return counts, int(length), nil
+}
+
+// TextStatsLength returns the number of tokens of the values of a node, without decoding
+// the term counts.
+func TextStatsLength(buf []byte) (int, error) {
+	length, n := binary.Uvarint(buf)
+	if n <= 0 {
+		return 0, errors.New("invalid text stats")
+	}
+	return int(length), nil
+}
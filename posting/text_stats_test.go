@@ -0,0 +1,84 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package posting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/schema"
+	"github.com/dgraph-io/dgraph/v24/tok"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+func TestTextStatsEncoding(t *testing.T) {
+	counts, length := tok.GetFullTextTermCounts("red red shoes for running", "en")
+	buf := encodeTextStats(counts, length)
+
+	decoded, n, err := DecodeTextStats(buf)
+	require.NoError(t, err)
+	require.Equal(t, counts, decoded)
+	require.Equal(t, length, n)
+	n, err = TextStatsLength(buf)
+	require.NoError(t, err)
+	require.Equal(t, length, n)
+
+	_, _, err = DecodeTextStats(buf[:len(buf)-2])
+	require.Error(t, err)
+}
+
+func TestTextStatsWithIndex(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("bio: string @index(fulltext) @lang ."), 1))
+	attr := x.GalaxyAttr("bio")
+	set := func(edge *pb.DirectedEdge, op uint32, startTs, commitTs uint64) {
+		l, err := GetNoStore(x.DataKey(attr, 1), startTs)
+		require.NoError(t, err)
+		addMutation(t, l, edge, op, startTs, commitTs, true)
+	}
+
+	stats := func(readTs uint64) (map[string]int, int) {
+		pl, err := GetNoStore(TextStatsKey(attr, 1), readTs)
+		require.NoError(t, err)
+		vals, err := pl.AllValues(readTs)
+		require.NoError(t, err)
+		if len(vals) == 0 {
+			return nil, 0
+		}
+		counts, length, err := DecodeTextStats(vals[0].Value.([]byte))
+		require.NoError(t, err)
+		return counts, length
+	}
+
+	set(&pb.DirectedEdge{Value: []byte("red red shoes"), Attr: attr, Entity: 1}, Set, 1, 2)
+	counts, length := stats(3)
+	require.Equal(t, 3, length)
+	require.Equal(t, 2, counts["\x08red"])
+
+	// The counts cover the values of every language.
+	set(&pb.DirectedEdge{Value: []byte("zapatos rojos"), Attr: attr, Entity: 1, Lang: "es"},
+		Set, 3, 4)
+	counts, length = stats(5)
+	require.Equal(t, 5, length)
+	require.Equal(t, 2, counts["\x08red"])
+
+	set(&pb.DirectedEdge{Value: []byte(x.Star), Attr: attr, Entity: 1}, Del, 5, 6)
+	counts, length = stats(7)
+	require.Nil(t, counts)
+	require.Zero(t, length)
+}
@@ -20,8 +20,11 @@ import (
 	"bytes"
 	"math"
 	"math/big"
+	"sort"
+	"strconv"
 	"time"
 
+	"github.com/dgryski/go-farm"
 	"github.com/pkg/errors"
 
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
@@ -33,6 +36,46 @@ type aggregator struct {
 	name   string
 	result types.Val
 	count  int // used when we need avergae.
+
+	// The statistical aggregators accumulate the values they are applied to,
+	// and compute result only when asked for it, see isStatAggregator.
+	percentile float64 // in [0, 1], for the percentile aggregators.
+	values     []float64
+	mean, m2   float64 // the running mean and sum of squared deviations.
+	distinct   map[string]struct{}
+	digest     *tDigest
+	hll        *hyperLogLog
+}
+
+// newAggregator returns the aggregator computing the aggregation function fn.
+func newAggregator(fn *Function) (*aggregator, error) {
+	ag := &aggregator{name: fn.Name}
+	switch fn.Name {
+	case "median":
+		ag.percentile = 0.5
+	case "percentile", "approx_percentile":
+		if len(fn.Args) != 1 {
+			return nil, errors.Errorf("Function %s expects a percentile", fn.Name)
+		}
+		p, err := strconv.ParseFloat(fn.Args[0].Value, 64)
+		if err != nil || p < 0 || p > 100 {
+			return nil, errors.Errorf("Percentile of %s should be a number between 0 and 100."+
+				" Got: %s", fn.Name, fn.Args[0].Value)
+		}
+		ag.percentile = p / 100
+	}
+	return ag, nil
+}
+
+// isStatAggregator returns true for the statistical aggregation functions.
+// Their result does not depend on the type of the values, unlike min or sum.
+func isStatAggregator(f string) bool {
+	switch f {
+	case "median", "percentile", "approx_percentile", "stddev", "variance", "count_distinct",
+		"approx_count_distinct":
+		return true
+	}
+	return false
 }
 
 func isUnary(f string) bool {
@@ -803,6 +846,9 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 }
 
 func (ag *aggregator) Apply(val types.Val) error {
+	if isStatAggregator(ag.name) {
+		return ag.applyStat(val)
+	}
 	if ag.result.Value == nil {
 		if val.Tid == types.VFloatID {
 			// Copy array if it's VFloat, otherwise we overwrite value.
@@ -864,9 +910,91 @@ func (ag *aggregator) Apply(val types.Val) error {
 	return nil
 }
 
+// applyStat accumulates val for a statistical aggregator.
+func (ag *aggregator) applyStat(val types.Val) error {
+	switch ag.name {
+	case "count_distinct", "approx_count_distinct":
+		key := types.Val{Tid: types.StringID, Value: ""}
+		if err := types.Marshal(val, &key); err != nil {
+			return err
+		}
+		ag.count++
+		if ag.name == "approx_count_distinct" {
+			if ag.hll == nil {
+				ag.hll = newHyperLogLog()
+			}
+			ag.hll.Add(farm.Fingerprint64([]byte(key.Value.(string))))
+			return nil
+		}
+		if ag.distinct == nil {
+			ag.distinct = make(map[string]struct{})
+		}
+		ag.distinct[key.Value.(string)] = struct{}{}
+		return nil
+	}
+
+	var v float64
+	switch val.Tid {
+	case types.IntID:
+		v = float64(val.Value.(int64))
+	case types.FloatID:
+		v = val.Value.(float64)
+	default:
+		return errors.Errorf("Function %s is only supported on int and float values. Got: %s",
+			ag.name, val.Tid.Name())
+	}
+	ag.count++
+	switch ag.name {
+	case "stddev", "variance":
+		// Welford's algorithm, which is numerically stable.
+		delta := v - ag.mean
+		ag.mean += delta / float64(ag.count)
+		ag.m2 += delta * (v - ag.mean)
+	case "approx_percentile":
+		if ag.digest == nil {
+			ag.digest = &tDigest{}
+		}
+		ag.digest.Add(v)
+	default:
+		ag.values = append(ag.values, v)
+	}
+	return nil
+}
+
+// statValue computes the result of a statistical aggregator.
+func (ag *aggregator) statValue() {
+	if ag.count == 0 || ag.result.Value != nil {
+		return
+	}
+	switch ag.name {
+	case "count_distinct":
+		ag.result = types.Val{Tid: types.IntID, Value: int64(len(ag.distinct))}
+	case "approx_count_distinct":
+		ag.result = types.Val{Tid: types.IntID, Value: int64(ag.hll.Count())}
+	case "variance":
+		// The population variance.
+		ag.result = types.Val{Tid: types.FloatID, Value: ag.m2 / float64(ag.count)}
+	case "stddev":
+		ag.result = types.Val{Tid: types.FloatID, Value: math.Sqrt(ag.m2 / float64(ag.count))}
+	case "approx_percentile":
+		ag.result = types.Val{Tid: types.FloatID, Value: ag.digest.Quantile(ag.percentile)}
+	default:
+		// The percentile is interpolated between the two closest values.
+		sort.Float64s(ag.values)
+		rank := ag.percentile * float64(len(ag.values)-1)
+		lo := int(math.Floor(rank))
+		hi := int(math.Ceil(rank))
+		v := ag.values[lo] + (rank-float64(lo))*(ag.values[hi]-ag.values[lo])
+		ag.result = types.Val{Tid: types.FloatID, Value: v}
+	}
+}
+
 func (ag *aggregator) ValueMarshalled() (*pb.TaskValue, error) {
 	data := types.ValueForType(types.BinaryID)
 	ag.divideByCount()
+	if isStatAggregator(ag.name) {
+		ag.statValue()
+	}
 	res := &pb.TaskValue{ValType: ag.result.Tid.Enum(), Val: x.Nilbyte}
 	if ag.result.Value == nil {
 		return res, nil
@@ -914,6 +1042,9 @@ func (ag *aggregator) divideByCount() {
 }
 
 func (ag *aggregator) Value() (types.Val, error) {
+	if isStatAggregator(ag.name) {
+		ag.statValue()
+	}
 	if ag.result.Value == nil {
 		return ag.result, ErrEmptyVal
 	}
@@ -0,0 +1,116 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/types"
+)
+
+func aggregate(t *testing.T, fn *Function, vals ...types.Val) types.Val {
+	ag, err := newAggregator(fn)
+	require.NoError(t, err)
+	for _, val := range vals {
+		require.NoError(t, ag.Apply(val))
+	}
+	v, err := ag.Value()
+	require.NoError(t, err)
+	return v
+}
+
+func intVals(vs ...int64) []types.Val {
+	vals := make([]types.Val, 0, len(vs))
+	for _, v := range vs {
+		vals = append(vals, types.Val{Tid: types.IntID, Value: v})
+	}
+	return vals
+}
+
+func TestStatAggregators(t *testing.T) {
+	vals := intVals(4, 1, 3, 2, 10)
+	pct := func(name, p string) *Function {
+		return &Function{Name: name, Args: []dql.Arg{{Value: p}}}
+	}
+
+	require.Equal(t, 3.0, aggregate(t, &Function{Name: "median"}, vals...).Value)
+	require.Equal(t, 2.5, aggregate(t, &Function{Name: "median"}, vals[:4]...).Value)
+	require.Equal(t, 1.0, aggregate(t, pct("percentile", "0"), vals...).Value)
+	require.Equal(t, 10.0, aggregate(t, pct("percentile", "100"), vals...).Value)
+	require.InDelta(t, 8.8, aggregate(t, pct("percentile", "95"), vals...).Value, 1e-9)
+	require.Equal(t, 7.0, aggregate(t, &Function{Name: "percentile",
+		Args: []dql.Arg{{Value: "50"}}}, types.Val{Tid: types.FloatID, Value: 7.0}).Value)
+
+	// The population variance of 1, 2, 3, 4 and 10.
+	require.InDelta(t, 10.0, aggregate(t, &Function{Name: "variance"}, vals...).Value, 1e-9)
+	require.InDelta(t, math.Sqrt(10), aggregate(t, &Function{Name: "stddev"}, vals...).Value, 1e-9)
+
+	strs := []types.Val{
+		{Tid: types.StringID, Value: "a"},
+		{Tid: types.StringID, Value: "b"},
+		{Tid: types.StringID, Value: "a"},
+	}
+	require.Equal(t, int64(2), aggregate(t, &Function{Name: "count_distinct"}, strs...).Value)
+	require.Equal(t, int64(2), aggregate(t, &Function{Name: "approx_count_distinct"}, strs...).Value)
+
+	ag, err := newAggregator(&Function{Name: "median"})
+	require.NoError(t, err)
+	require.Error(t, ag.Apply(strs[0]))
+	_, err = ag.Value()
+	require.Equal(t, ErrEmptyVal, err)
+
+	_, err = newAggregator(&Function{Name: "percentile"})
+	require.Error(t, err)
+	_, err = newAggregator(pct("approx_percentile", "120"))
+	require.Error(t, err)
+}
+
+func TestTDigest(t *testing.T) {
+	var d tDigest
+	require.True(t, math.IsNaN(d.Quantile(0.5)))
+
+	r := rand.New(rand.NewSource(1))
+	for _, i := range r.Perm(100000) {
+		d.Add(float64(i))
+	}
+	require.Less(t, len(d.centroids), 5*tDigestCompression)
+	require.Equal(t, 0.0, d.Quantile(0))
+	require.Equal(t, 99999.0, d.Quantile(1))
+	for _, q := range []float64{0.01, 0.5, 0.9, 0.99, 0.999} {
+		require.InDelta(t, q*100000, d.Quantile(q), 100000*0.005, "quantile %f", q)
+	}
+}
+
+func TestHyperLogLog(t *testing.T) {
+	for _, n := range []int{10, 1000, 100000} {
+		ag, err := newAggregator(&Function{Name: "approx_count_distinct"})
+		require.NoError(t, err)
+		for i := 0; i < 2*n; i++ {
+			val := types.Val{Tid: types.StringID, Value: strconv.Itoa(i % n)}
+			require.NoError(t, ag.Apply(val))
+		}
+		v, err := ag.Value()
+		require.NoError(t, err)
+		require.InEpsilon(t, n, v.Value, 0.05, "distinct values %d", n)
+	}
+}
@@ -0,0 +1,379 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/types"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+const (
+	defaultDamping    = 0.85
+	defaultIterations = 20
+	defaultTolerance  = 1e-6
+)
+
+// algoGraph is the graph a graph algorithm block runs on. The nodes are identified by
+// their index in uids, which is sorted.
+type algoGraph struct {
+	uids []uint64
+	// out holds the indexes of the targets of the edges leaving every node. An edge is
+	// repeated if it is found through several predicates.
+	out [][]int
+}
+
+func (g *algoGraph) index(uid uint64) int {
+	return sort.Search(len(g.uids), func(i int) bool { return g.uids[i] >= uid })
+}
+
+// newAlgoGraph builds the graph from the edges leaving every uid of nodes, which
+// includes the uids only reached by an edge.
+func newAlgoGraph(nodes []uint64, edges map[uint64][]uint64) *algoGraph {
+	g := &algoGraph{uids: nodes}
+	g.out = make([][]int, len(g.uids))
+	for u, targets := range edges {
+		ui := g.index(u)
+		for _, v := range targets {
+			g.out[ui] = append(g.out[ui], g.index(v))
+		}
+	}
+	return g
+}
+
+// pageRank computes the PageRank of every node of g. The ranks of the dangling nodes,
+// without any edge leaving them, are spread over all the nodes. It stops after
+// iterations iterations or once the ranks change by less than tolerance in total.
+func pageRank(g *algoGraph, damping float64, iterations int, tolerance float64) []float64 {
+	n := len(g.uids)
+	if n == 0 {
+		return nil
+	}
+	rank := make([]float64, n)
+	for i := range rank {
+		rank[i] = 1 / float64(n)
+	}
+	next := make([]float64, n)
+	for iter := 0; iter < iterations; iter++ {
+		var dangling float64
+		for u, targets := range g.out {
+			if len(targets) == 0 {
+				dangling += rank[u]
+			}
+		}
+		base := (1-damping)/float64(n) + damping*dangling/float64(n)
+		for i := range next {
+			next[i] = base
+		}
+		for u, targets := range g.out {
+			share := damping * rank[u] / float64(len(targets))
+			for _, v := range targets {
+				next[v] += share
+			}
+		}
+
+		var delta float64
+		for i := range rank {
+			delta += math.Abs(next[i] - rank[i])
+		}
+		rank, next = next, rank
+		if delta < tolerance {
+			break
+		}
+	}
+	return rank
+}
+
+// connectedComponents labels every node of g with the smallest uid of its weakly
+// connected component.
+func connectedComponents(g *algoGraph) []uint64 {
+	parent := make([]int, len(g.uids))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for u, targets := range g.out {
+		for _, v := range targets {
+			ru, rv := find(u), find(v)
+			// The root of a component is its smallest index, hence its smallest uid.
+			if ru < rv {
+				parent[rv] = ru
+			} else {
+				parent[ru] = rv
+			}
+		}
+	}
+
+	labels := make([]uint64, len(g.uids))
+	for i := range labels {
+		labels[i] = g.uids[find(i)]
+	}
+	return labels
+}
+
+// louvain detects the communities of g by maximizing their modularity with the Louvain
+// method, ignoring the direction of the edges. Every node is first moved to the
+// community of a neighbor if that increases the modularity, in the order of the uids,
+// until no node moves or after iterations passes over the nodes. The communities found
+// then become the nodes of a smaller graph and the process repeats until no node moves.
+// Every node is labeled with the smallest uid of its community.
+func louvain(g *algoGraph, iterations int) []uint64 {
+	n := len(g.uids)
+	// The graph of the current level: the weight of the edges between its nodes, which
+	// don't include the edges inside a node, and the degree of its nodes.
+	adj := make([]map[int]float64, n)
+	degree := make([]float64, n)
+	for i := range adj {
+		adj[i] = make(map[int]float64)
+	}
+	var m2 float64
+	for u, targets := range g.out {
+		for _, v := range targets {
+			degree[u]++
+			degree[v]++
+			m2 += 2
+			if u != v {
+				adj[u][v]++
+				adj[v][u]++
+			}
+		}
+	}
+	// node maps every node of g to its node in the current level.
+	node := make([]int, n)
+	for i := range node {
+		node[i] = i
+	}
+
+	for m2 > 0 {
+		comm := make([]int, len(adj))
+		total := make([]float64, len(adj))
+		for i := range comm {
+			comm[i] = i
+			total[i] = degree[i]
+		}
+		var moved bool
+		weights := make(map[int]float64)
+		for pass := 0; pass < iterations; pass++ {
+			var movedInPass bool
+			for u := range adj {
+				cur := comm[u]
+				total[cur] -= degree[u]
+				for c := range weights {
+					delete(weights, c)
+				}
+				for v, w := range adj[u] {
+					weights[comm[v]] += w
+				}
+				// The modularity gain of adding u to the community c, up to a factor.
+				gain := func(c int) float64 {
+					return weights[c] - total[c]*degree[u]/m2
+				}
+				best, bestGain := cur, gain(cur)
+				for c := range weights {
+					// Ties keep u in its community, or pick the smallest one otherwise so that
+					// the result doesn't depend on the order of the map.
+					if cg := gain(c); cg > bestGain || (cg == bestGain && best != cur && c < best) {
+						best, bestGain = c, cg
+					}
+				}
+				total[best] += degree[u]
+				if best != cur {
+					comm[u] = best
+					movedInPass = true
+				}
+			}
+			if !movedInPass {
+				break
+			}
+			moved = true
+		}
+		if !moved {
+			break
+		}
+
+		// Aggregate the communities into the nodes of the next level.
+		index := make(map[int]int)
+		for _, c := range comm {
+			if _, ok := index[c]; !ok {
+				index[c] = len(index)
+			}
+		}
+		nextAdj := make([]map[int]float64, len(index))
+		nextDegree := make([]float64, len(index))
+		for i := range nextAdj {
+			nextAdj[i] = make(map[int]float64)
+		}
+		for u := range adj {
+			cu := index[comm[u]]
+			nextDegree[cu] += degree[u]
+			for v, w := range adj[u] {
+				if cv := index[comm[v]]; cu != cv {
+					nextAdj[cu][cv] += w
+				}
+			}
+		}
+		for i := range node {
+			node[i] = index[comm[node[i]]]
+		}
+		adj, degree = nextAdj, nextDegree
+	}
+
+	// The uids are sorted, so the first uid seen in a community is its smallest one.
+	first := make(map[int]uint64)
+	labels := make([]uint64, n)
+	for i, uid := range g.uids {
+		if _, ok := first[node[i]]; !ok {
+			first[node[i]] = uid
+		}
+		labels[i] = first[node[i]]
+	}
+	return labels
+}
+
+// collectAlgoGraph finds the graph a graph algorithm block runs on. It starts from the
+// nodes of the block and follows the predicates of its children, up to the depth of the
+// block if any, fetching the edges of every level from the groups serving them.
+func (sg *SubGraph) collectAlgoGraph(ctx context.Context) (*algoGraph, error) {
+	edges := make(map[uint64][]uint64)
+	seen := make(map[uint64]struct{})
+	var nodes []uint64
+	visit := func(uids []uint64) []uint64 {
+		var fresh []uint64
+		for _, uid := range uids {
+			if _, ok := seen[uid]; ok {
+				continue
+			}
+			seen[uid] = struct{}{}
+			fresh = append(fresh, uid)
+		}
+		nodes = append(nodes, fresh...)
+		return fresh
+	}
+
+	maxDepth := uint64(math.MaxUint64)
+	if sg.Params.ExploreDepth != nil {
+		maxDepth = *sg.Params.ExploreDepth
+	}
+	var numEdges uint64
+	frontier := visit(sg.DestUIDs.GetUids())
+	dummy := &SubGraph{}
+	for depth := uint64(0); depth < maxDepth && len(frontier) > 0; depth++ {
+		var exec []*SubGraph
+		for _, child := range sg.Children {
+			temp := new(SubGraph)
+			temp.copyFiltersRecurse(child)
+			temp.SrcUIDs = &pb.List{Uids: frontier}
+			exec = append(exec, temp)
+		}
+		rch := make(chan error, len(exec))
+		for _, subgraph := range exec {
+			go ProcessGraph(ctx, subgraph, dummy, rch)
+		}
+		for range exec {
+			select {
+			case err := <-rch:
+				if err != nil {
+					return nil, err
+				}
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+
+		var next []uint64
+		for _, subgraph := range exec {
+			if subgraph.UnknownAttr {
+				continue
+			}
+			subgraph.updateUidMatrix()
+			for i, from := range subgraph.SrcUIDs.Uids {
+				if i >= len(subgraph.uidMatrix) {
+					continue
+				}
+				targets := subgraph.uidMatrix[i].Uids
+				edges[from] = append(edges[from], targets...)
+				numEdges += uint64(len(targets))
+				next = append(next, visit(targets)...)
+			}
+		}
+		if numEdges > x.Config.LimitQueryEdge {
+			return nil, errors.Errorf("Exceeded query edge limit = %v. Found %v edges.",
+				x.Config.LimitQueryEdge, numEdges)
+		}
+		sort.Slice(next, func(i, j int) bool { return next[i] < next[j] })
+		frontier = next
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i] < nodes[j] })
+	return newAlgoGraph(nodes, edges), nil
+}
+
+// runGraphAlgorithm runs the graph algorithm of a pagerank, components or communities
+// block. The score or the label of every node reached is kept for the variable of the
+// block, which is a value variable.
+func runGraphAlgorithm(ctx context.Context, sg *SubGraph) error {
+	// The children of the block are the predicates to follow, the starting nodes are
+	// found without them.
+	children := sg.Children
+	sg.Children = nil
+	rch := make(chan error, 1)
+	ProcessGraph(ctx, sg, nil, rch)
+	if err := <-rch; err != nil {
+		return err
+	}
+	sg.Children = children
+
+	g, err := sg.collectAlgoGraph(ctx)
+	if err != nil {
+		return err
+	}
+	// The predicates were only followed to find the graph, there is nothing to return.
+	sg.Children = nil
+
+	sg.algoValues = make(map[uint64]types.Val, len(g.uids))
+	switch sg.Params.Alias {
+	case "pagerank":
+		ranks := pageRank(g, sg.Params.Damping, sg.Params.Iterations, sg.Params.Tolerance)
+		for i, uid := range g.uids {
+			sg.algoValues[uid] = types.Val{Tid: types.FloatID, Value: ranks[i]}
+		}
+		return nil
+	case "components":
+		for i, label := range connectedComponents(g) {
+			sg.algoValues[g.uids[i]] = types.Val{Tid: types.IntID, Value: int64(label)}
+		}
+		return nil
+	case "communities":
+		for i, label := range louvain(g, sg.Params.Iterations) {
+			sg.algoValues[g.uids[i]] = types.Val{Tid: types.IntID, Value: int64(label)}
+		}
+		return nil
+	}
+	return errors.Errorf("Unknown graph algorithm %s", sg.Params.Alias)
+}
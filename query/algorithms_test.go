@@ -0,0 +1,88 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPageRank(t *testing.T) {
+	// 1 -> 2 -> 3 -> 1 is a cycle, so every node has the same rank.
+	g := newAlgoGraph([]uint64{1, 2, 3}, map[uint64][]uint64{1: {2}, 2: {3}, 3: {1}})
+	for _, r := range pageRank(g, defaultDamping, defaultIterations, defaultTolerance) {
+		require.InDelta(t, 1.0/3, r, 1e-9)
+	}
+
+	// 31 -> 24 where 24 is dangling. The ranks are the solution of
+	// r31 = 0.15/2 + 0.85*r24/2 and r24 = 0.15/2 + 0.85*(r31 + r24/2).
+	g = newAlgoGraph([]uint64{24, 31}, map[uint64][]uint64{31: {24}})
+	ranks := pageRank(g, defaultDamping, 100, 1e-12)
+	require.InDelta(t, 0.5/1.425, ranks[1], 1e-9)
+	require.InDelta(t, 1-0.5/1.425, ranks[0], 1e-9)
+
+	// A star pointing to its center.
+	g = newAlgoGraph([]uint64{1, 2, 3, 4}, map[uint64][]uint64{2: {1}, 3: {1}, 4: {1}})
+	ranks = pageRank(g, defaultDamping, defaultIterations, defaultTolerance)
+	var sum float64
+	for _, r := range ranks {
+		sum += r
+	}
+	require.InDelta(t, 1, sum, 1e-9)
+	require.Greater(t, ranks[0], ranks[1])
+	require.Equal(t, ranks[1], ranks[2])
+
+	// No iteration gives the initial ranks.
+	require.Equal(t, []float64{0.25, 0.25, 0.25, 0.25}, pageRank(g, defaultDamping, 0, 0))
+	require.Empty(t, pageRank(newAlgoGraph(nil, nil), defaultDamping, 1, 0))
+}
+
+func TestConnectedComponents(t *testing.T) {
+	g := newAlgoGraph([]uint64{1, 2, 3, 4, 5, 6, 7},
+		map[uint64][]uint64{3: {1}, 2: {5}, 5: {2, 5}, 6: {2}, 4: {4}})
+	require.Equal(t, []uint64{1, 2, 1, 4, 2, 2, 7}, connectedComponents(g))
+}
+
+func TestLouvain(t *testing.T) {
+	// Two triangles joined by the edge 3 -> 4, and an isolated node.
+	g := newAlgoGraph([]uint64{1, 2, 3, 4, 5, 6, 9}, map[uint64][]uint64{
+		1: {2, 3},
+		2: {3},
+		3: {4},
+		4: {5, 6},
+		5: {6},
+	})
+	require.Equal(t, []uint64{1, 1, 1, 4, 4, 4, 9}, louvain(g, defaultIterations))
+
+	// Without iterations, every node is alone in its community.
+	require.Equal(t, g.uids, louvain(g, 0))
+
+	// Two cliques of four nodes joined by a path through 5 find each other again once
+	// aggregated.
+	edges := map[uint64][]uint64{4: {5}, 5: {6}}
+	for _, clique := range [][]uint64{{1, 2, 3, 4}, {6, 7, 8, 9}} {
+		for i, u := range clique {
+			edges[u] = append(edges[u], clique[i+1:]...)
+		}
+	}
+	g = newAlgoGraph([]uint64{1, 2, 3, 4, 5, 6, 7, 8, 9}, edges)
+	labels := louvain(g, defaultIterations)
+	require.Equal(t, []uint64{1, 1, 1, 1}, labels[:4])
+	require.Equal(t, []uint64{6, 6, 6, 6}, labels[5:])
+	require.Contains(t, []uint64{1, 6}, labels[4])
+}
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/dgraph-io/dgo/v230/protos/api"
 	"github.com/dgraph-io/dgraph/v24/dgraphapi"
@@ -89,6 +90,22 @@ func processQueryRDF(ctx context.Context, query string) (string, error) {
 	return string(res.Rdf), err
 }
 
+// processQueryFormat works like processQueryRDF but returns the response in the format given,
+// one of the formats returned in Response.Json.
+func processQueryFormat(ctx context.Context, query string, format string) (string, error) {
+	ctx = metadata.AppendToOutgoingContext(ctx, "resp-format", format)
+	txn := client.NewTxn()
+	defer func() { _ = txn.Discard(ctx) }()
+
+	res, err := txn.Do(ctx, &api.Request{
+		Query: query,
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(res.Json), err
+}
+
 func processQueryNoErr(t *testing.T, query string) string {
 	res, err := processQuery(context.Background(), t, query)
 	require.NoError(t, err)
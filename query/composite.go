@@ -0,0 +1,152 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/schema"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+// compositeFnName is the name of the function that evaluates comparisons on a composite
+// index. It isn't part of DQL, see useCompositeIndexes.
+const compositeFnName = "composite"
+
+// isCompositeComparison returns true if the filter f is a comparison of a predicate with
+// values given in the query, that a composite index can evaluate.
+func isCompositeComparison(f *SubGraph) bool {
+	fn := f.SrcFunc
+	if fn == nil || f.FilterOp != "" || len(f.Filters) > 0 || fn.IsCount || fn.IsValueVar ||
+		fn.IsLenVar || len(f.Params.Langs) > 0 || len(f.Params.NeedsVar) > 0 ||
+		strings.HasPrefix(f.Attr, "~") {
+		return false
+	}
+	for _, arg := range fn.Args {
+		if arg.IsValueVar {
+			return false
+		}
+	}
+	switch fn.Name {
+	case "eq", "ge", "gt", "le", "lt":
+		return len(fn.Args) == 1
+	case "between":
+		return len(fn.Args) == 2
+	}
+	return false
+}
+
+// useCompositeIndexes replaces the comparisons of an and filter that match a composite
+// index with the composite function, which evaluates them with a single lookup of the index
+// instead of intersecting the uids matching each of them. The comparisons match the index
+// @composite(pred1, pred2) of pred if they compare pred, or pred and pred1, with eq, and the
+// predicate that follows with any comparison, as long as two predicates are compared.
+// For example, eq(country, "DE") AND ge(age, 30) becomes:
+//
+//	composite(country, "ge", "DE", "30")
+//
+// The indexes aren't used while some index is being built, as a composite index may miss
+// nodes until the change of the schema of one of its predicates is done.
+func (sg *SubGraph) useCompositeIndexes(ctx context.Context) {
+	if sg.FilterOp != "and" || schema.State().IndexingInProgress() {
+		return
+	}
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return
+	}
+
+	for {
+		// The comparisons of every predicate, by position in sg.Filters.
+		comparisons := make(map[string][]int)
+		for i, f := range sg.Filters {
+			if isCompositeComparison(f) {
+				comparisons[f.Attr] = append(comparisons[f.Attr], i)
+			}
+		}
+
+		var best []int
+		for attr := range comparisons {
+			with := schema.State().CompositeWith(ctx, x.NamespaceAttr(ns, attr))
+			if len(with) == 0 {
+				continue
+			}
+			preds := append([]string{attr}, x.ParseAttrList(with)...)
+			var matched []int
+			for _, pred := range preds {
+				eq, other := -1, -1
+				for _, i := range comparisons[pred] {
+					if sg.Filters[i].SrcFunc.Name == "eq" {
+						eq = i
+						break
+					}
+					if other == -1 {
+						other = i
+					}
+				}
+				if eq != -1 {
+					matched = append(matched, eq)
+					continue
+				}
+				if other != -1 {
+					matched = append(matched, other)
+				}
+				break
+			}
+			if len(matched) >= 2 && (len(matched) > len(best) ||
+				(len(matched) == len(best) && attr < sg.Filters[best[0]].Attr)) {
+				best = matched
+			}
+		}
+		if len(best) == 0 {
+			return
+		}
+
+		first, last := sg.Filters[best[0]], sg.Filters[best[len(best)-1]]
+		fn := &Function{
+			Name: compositeFnName,
+			Args: []dql.Arg{{Value: last.SrcFunc.Name}},
+		}
+		for _, i := range best {
+			fn.Args = append(fn.Args, sg.Filters[i].SrcFunc.Args...)
+		}
+		composite := &SubGraph{
+			Attr:    first.Attr,
+			SrcFunc: fn,
+			ReadTs:  first.ReadTs,
+			Cache:   first.Cache,
+		}
+
+		filters := sg.Filters[:0:0]
+		for i, f := range sg.Filters {
+			if i == best[0] {
+				filters = append(filters, composite)
+				continue
+			}
+			used := false
+			for _, j := range best {
+				used = used || i == j
+			}
+			if !used {
+				filters = append(filters, f)
+			}
+		}
+		sg.Filters = filters
+	}
+}
@@ -0,0 +1,120 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/schema"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+func compositeFilter(attr, fname string, values ...string) *SubGraph {
+	fn := &Function{Name: fname}
+	for _, v := range values {
+		fn.Args = append(fn.Args, dql.Arg{Value: v})
+	}
+	return &SubGraph{Attr: attr, SrcFunc: fn}
+}
+
+func TestUseCompositeIndexes(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		country: string @composite(age, city) .
+		age: int .
+		city: string .
+		name: string .
+	`), 1))
+	ctx := x.AttachNamespace(context.Background(), x.GalaxyNamespace)
+
+	tests := []struct {
+		name     string
+		filters  []*SubGraph
+		expected []*SubGraph
+	}{
+		{
+			name: "eq prefix and range",
+			filters: []*SubGraph{
+				compositeFilter("name", "eq", "x"),
+				compositeFilter("age", "ge", "30"),
+				compositeFilter("country", "eq", "DE"),
+			},
+			expected: []*SubGraph{
+				compositeFilter("name", "eq", "x"),
+				compositeFilter("country", "composite", "ge", "DE", "30"),
+			},
+		},
+		{
+			name: "all predicates",
+			filters: []*SubGraph{
+				compositeFilter("city", "between", "a", "m"),
+				compositeFilter("country", "eq", "DE"),
+				compositeFilter("age", "eq", "30"),
+			},
+			expected: []*SubGraph{
+				compositeFilter("country", "composite", "between", "DE", "30", "a", "m"),
+			},
+		},
+		{
+			name: "no eq on the first predicate",
+			filters: []*SubGraph{
+				compositeFilter("country", "ge", "DE"),
+				compositeFilter("age", "eq", "30"),
+			},
+		},
+		{
+			name: "gap in the prefix",
+			filters: []*SubGraph{
+				compositeFilter("country", "eq", "DE"),
+				compositeFilter("city", "eq", "Berlin"),
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			sg := &SubGraph{FilterOp: "and", Filters: tc.filters}
+			expected := tc.expected
+			if expected == nil {
+				expected = append([]*SubGraph(nil), tc.filters...)
+			}
+			sg.useCompositeIndexes(ctx)
+			require.Equal(t, expected, sg.Filters)
+		})
+	}
+
+	// Only and filters are rewritten.
+	filters := []*SubGraph{
+		compositeFilter("country", "eq", "DE"),
+		compositeFilter("age", "eq", "30"),
+	}
+	sg := &SubGraph{FilterOp: "or", Filters: filters}
+	sg.useCompositeIndexes(ctx)
+	require.Equal(t, filters, sg.Filters)
+
+	// Nor while an index is being built.
+	name := x.GalaxyAttr("name")
+	schema.State().SetMutSchema(name,
+		&pb.SchemaUpdate{Predicate: name, Directive: pb.SchemaUpdate_INDEX})
+	defer schema.State().DeleteMutSchema(name)
+	sg = &SubGraph{FilterOp: "and", Filters: filters}
+	sg.useCompositeIndexes(ctx)
+	require.Equal(t, filters, sg.Filters)
+}
@@ -0,0 +1,137 @@
+//go:build integration || cloud || upgrade
+
+/*
+ * Copyright 2023 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNDJSONResult(t *testing.T) {
+	query := `{
+		me(func: uid(1)) {
+			name
+		}
+		friends(func: uid(23, 24)) {
+			name
+			age
+		}
+	}`
+	ndjson, err := processQueryFormat(context.Background(), query, RespFormatNDJSON)
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"Michonne"}
+{"name":"Rick Grimes","age":15}
+{"name":"Glenn Rhee","age":15}
+`, ndjson)
+}
+
+func TestCSVResult(t *testing.T) {
+	query := `{
+		friends(func: uid(23, 24, 25)) {
+			uid
+			name
+			age
+		}
+	}`
+	csv, err := processQueryFormat(context.Background(), query, RespFormatCSV)
+	require.NoError(t, err)
+	require.Equal(t, `uid,name,age
+0x17,Rick Grimes,15
+0x18,Glenn Rhee,15
+0x19,Daryl Dixon,17
+`, csv)
+}
+
+func TestCSVNormalize(t *testing.T) {
+	query := `{
+		me(func: uid(1)) @normalize {
+			n: name
+			son {
+				sn: name
+			}
+		}
+	}`
+	csv, err := processQueryFormat(context.Background(), query, RespFormatCSV)
+	require.NoError(t, err)
+	require.Equal(t, `n,sn
+Michonne,Andre
+Michonne,Helmut
+`, csv)
+}
+
+func TestCSVNested(t *testing.T) {
+	query := `{
+		me(func: uid(1)) {
+			name
+			friend {
+				name
+			}
+		}
+	}`
+	_, err := processQueryFormat(context.Background(), query, RespFormatCSV)
+	require.Contains(t, err.Error(),
+		"nested objects are not supported in the csv output format, use @normalize to flatten friend")
+}
+
+func TestCSVList(t *testing.T) {
+	query := `{
+		me(func: uid(1)) {
+			name
+			graduation
+		}
+	}`
+	_, err := processQueryFormat(context.Background(), query, RespFormatCSV)
+	require.Contains(t, err.Error(), "list values are not supported in the csv output format")
+}
+
+func TestGraphSONResult(t *testing.T) {
+	query := `{
+		me(func: uid(1)) {
+			name
+			friend @filter(uid(23)) {
+				name
+			}
+		}
+	}`
+	graphson, err := processQueryFormat(context.Background(), query, RespFormatGraphSON)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"@type": "g:List", "@value": [
+		{"@type": "g:Vertex", "@value": {"id": "0x1", "label": "vertex", "properties": {
+			"name": [{"@type": "g:VertexProperty",
+				"@value": {"id": "0x1|name|0", "value": "Michonne", "label": "name"}}]}}},
+		{"@type": "g:Vertex", "@value": {"id": "0x17", "label": "vertex", "properties": {
+			"name": [{"@type": "g:VertexProperty",
+				"@value": {"id": "0x17|name|0", "value": "Rick Grimes", "label": "name"}}]}}},
+		{"@type": "g:Edge", "@value": {"id": "0x1|friend|0x17", "label": "friend",
+			"inVLabel": "vertex", "outVLabel": "vertex", "inV": "0x17", "outV": "0x1"}}
+	]}`, graphson)
+}
+
+func TestGraphSONGroupBy(t *testing.T) {
+	query := `
+	{
+		me(func: uid(1, 23, 24, 25, 31)) @groupby(age) {
+				count(uid)
+		}
+	}`
+	_, err := processQueryFormat(context.Background(), query, RespFormatGraphSON)
+	require.Contains(t, err.Error(), "groupby is not supported in graphson output format")
+}
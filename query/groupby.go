@@ -24,8 +24,10 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/dgraph-io/dgraph/v24/algo"
+	"github.com/dgraph-io/dgraph/v24/dql"
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
 	"github.com/dgraph-io/dgraph/v24/types"
+	"github.com/dgraph-io/dgraph/v24/x"
 )
 
 type groupPair struct {
@@ -39,15 +41,28 @@ type groupResult struct {
 	uids       []uint64
 }
 
+// groupFieldName returns the name of the key or the aggregate computed by child in the
+// groups, as it appears in the result.
+func groupFieldName(child *SubGraph) string {
+	switch {
+	case child.Params.Alias != "":
+		return child.Params.Alias
+	case child.Params.IgnoreResult:
+		return child.Attr
+	case child.Params.DoCount:
+		return "count"
+	case child.SrcFunc != nil:
+		return fmt.Sprintf("%s(%s)", child.SrcFunc.Name, child.Attr)
+	}
+	return child.Attr
+}
+
 func (grp *groupResult) aggregateChild(child *SubGraph) error {
-	fieldName := child.Params.Alias
+	fieldName := groupFieldName(child)
 	if child.Params.DoCount {
 		if child.Attr != "uid" {
 			return errors.Errorf("Only uid predicate is allowed in count within groupby")
 		}
-		if fieldName == "" {
-			fieldName = "count"
-		}
 		grp.aggregates = append(grp.aggregates, groupPair{
 			attr: fieldName,
 			key: types.Val{
@@ -58,9 +73,6 @@ func (grp *groupResult) aggregateChild(child *SubGraph) error {
 		return nil
 	}
 	if child.SrcFunc != nil && isAggregatorFn(child.SrcFunc.Name) {
-		if fieldName == "" {
-			fieldName = fmt.Sprintf("%s(%s)", child.SrcFunc.Name, child.Attr)
-		}
 		finalVal, err := aggregateGroup(grp, child)
 		if err != nil {
 			return err
@@ -73,6 +85,87 @@ func (grp *groupResult) aggregateChild(child *SubGraph) error {
 	return nil
 }
 
+// value returns the value of the key or the aggregate of the group named name.
+func (grp *groupResult) value(name string) (types.Val, bool) {
+	for _, p := range grp.keys {
+		if p.attr == name {
+			return p.key, true
+		}
+	}
+	for _, p := range grp.aggregates {
+		if p.attr == name {
+			return p.key, true
+		}
+	}
+	return types.Val{}, false
+}
+
+// matches evaluates the having filter of a groupby on the group.
+func (grp *groupResult) matches(ft *dql.FilterTree) (bool, error) {
+	switch ft.Op {
+	case "and", "or":
+		for _, child := range ft.Child {
+			ok, err := grp.matches(child)
+			if err != nil {
+				return false, err
+			}
+			if ok != (ft.Op == "and") {
+				return ok, nil
+			}
+		}
+		return ft.Op == "and", nil
+	case "not":
+		if len(ft.Child) != 1 {
+			return false, errors.Errorf("Expected one child for not in having")
+		}
+		ok, err := grp.matches(ft.Child[0])
+		return !ok, err
+	}
+
+	fn := ft.Func
+	if fn == nil || !dql.IsInequalityFn(fn.Name) {
+		return false, errors.Errorf("Only inequality functions are allowed in having")
+	}
+	cur, ok := grp.value(fn.Attr)
+	if !ok {
+		// The aggregate has no value for this group.
+		return false, nil
+	}
+	args := make([]types.Val, 0, len(fn.Args))
+	for _, arg := range fn.Args {
+		if arg.IsValueVar || arg.IsDQLVar {
+			return false, errors.Errorf("Only constants are allowed as arguments in having."+
+				" Got: %s", arg.Value)
+		}
+		src := types.Val{Tid: types.StringID, Value: []byte(arg.Value)}
+		dst, err := types.Convert(src, cur.Tid)
+		if err != nil {
+			return false, errors.Errorf("Invalid argument %v in having. Comparing with"+
+				" different type", arg.Value)
+		}
+		args = append(args, dst)
+	}
+	switch {
+	case fn.Name == "between":
+		if len(args) != 2 {
+			return false, errors.Errorf("Expected two arguments for between in having")
+		}
+		return types.CompareBetween(cur, args[0], args[1]), nil
+	case len(args) == 0:
+		return false, errors.Errorf("Expected an argument for %s in having", fn.Name)
+	case fn.Name == "eq":
+		for _, arg := range args {
+			if types.CompareVals(fn.Name, cur, arg) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case len(args) > 1:
+		return false, errors.Errorf("Expected one argument for %s in having", fn.Name)
+	}
+	return types.CompareVals(fn.Name, cur, args[0]), nil
+}
+
 type groupResults struct {
 	group []*groupResult
 }
@@ -139,8 +232,9 @@ func (d *dedup) addValue(attr string, value types.Val, uid uint64) {
 }
 
 func aggregateGroup(grp *groupResult, child *SubGraph) (types.Val, error) {
-	ag := aggregator{
-		name: child.SrcFunc.Name,
+	ag, err := newAggregator(child.SrcFunc)
+	if err != nil {
+		return types.Val{}, err
 	}
 	for _, uid := range grp.uids {
 		idx := sort.Search(len(child.SrcUIDs.Uids), func(i int) bool {
@@ -165,6 +259,92 @@ func aggregateGroup(grp *groupResult, child *SubGraph) (types.Val, error) {
 	return ag.Value()
 }
 
+// applyArgs filters, orders and paginates the groups as asked by the arguments of the
+// groupby. The groups are expected to be sorted by groupLess, which breaks the ties of
+// the ordering.
+func (res *groupResults) applyArgs(args *dql.GroupByArgs) error {
+	if args.Having != nil {
+		filtered := res.group[:0]
+		for _, grp := range res.group {
+			ok, err := grp.matches(args.Having)
+			if err != nil {
+				return err
+			}
+			if ok {
+				filtered = append(filtered, grp)
+			}
+		}
+		res.group = filtered
+	}
+	if len(args.Order) > 0 {
+		sort.SliceStable(res.group, func(i, j int) bool {
+			return groupOrderLess(res.group[i], res.group[j], args.Order)
+		})
+	}
+	start, end := x.PageRange(args.First, args.Offset, len(res.group))
+	res.group = res.group[start:end]
+	return nil
+}
+
+// groupOrderLess compares the groups by the keys and aggregates named in order. Groups
+// without a value for an attribute are sorted after the others.
+func groupOrderLess(a, b *groupResult, order []*pb.Order) bool {
+	for _, o := range order {
+		av, aok := a.value(o.Attr)
+		bv, bok := b.value(o.Attr)
+		switch {
+		case !aok && !bok:
+			continue
+		case !aok || !bok:
+			return aok
+		}
+		if l, err := types.Less(av, bv); err == nil && l {
+			return !o.Desc
+		}
+		if l, err := types.Less(bv, av); err == nil && l {
+			return o.Desc
+		}
+	}
+	return false
+}
+
+// checkGroupbyArgs returns an error if the arguments of the groupby refer to a key or an
+// aggregate that isn't computed for the groups.
+func (sg *SubGraph) checkGroupbyArgs() error {
+	args := &sg.Params.GroupbyArgs
+	names := make(map[string]struct{})
+	for _, child := range sg.Children {
+		names[groupFieldName(child)] = struct{}{}
+	}
+	check := func(name string) error {
+		if _, ok := names[name]; !ok {
+			return errors.Errorf("Unknown key or aggregate %s in groupby arguments", name)
+		}
+		return nil
+	}
+	for _, o := range args.Order {
+		if err := check(o.Attr); err != nil {
+			return err
+		}
+	}
+	var checkFilter func(ft *dql.FilterTree) error
+	checkFilter = func(ft *dql.FilterTree) error {
+		if ft.Func != nil {
+			return check(ft.Func.Attr)
+		}
+		for _, child := range ft.Child {
+			if err := checkFilter(child); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if args.Having != nil {
+		return checkFilter(args.Having)
+	}
+	return nil
+}
+
 // formGroup creates all possible groups with the list of uids that belong to that
 // group.
 func (res *groupResults) formGroups(dedupMap dedup, cur *pb.List, groupVal []groupPair) {
@@ -267,7 +447,7 @@ func (sg *SubGraph) formResult(ul *pb.List) (*groupResults, error) {
 		return groupLess(res.group[i], res.group[j])
 	})
 
-	return res, nil
+	return res, res.applyArgs(&sg.Params.GroupbyArgs)
 }
 
 // This function is to use the fillVars. It is similar to formResult, the only difference being
@@ -340,10 +520,18 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 				return err
 			}
 		}
-		if child.Params.Var == "" {
+	}
+	// The variables only hold the groups kept by the arguments of the groupby.
+	if err := res.applyArgs(&sg.Params.GroupbyArgs); err != nil {
+		return err
+	}
+
+	for _, child := range sg.Children {
+		if child.Params.IgnoreResult || child.Params.Var == "" {
 			continue
 		}
 		chVar := child.Params.Var
+		fieldName := groupFieldName(child)
 
 		tempMap := make(map[uint64]types.Val)
 		for _, grp := range res.group {
@@ -358,9 +546,11 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 			if !ok {
 				return errors.Errorf("Vars can be assigned only when grouped by UID attribute")
 			}
-			// grp.aggregates could be empty if schema conversion failed during aggregation
-			if len(grp.aggregates) > 0 {
-				tempMap[uid] = grp.aggregates[len(grp.aggregates)-1].key
+			// The aggregate could be missing if schema conversion failed during aggregation
+			for _, agg := range grp.aggregates {
+				if agg.attr == fieldName {
+					tempMap[uid] = agg.key
+				}
 			}
 		}
 		doneVars[chVar] = varValue{
@@ -372,6 +562,9 @@ func (sg *SubGraph) fillGroupedVars(doneVars map[string]varValue, path []*SubGra
 }
 
 func (sg *SubGraph) processGroupBy(doneVars map[string]varValue, path []*SubGraph) error {
+	if err := sg.checkGroupbyArgs(); err != nil {
+		return err
+	}
 	for _, ul := range sg.uidMatrix {
 		// We need to process groupby for each list as grouping needs to happen for each path of the
 		// tree.
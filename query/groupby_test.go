@@ -0,0 +1,91 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/types"
+)
+
+func testGroups() *groupResults {
+	group := func(author string, count int64, words ...float64) *groupResult {
+		grp := &groupResult{
+			keys: []groupPair{{attr: "author", key: types.Val{Tid: types.StringID, Value: author}}},
+			aggregates: []groupPair{{attr: "count",
+				key: types.Val{Tid: types.IntID, Value: count}}},
+		}
+		for _, w := range words {
+			grp.aggregates = append(grp.aggregates, groupPair{attr: "words",
+				key: types.Val{Tid: types.FloatID, Value: w}})
+		}
+		return grp
+	}
+	return &groupResults{group: []*groupResult{
+		group("alice", 5, 100),
+		group("bob", 2, 300),
+		group("carol", 7),
+		group("dave", 5, 200),
+	}}
+}
+
+func groupAuthors(res *groupResults) []string {
+	var authors []string
+	for _, grp := range res.group {
+		authors = append(authors, grp.keys[0].key.Value.(string))
+	}
+	return authors
+}
+
+func TestGroupbyArgs(t *testing.T) {
+	parse := func(args string) *dql.GroupByArgs {
+		res, err := dql.Parse(dql.Request{Str: `{ q(func: uid(1)) { post @groupby(author, ` +
+			args + `) { count(uid) words: avg(words) } } }`})
+		require.NoError(t, err)
+		return &res.Query[0].Children[0].GroupbyArgs
+	}
+
+	for _, tc := range []struct {
+		args    string
+		authors []string
+	}{
+		{"orderdesc: count", []string{"carol", "alice", "dave", "bob"}},
+		{"orderdesc: count, orderdesc: author", []string{"carol", "dave", "alice", "bob"}},
+		{"orderdesc: count, first: 2", []string{"carol", "alice"}},
+		{"orderasc: count, first: -2", []string{"dave", "carol"}},
+		{"orderasc: count, offset: 1, first: 2", []string{"alice", "dave"}},
+		// Groups without the aggregate are sorted last.
+		{"orderasc: words", []string{"alice", "dave", "bob", "carol"}},
+		{"having: ge(count, 5)", []string{"alice", "carol", "dave"}},
+		{"having: eq(count, 2, 7)", []string{"bob", "carol"}},
+		{"having: between(words, 150, 300)", []string{"bob", "dave"}},
+		{"having: (ge(count, 5) and not eq(author, \"carol\"))", []string{"alice", "dave"}},
+		{"having: (lt(count, 3) or gt(words, 150)), orderdesc: words",
+			[]string{"bob", "dave"}},
+	} {
+		res := testGroups()
+		require.NoError(t, res.applyArgs(parse(tc.args)), tc.args)
+		require.Equal(t, tc.authors, groupAuthors(res), tc.args)
+	}
+
+	res := testGroups()
+	require.Error(t, res.applyArgs(parse("having: ge(count, ten)")))
+	require.Error(t, res.applyArgs(parse("having: has(count)")))
+}
@@ -0,0 +1,335 @@
+/*
+ * Copyright 2017-2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v24/algo"
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/worker"
+)
+
+const (
+	// rrfFusion ranks the nodes by the sum of their reciprocal ranks.
+	rrfFusion = "rrf"
+	// weightedFusion ranks the nodes by a weighted sum of their normalized
+	// vector and text scores.
+	weightedFusion = "weighted"
+
+	// rrfRankConstant damps the weight of the top ranks in reciprocal rank
+	// fusion, 60 is the value proposed by the original paper.
+	rrfRankConstant = 60
+)
+
+// hybridArgs holds the arguments of the hybrid_search function, which are
+//
+//	hybrid_search(vectorPred, k, vector, textPred, "terms"[, options...])
+//
+// Each option is either the fusion method ("rrf" or "weighted"), the text
+// function used to match the terms ("anyoftext" or "alloftext"), or the weight
+// given to the vector ranking by weighted fusion, between 0 and 1.
+type hybridArgs struct {
+	k        int
+	vector   string
+	textAttr string
+	terms    string
+	textFn   string
+	fusion   string
+	weight   float64
+}
+
+func parseHybridArgs(fn *Function) (*hybridArgs, error) {
+	if len(fn.Args) < 4 {
+		return nil, errors.Errorf("hybrid_search requires a vector predicate, the number of "+
+			"results, a vector, a text predicate and terms, but got %d arguments", len(fn.Args)+1)
+	}
+	k, err := strconv.Atoi(fn.Args[0].Value)
+	if err != nil || k <= 0 {
+		return nil, errors.Errorf("invalid value for number of results in hybrid_search: %s",
+			fn.Args[0].Value)
+	}
+	args := &hybridArgs{
+		k:        k,
+		vector:   fn.Args[1].Value,
+		textAttr: fn.Args[2].Value,
+		terms:    fn.Args[3].Value,
+		textFn:   "anyoftext",
+		fusion:   rrfFusion,
+		weight:   0.5,
+	}
+	var hasWeight bool
+	for _, arg := range fn.Args[4:] {
+		switch opt := strings.ToLower(strings.TrimSpace(arg.Value)); opt {
+		case rrfFusion, weightedFusion:
+			args.fusion = opt
+		case "anyoftext", "alloftext":
+			args.textFn = opt
+		default:
+			w, err := strconv.ParseFloat(opt, 64)
+			if err != nil || w < 0 || w > 1 {
+				return nil, errors.Errorf("invalid option for hybrid_search: %s", arg.Value)
+			}
+			args.weight = w
+			hasWeight = true
+		}
+	}
+	if hasWeight && args.fusion != weightedFusion {
+		return nil, errors.Errorf("hybrid_search only accepts a weight with %q fusion",
+			weightedFusion)
+	}
+	return args, nil
+}
+
+// hybridSearch evaluates a hybrid_search function at root. It ranks the k
+// nearest neighbors of the vector along with the nodes whose text matches the
+// terms, scored by BM25, fuses both rankings and keeps the k best nodes. The
+// filters of sg are applied to both rankings before they are fused, so that
+// the k nodes returned pass them. Like similar_to, the result is sorted by
+// uid; the fused scores are exposed through the _score_ pseudo-predicate to
+// order it, and the distances of the nodes that were nearest neighbors through
+// _distance_.
+func (sg *SubGraph) hybridSearch(ctx context.Context) error {
+	args, err := parseHybridArgs(sg.SrcFunc)
+	if err != nil {
+		return err
+	}
+
+	vecSg := &SubGraph{
+		Attr:   sg.Attr,
+		ReadTs: sg.ReadTs,
+		Cache:  sg.Cache,
+		SrcFunc: &Function{
+			Name: "similar_to",
+			Args: []dql.Arg{{Value: strconv.Itoa(args.k)}, {Value: args.vector}},
+		},
+	}
+	vecQuery, err := createTaskQuery(ctx, vecSg)
+	if err != nil {
+		return err
+	}
+	var vecResult *pb.Result
+	if len(sg.Filters) > 0 {
+		vecResult, err = sg.filteredVectorSearch(ctx, vecQuery)
+		vecResult, err = hybridTaskResult(vecResult, err)
+	} else {
+		vecResult, err = processHybridTask(ctx, vecQuery)
+	}
+	if err != nil {
+		return err
+	}
+	distances := make(map[uint64]float64, len(vecResult.VectorDistances))
+	if len(vecResult.UidMatrix) > 0 {
+		for i, uid := range vecResult.UidMatrix[0].GetUids() {
+			distances[uid] = vecResult.VectorDistances[i]
+		}
+	}
+
+	textScores, err := sg.textScores(ctx, args)
+	if err != nil {
+		return err
+	}
+	if err := sg.filterHybridCandidates(ctx, distances, textScores); err != nil {
+		return err
+	}
+
+	var scores map[uint64]float64
+	switch args.fusion {
+	case weightedFusion:
+		scores = fuseWeighted(distances, textScores, args.weight)
+	default:
+		scores = fuseRRF(rankByScore(distances, true), rankByScore(textScores, false))
+	}
+
+	uids := rankByScore(scores, false)
+	if len(uids) > args.k {
+		uids = uids[:args.k]
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	sg.hybridScores = make(map[uint64]float64, len(uids))
+	sg.vectorDistances = make(map[uint64]float64, len(uids))
+	for _, uid := range uids {
+		sg.hybridScores[uid] = scores[uid]
+		if d, ok := distances[uid]; ok {
+			sg.vectorDistances[uid] = d
+		}
+	}
+	sg.vectorMetrics = vecResult.VectorMetrics
+	sg.DestUIDs = &pb.List{Uids: uids}
+	sg.uidMatrix = []*pb.List{{Uids: append([]uint64{}, uids...)}}
+	return nil
+}
+
+// filterHybridCandidates applies the filters of sg to the nodes of both
+// rankings of a hybrid_search function and removes the ones that don't pass
+// them, before the rankings are fused and cut to k nodes.
+func (sg *SubGraph) filterHybridCandidates(ctx context.Context,
+	distances, textScores map[uint64]float64) error {
+	if len(sg.Filters) == 0 {
+		return nil
+	}
+	uids := make([]uint64, 0, len(distances)+len(textScores))
+	for uid := range distances {
+		uids = append(uids, uid)
+	}
+	for uid := range textScores {
+		if _, ok := distances[uid]; !ok {
+			uids = append(uids, uid)
+		}
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+
+	destUIDs := sg.DestUIDs
+	sg.DestUIDs = &pb.List{Uids: uids}
+	err := sg.applyFilters(ctx)
+	passed := sg.DestUIDs
+	sg.DestUIDs = destUIDs
+	if err != nil {
+		return err
+	}
+	for _, uid := range uids {
+		if algo.IndexOf(passed, uid) < 0 {
+			delete(distances, uid)
+			delete(textScores, uid)
+		}
+	}
+	return nil
+}
+
+// textScores returns the BM25 score of every node whose value of the text
+// predicate matches the terms of the hybrid_search function. The scores are
+// computed by the group serving the predicate, see worker.textScores.
+func (sg *SubGraph) textScores(ctx context.Context, args *hybridArgs) (map[uint64]float64, error) {
+	textSg := &SubGraph{
+		Attr:    args.textAttr,
+		ReadTs:  sg.ReadTs,
+		Cache:   sg.Cache,
+		SrcFunc: &Function{Name: args.textFn, Args: []dql.Arg{{Value: args.terms}}},
+	}
+	textQuery, err := createTaskQuery(ctx, textSg)
+	if err != nil {
+		return nil, err
+	}
+	textQuery.TextScores = true
+	result, err := processHybridTask(ctx, textQuery)
+	if err != nil {
+		return nil, err
+	}
+	var matches *pb.List
+	if result.IntersectDest {
+		matches = algo.IntersectSorted(result.UidMatrix)
+	} else {
+		matches = algo.MergeSorted(result.UidMatrix)
+	}
+	scores := make(map[uint64]float64, len(matches.GetUids()))
+	for i, uid := range matches.GetUids() {
+		if i < len(result.TextScores) {
+			scores[uid] = result.TextScores[i]
+		}
+	}
+	return scores, nil
+}
+
+// processHybridTask runs one of the tasks of a hybrid_search function. A
+// predicate that isn't served by any group matches nothing.
+func processHybridTask(ctx context.Context, q *pb.Query) (*pb.Result, error) {
+	return hybridTaskResult(worker.ProcessTaskOverNetwork(ctx, q))
+}
+
+func hybridTaskResult(result *pb.Result, err error) (*pb.Result, error) {
+	if err != nil && strings.Contains(err.Error(), worker.ErrNonExistentTabletMessage) {
+		return &pb.Result{}, nil
+	}
+	return result, err
+}
+
+// rankByScore returns the uids of scores from the best to the worst score,
+// which is the lowest one if ascending is set. Ties are broken by uid.
+func rankByScore(scores map[uint64]float64, ascending bool) []uint64 {
+	uids := make([]uint64, 0, len(scores))
+	for uid := range scores {
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool {
+		si, sj := scores[uids[i]], scores[uids[j]]
+		switch {
+		case si == sj:
+			return uids[i] < uids[j]
+		case ascending:
+			return si < sj
+		default:
+			return si > sj
+		}
+	})
+	return uids
+}
+
+// fuseRRF combines the given rankings with reciprocal rank fusion, a node
+// scores the sum of 1/(rrfRankConstant+rank) over the rankings it is part of.
+func fuseRRF(rankings ...[]uint64) map[uint64]float64 {
+	scores := make(map[uint64]float64)
+	for _, ranking := range rankings {
+		for i, uid := range ranking {
+			scores[uid] += 1 / float64(rrfRankConstant+i+1)
+		}
+	}
+	return scores
+}
+
+// fuseWeighted combines the vector distances and the text scores after
+// scaling both of them to [0, 1], where 1 is the best value. A node scores
+// weight times its vector score plus (1-weight) times its text score, and a
+// node missing from one of the rankings scores 0 in it.
+func fuseWeighted(distances, textScores map[uint64]float64, weight float64) map[uint64]float64 {
+	scores := make(map[uint64]float64, len(distances)+len(textScores))
+	for uid, d := range normalizeScores(distances, true) {
+		scores[uid] += weight * d
+	}
+	for uid, s := range normalizeScores(textScores, false) {
+		scores[uid] += (1 - weight) * s
+	}
+	return scores
+}
+
+// normalizeScores scales scores with min-max normalization so that the best
+// score becomes 1 and the worst 0. If they are all equal, they all become 1.
+func normalizeScores(scores map[uint64]float64, ascending bool) map[uint64]float64 {
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, s := range scores {
+		lo = math.Min(lo, s)
+		hi = math.Max(hi, s)
+	}
+	out := make(map[uint64]float64, len(scores))
+	for uid, s := range scores {
+		switch {
+		case hi == lo:
+			out[uid] = 1
+		case ascending:
+			out[uid] = (hi - s) / (hi - lo)
+		default:
+			out[uid] = (s - lo) / (hi - lo)
+		}
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright 2017-2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/dql"
+)
+
+func hybridFunc(args ...string) *Function {
+	fn := &Function{Name: "hybrid_search"}
+	for _, arg := range args {
+		fn.Args = append(fn.Args, dql.Arg{Value: arg})
+	}
+	return fn
+}
+
+func TestParseHybridArgs(t *testing.T) {
+	args, err := parseHybridArgs(hybridFunc("5", "[1,2]", "description", "red shoes"))
+	require.NoError(t, err)
+	require.Equal(t, &hybridArgs{k: 5, vector: "[1,2]", textAttr: "description",
+		terms: "red shoes", textFn: "anyoftext", fusion: rrfFusion, weight: 0.5}, args)
+
+	args, err = parseHybridArgs(hybridFunc("5", "[1,2]", "description", "red shoes",
+		"alloftext", "weighted", "0.8"))
+	require.NoError(t, err)
+	require.Equal(t, "alloftext", args.textFn)
+	require.Equal(t, weightedFusion, args.fusion)
+	require.Equal(t, 0.8, args.weight)
+
+	for _, bad := range [][]string{
+		{"5", "[1,2]", "description"},
+		{"0", "[1,2]", "description", "red shoes"},
+		{"5", "[1,2]", "description", "red shoes", "bm42"},
+		{"5", "[1,2]", "description", "red shoes", "weighted", "1.5"},
+		{"5", "[1,2]", "description", "red shoes", "0.3"},
+	} {
+		_, err := parseHybridArgs(hybridFunc(bad...))
+		require.Error(t, err, "%v", bad)
+	}
+}
+
+func TestFuseRRF(t *testing.T) {
+	scores := fuseRRF([]uint64{1, 2, 3}, []uint64{3, 4})
+	require.InDelta(t, 1.0/61, scores[1], 1e-12)
+	require.InDelta(t, 1.0/63+1.0/61, scores[3], 1e-12)
+	require.Equal(t, []uint64{3, 1, 2, 4}, rankByScore(scores, false))
+}
+
+func TestFuseWeighted(t *testing.T) {
+	distances := map[uint64]float64{1: 0, 2: 1, 3: 2}
+	text := map[uint64]float64{3: 10, 4: 5}
+
+	scores := fuseWeighted(distances, text, 0.5)
+	require.Equal(t, map[uint64]float64{1: 0.5, 2: 0.25, 3: 0.5, 4: 0}, scores)
+	require.Equal(t, []uint64{1, 3, 2, 4}, rankByScore(scores, false))
+
+	// Only the vector ranking counts with a weight of 1.
+	require.Equal(t, []uint64{1, 2, 3, 4}, rankByScore(fuseWeighted(distances, text, 1), false))
+	// Only the text ranking counts with a weight of 0.
+	require.Equal(t, []uint64{3, 1, 2, 4}, rankByScore(fuseWeighted(distances, text, 0), false))
+}
@@ -17,6 +17,10 @@
 package query
 
 import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 
@@ -264,6 +268,10 @@ func evalMathTree(mNode *mathTree) error {
 	}
 
 	aggName := mNode.Fn
+	if isValueFunc(aggName) {
+		return processValueFunc(mNode)
+	}
+
 	if isUnary(aggName) {
 		if len(mNode.Child) != 1 {
 			return errors.Errorf("Function %v expects 1 argument. But got: %v", aggName,
@@ -298,3 +306,288 @@ func evalMathTree(mNode *mathTree) error {
 
 	return errors.Errorf("Unhandled Math operator: %v", aggName)
 }
+
+// valueFunc evaluates a function over strings and datetimes.
+type valueFunc func(args []types.Val) (types.Val, error)
+
+var valueFunctions = map[string]valueFunc{
+	"concat":       applyConcat,
+	"lower":        applyLower,
+	"upper":        applyUpper,
+	"len":          applyLen,
+	"substr":       applySubstr,
+	"date_trunc":   applyDateTrunc,
+	"date_diff":    applyDateDiff,
+	"add_duration": applyAddDuration,
+	"year":         datePart("year", func(t time.Time) int { return t.Year() }),
+	"month":        datePart("month", func(t time.Time) int { return int(t.Month()) }),
+	"day":          datePart("day", func(t time.Time) int { return t.Day() }),
+	"hour":         datePart("hour", func(t time.Time) int { return t.Hour() }),
+	"minute":       datePart("minute", func(t time.Time) int { return t.Minute() }),
+	"second":       datePart("second", func(t time.Time) int { return t.Second() }),
+}
+
+func isValueFunc(f string) bool {
+	_, ok := valueFunctions[f]
+	return ok
+}
+
+// processValueFunc handles the functions over strings and datetimes, like concat or
+// date_trunc. The result is missing for the uids missing the value of an argument.
+func processValueFunc(mNode *mathTree) error {
+	fn := valueFunctions[mNode.Fn]
+	args := make([]types.Val, len(mNode.Child))
+	// vars holds the index of the arguments with a value per uid.
+	var vars []int
+	for i, ch := range mNode.Child {
+		switch {
+		case ch.Const.Value != nil:
+			args[i] = ch.Const
+		case len(ch.Val) == 1 && ch.Val[0].Value != nil:
+			// The output of an aggregation, which applies to all the uids.
+			args[i] = ch.Val[0]
+		default:
+			vars = append(vars, i)
+		}
+	}
+
+	if len(vars) == 0 {
+		var err error
+		mNode.Const, err = fn(args)
+		return err
+	}
+
+	destMap := make(map[uint64]types.Val)
+	for k := range mNode.Child[vars[0]].Val {
+		missing := false
+		for _, i := range vars {
+			val, ok := mNode.Child[i].Val[k]
+			if !ok || val.Value == nil {
+				missing = true
+				break
+			}
+			args[i] = val
+		}
+		if missing {
+			continue
+		}
+		res, err := fn(args)
+		if err != nil {
+			return err
+		}
+		destMap[k] = res
+	}
+	mNode.Val = destMap
+	return nil
+}
+
+func argString(v types.Val, fn string) (string, error) {
+	res := types.Val{Tid: types.StringID}
+	if err := types.Marshal(v, &res); err != nil {
+		return "", errors.Errorf("Wrong type %v encountered for func %s", v.Tid, fn)
+	}
+	return res.Value.(string), nil
+}
+
+func argInt(v types.Val, fn string) (int64, error) {
+	if v.Tid != types.IntID {
+		return 0, errors.Errorf("Wrong type %v encountered for func %s, expected an int", v.Tid, fn)
+	}
+	return v.Value.(int64), nil
+}
+
+// argTime returns the datetime of v, which may also be a string holding one.
+func argTime(v types.Val, fn string) (time.Time, error) {
+	switch v.Tid {
+	case types.DateTimeID:
+		return v.Value.(time.Time), nil
+	case types.StringID, types.DefaultID:
+		res, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(v.Value.(string))},
+			types.DateTimeID)
+		if err != nil {
+			return time.Time{}, errors.Wrapf(err, "while parsing the datetime of func %s", fn)
+		}
+		return res.Value.(time.Time), nil
+	}
+	return time.Time{}, errors.Errorf("Wrong type %v encountered for func %s", v.Tid, fn)
+}
+
+func applyConcat(args []types.Val) (types.Val, error) {
+	var sb strings.Builder
+	for _, arg := range args {
+		s, err := argString(arg, "concat")
+		if err != nil {
+			return types.Val{}, err
+		}
+		sb.WriteString(s)
+	}
+	return types.Val{Tid: types.StringID, Value: sb.String()}, nil
+}
+
+func applyLower(args []types.Val) (types.Val, error) {
+	s, err := argString(args[0], "lower")
+	return types.Val{Tid: types.StringID, Value: strings.ToLower(s)}, err
+}
+
+func applyUpper(args []types.Val) (types.Val, error) {
+	s, err := argString(args[0], "upper")
+	return types.Val{Tid: types.StringID, Value: strings.ToUpper(s)}, err
+}
+
+// applyLen returns the number of characters of a string.
+func applyLen(args []types.Val) (types.Val, error) {
+	s, err := argString(args[0], "len")
+	return types.Val{Tid: types.IntID, Value: int64(utf8.RuneCountInString(s))}, err
+}
+
+// applySubstr returns the characters of a string from a start, counted from 0, up to an
+// optional length.
+func applySubstr(args []types.Val) (types.Val, error) {
+	s, err := argString(args[0], "substr")
+	if err != nil {
+		return types.Val{}, err
+	}
+	start, err := argInt(args[1], "substr")
+	if err != nil {
+		return types.Val{}, err
+	}
+	runes := []rune(s)
+	end := int64(len(runes))
+	if len(args) > 2 {
+		length, err := argInt(args[2], "substr")
+		if err != nil {
+			return types.Val{}, err
+		}
+		if length < 0 {
+			return types.Val{}, errors.Errorf("Length of substr can't be negative: %d", length)
+		}
+		end = min(end, start+length)
+	}
+	if start < 0 {
+		return types.Val{}, errors.Errorf("Start of substr can't be negative: %d", start)
+	}
+	if start >= end {
+		return types.Val{Tid: types.StringID, Value: ""}, nil
+	}
+	return types.Val{Tid: types.StringID, Value: string(runes[start:end])}, nil
+}
+
+// applyDateTrunc truncates a datetime to the start of its year, month, week, day, hour,
+// minute or second, in its time zone. Weeks start on Monday.
+func applyDateTrunc(args []types.Val) (types.Val, error) {
+	unit, err := argString(args[0], "date_trunc")
+	if err != nil {
+		return types.Val{}, err
+	}
+	t, err := argTime(args[1], "date_trunc")
+	if err != nil {
+		return types.Val{}, err
+	}
+	y, m, d := t.Date()
+	loc := t.Location()
+	switch strings.ToLower(unit) {
+	case "year":
+		t = time.Date(y, time.January, 1, 0, 0, 0, 0, loc)
+	case "month":
+		t = time.Date(y, m, 1, 0, 0, 0, 0, loc)
+	case "week":
+		weekday := (int(t.Weekday()) + 6) % 7
+		t = time.Date(y, m, d-weekday, 0, 0, 0, 0, loc)
+	case "day":
+		t = time.Date(y, m, d, 0, 0, 0, 0, loc)
+	case "hour":
+		t = time.Date(y, m, d, t.Hour(), 0, 0, 0, loc)
+	case "minute":
+		t = time.Date(y, m, d, t.Hour(), t.Minute(), 0, 0, loc)
+	case "second":
+		t = time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), 0, loc)
+	default:
+		return types.Val{}, errors.Errorf("Unknown unit %q for func date_trunc", unit)
+	}
+	return types.Val{Tid: types.DateTimeID, Value: t}, nil
+}
+
+// monthsBetween returns the number of whole months from a to b.
+func monthsBetween(a, b time.Time) int64 {
+	months := int64(b.Year()-a.Year())*12 + int64(b.Month()-a.Month())
+	switch {
+	case months > 0 && b.Before(a.AddDate(0, int(months), 0)):
+		months--
+	case months < 0 && b.After(a.AddDate(0, int(months), 0)):
+		months++
+	}
+	return months
+}
+
+// applyDateDiff returns the number of whole units, from seconds to years, from a datetime
+// to another one. It's negative if the second one is before the first one.
+func applyDateDiff(args []types.Val) (types.Val, error) {
+	unit, err := argString(args[0], "date_diff")
+	if err != nil {
+		return types.Val{}, err
+	}
+	a, err := argTime(args[1], "date_diff")
+	if err != nil {
+		return types.Val{}, err
+	}
+	b, err := argTime(args[2], "date_diff")
+	if err != nil {
+		return types.Val{}, err
+	}
+	var diff int64
+	switch strings.ToLower(unit) {
+	case "year":
+		diff = monthsBetween(a, b) / 12
+	case "month":
+		diff = monthsBetween(a, b)
+	case "week":
+		diff = int64(b.Sub(a) / (7 * 24 * time.Hour))
+	case "day":
+		diff = int64(b.Sub(a) / (24 * time.Hour))
+	case "hour":
+		diff = int64(b.Sub(a) / time.Hour)
+	case "minute":
+		diff = int64(b.Sub(a) / time.Minute)
+	case "second":
+		diff = int64(b.Sub(a) / time.Second)
+	default:
+		return types.Val{}, errors.Errorf("Unknown unit %q for func date_diff", unit)
+	}
+	return types.Val{Tid: types.IntID, Value: diff}, nil
+}
+
+// applyAddDuration adds a duration to a datetime. The duration is either a string like
+// "1h30m" or a number of seconds.
+func applyAddDuration(args []types.Val) (types.Val, error) {
+	t, err := argTime(args[0], "add_duration")
+	if err != nil {
+		return types.Val{}, err
+	}
+	var d time.Duration
+	switch args[1].Tid {
+	case types.IntID:
+		d = time.Duration(args[1].Value.(int64)) * time.Second
+	case types.FloatID:
+		d = time.Duration(args[1].Value.(float64) * float64(time.Second))
+	default:
+		s, err := argString(args[1], "add_duration")
+		if err != nil {
+			return types.Val{}, err
+		}
+		if d, err = time.ParseDuration(s); err != nil {
+			return types.Val{}, errors.Wrapf(err, "while parsing the duration of add_duration")
+		}
+	}
+	return types.Val{Tid: types.DateTimeID, Value: t.Add(d)}, nil
+}
+
+// datePart returns the function fn extracting a part of a datetime, like its year.
+func datePart(fn string, part func(time.Time) int) valueFunc {
+	return func(args []types.Val) (types.Val, error) {
+		t, err := argTime(args[0], fn)
+		if err != nil {
+			return types.Val{}, err
+		}
+		return types.Val{Tid: types.IntID, Value: int64(part(t))}, nil
+	}
+}
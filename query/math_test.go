@@ -20,6 +20,7 @@ import (
 	"math"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
@@ -1006,3 +1007,91 @@ func TestProcessTernary(t *testing.T) {
 		require.EqualValues(t, tc.out, tc.in.Val[0])
 	}
 }
+
+func TestProcessValueFunc(t *testing.T) {
+	str := func(s string) *mathTree { return &mathTree{Const: types.Val{Tid: types.StringID, Value: s}} }
+	num := func(i int64) *mathTree { return &mathTree{Const: types.Val{Tid: types.IntID, Value: i}} }
+	date := func(s string) *mathTree {
+		d, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return &mathTree{Const: types.Val{Tid: types.DateTimeID, Value: d}}
+	}
+	dateVal := func(s string) types.Val {
+		return date(s).Const
+	}
+
+	tests := []struct {
+		fn   string
+		args []*mathTree
+		out  types.Val
+	}{
+		{"concat", []*mathTree{str("a"), num(1), str("b")}, types.Val{Tid: types.StringID, Value: "a1b"}},
+		{"lower", []*mathTree{str("AbC")}, types.Val{Tid: types.StringID, Value: "abc"}},
+		{"upper", []*mathTree{str("AbC")}, types.Val{Tid: types.StringID, Value: "ABC"}},
+		{"len", []*mathTree{str("héllo")}, types.Val{Tid: types.IntID, Value: int64(5)}},
+		{"substr", []*mathTree{str("héllo"), num(1)}, types.Val{Tid: types.StringID, Value: "éllo"}},
+		{"substr", []*mathTree{str("héllo"), num(1), num(2)}, types.Val{Tid: types.StringID, Value: "él"}},
+		{"substr", []*mathTree{str("héllo"), num(4), num(10)}, types.Val{Tid: types.StringID, Value: "o"}},
+		{"substr", []*mathTree{str("héllo"), num(7)}, types.Val{Tid: types.StringID, Value: ""}},
+		{"date_trunc", []*mathTree{str("month"), date("2024-03-15T10:20:30Z")},
+			dateVal("2024-03-01T00:00:00Z")},
+		{"date_trunc", []*mathTree{str("week"), date("2024-03-17T10:20:30Z")},
+			dateVal("2024-03-11T00:00:00Z")},
+		{"date_trunc", []*mathTree{str("hour"), str("2024-03-15T10:20:30Z")},
+			dateVal("2024-03-15T10:00:00Z")},
+		{"date_diff", []*mathTree{str("day"), date("2024-03-15T10:00:00Z"), date("2024-03-18T09:00:00Z")},
+			types.Val{Tid: types.IntID, Value: int64(2)}},
+		{"date_diff", []*mathTree{str("month"), date("2024-01-31T00:00:00Z"), date("2024-03-30T00:00:00Z")},
+			types.Val{Tid: types.IntID, Value: int64(1)}},
+		{"date_diff", []*mathTree{str("year"), date("2024-03-15T00:00:00Z"), date("2020-03-16T00:00:00Z")},
+			types.Val{Tid: types.IntID, Value: int64(-3)}},
+		{"add_duration", []*mathTree{date("2024-03-15T10:00:00Z"), str("1h30m")},
+			dateVal("2024-03-15T11:30:00Z")},
+		{"add_duration", []*mathTree{date("2024-03-15T10:00:00Z"), num(-60)},
+			dateVal("2024-03-15T09:59:00Z")},
+		{"year", []*mathTree{date("2024-03-15T10:20:30Z")}, types.Val{Tid: types.IntID, Value: int64(2024)}},
+		{"second", []*mathTree{date("2024-03-15T10:20:30Z")}, types.Val{Tid: types.IntID, Value: int64(30)}},
+	}
+	for _, tc := range tests {
+		in := &mathTree{Fn: tc.fn, Child: tc.args}
+		require.NoError(t, evalMathTree(in), "%s", tc.fn)
+		require.Equal(t, tc.out, in.Const, "%s", tc.fn)
+	}
+
+	for _, tc := range []struct {
+		fn   string
+		args []*mathTree
+	}{
+		{"substr", []*mathTree{str("abc"), num(-1)}},
+		{"substr", []*mathTree{str("abc"), str("1")}},
+		{"date_trunc", []*mathTree{str("decade"), date("2024-03-15T10:20:30Z")}},
+		{"year", []*mathTree{str("yesterday")}},
+		{"add_duration", []*mathTree{date("2024-03-15T10:00:00Z"), str("soon")}},
+	} {
+		require.Error(t, evalMathTree(&mathTree{Fn: tc.fn, Child: tc.args}), "%s", tc.fn)
+	}
+}
+
+func TestProcessValueFuncVars(t *testing.T) {
+	in := &mathTree{
+		Fn: "concat",
+		Child: []*mathTree{
+			{Var: "a", Val: map[uint64]types.Val{
+				1: {Tid: types.StringID, Value: "x"},
+				2: {Tid: types.DefaultID, Value: "y"},
+				3: {Tid: types.StringID, Value: "z"},
+			}},
+			{Const: types.Val{Tid: types.StringID, Value: "-"}},
+			{Var: "b", Val: map[uint64]types.Val{
+				1: {Tid: types.IntID, Value: int64(1)},
+				2: {Tid: types.IntID, Value: int64(2)},
+			}},
+		},
+	}
+	require.NoError(t, evalMathTree(in))
+	// The uid 3 is missing a value of b.
+	require.Equal(t, map[uint64]types.Val{
+		1: {Tid: types.StringID, Value: "x-1"},
+		2: {Tid: types.StringID, Value: "y-2"},
+	}, in.Val)
+}
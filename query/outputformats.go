@@ -0,0 +1,506 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v24/algo"
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/types"
+)
+
+// The response formats beyond the JSON and RDF ones named by api.Request_RespFormat. As the
+// api.Request can't hold them, clients set them apart from the request, with the
+// "resp-format" gRPC metadata or the respFormat HTTP parameter, along with the JSON
+// api.Request_RespFormat. The results are returned in Response.Json.
+const (
+	// RespFormatNDJSON returns a JSON object per line for every root-level result.
+	RespFormatNDJSON = "ndjson"
+	// RespFormatCSV returns the scalar fields of the root-level results of a single query
+	// block as CSV, with a header row.
+	RespFormatCSV = "csv"
+	// RespFormatGraphSON returns the nodes and the edges of the results as a GraphSON 3.0
+	// list of vertices and edges.
+	RespFormatGraphSON = "graphson"
+)
+
+// hasOutput tells whether the results of the query block sg are returned, unlike those of
+// the var, shortest and graph algorithm blocks.
+func hasOutput(sg *SubGraph) bool {
+	return sg.Params.Alias != "var" && sg.Params.Alias != "shortest" &&
+		!dql.IsGraphAlgorithm(sg.Params.Alias)
+}
+
+// encodeBlocks encodes the root-level results of the query blocks of sgl, like ToJson, and
+// calls fn with the node holding them. Every child of the node is a root-level result, named
+// after its block.
+func encodeBlocks(l *Latency, sgl []*SubGraph, fn func(enc *encoder, root fastJsonNode) error) error {
+	encodingStart := time.Now()
+	defer func() {
+		l.Json = time.Since(encodingStart)
+	}()
+
+	enc := newEncoder()
+	defer func() {
+		// Put encoder's arena back to arena pool.
+		arenaPool.Put(enc.arena)
+		enc.alloc.Release()
+	}()
+
+	root := enc.newNode(enc.idForAttr("_root_"))
+	for _, sg := range sgl {
+		if !hasOutput(sg) {
+			continue
+		}
+		if err := processNodeUids(root, enc, sg); err != nil {
+			return err
+		}
+	}
+	enc.fixOrder(root)
+	return fn(enc, root)
+}
+
+// ToNDJSON converts the given subgraph list into NDJSON: every root-level result is written
+// as a JSON object on its own line, in the order of the query blocks.
+func ToNDJSON(l *Latency, sgl []*SubGraph) ([]byte, error) {
+	for _, sg := range sgl {
+		if err := validateSubGraphForNDJSON(sg); err != nil {
+			return nil, err
+		}
+	}
+
+	var out bytes.Buffer
+	err := encodeBlocks(l, sgl, func(enc *encoder, root fastJsonNode) error {
+		for node := enc.children(root); node != nil; node = node.next {
+			if enc.children(node) == nil {
+				// The empty result of a block without any root-level result.
+				continue
+			}
+			enc.buf.Reset()
+			if err := enc.encode(node); err != nil {
+				return err
+			}
+			if uint64(out.Len()+enc.buf.Len()) > maxEncodedSize {
+				return errors.Errorf("while writing to buffer. Encoded response size: %d"+
+					" is bigger than threshold: %d", out.Len()+enc.buf.Len(), maxEncodedSize)
+			}
+			out.Write(enc.buf.Bytes())
+			out.WriteByte('\n')
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while running ToNDJSON")
+	}
+	return out.Bytes(), nil
+}
+
+func validateSubGraphForNDJSON(sg *SubGraph) error {
+	if hasOutput(sg) && sg.IsGroupBy() {
+		return errors.New("groupby at the root is not supported in the ndjson output format")
+	}
+	return nil
+}
+
+// ToCSV converts the given subgraph list into CSV. Every root-level result of the query
+// block is a row, with a column for every field found in the results, in the order they are
+// first found. The first row holds the names of the fields. A field missing from a result is
+// left empty.
+func ToCSV(l *Latency, sgl []*SubGraph) ([]byte, error) {
+	var blocks int
+	for _, sg := range sgl {
+		if !hasOutput(sg) {
+			continue
+		}
+		blocks++
+		if err := validateSubGraphForCSV(sg); err != nil {
+			return nil, err
+		}
+	}
+	if blocks > 1 {
+		return nil, errors.Errorf("csv output format supports a single query block, got %d",
+			blocks)
+	}
+
+	var columns []string
+	var rows []map[string]string
+	err := encodeBlocks(l, sgl, func(enc *encoder, root fastJsonNode) error {
+		index := make(map[string]struct{})
+		for node := enc.children(root); node != nil; node = node.next {
+			row := make(map[string]string)
+			for field := enc.children(node); field != nil; field = field.next {
+				name := enc.attrForID(enc.getAttr(field))
+				_, repeated := row[name]
+				if repeated || enc.getList(field) {
+					return errors.Errorf("list values are not supported in the csv output"+
+						" format, found one for %s", name)
+				}
+				if enc.children(field) != nil {
+					return errors.Errorf("nested objects are not supported in the csv output"+
+						" format, found one for %s", name)
+				}
+				val, err := csvValue(enc, field)
+				if err != nil {
+					return err
+				}
+				row[name] = val
+				if _, ok := index[name]; !ok {
+					index[name] = struct{}{}
+					columns = append(columns, name)
+				}
+			}
+			if len(row) > 0 {
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while running ToCSV")
+	}
+
+	var out bytes.Buffer
+	if len(rows) == 0 {
+		return out.Bytes(), nil
+	}
+	w := csv.NewWriter(&out)
+	if err := w.Write(columns); err != nil {
+		return nil, err
+	}
+	record := make([]string, len(columns))
+	for _, row := range rows {
+		for i, column := range columns {
+			record[i] = row[column]
+		}
+		if err := w.Write(record); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return out.Bytes(), w.Error()
+}
+
+// csvValue returns the value of the scalar node fj as written in a CSV cell: the strings
+// without their quotes, the other values as in JSON.
+func csvValue(enc *encoder, fj fastJsonNode) (string, error) {
+	val, err := enc.getScalarVal(fj)
+	if err != nil {
+		return "", err
+	}
+	if len(val) == 0 || val[0] != '"' {
+		return string(val), nil
+	}
+	var s string
+	if err := json.Unmarshal(val, &s); err != nil {
+		return "", errors.Wrapf(err, "while decoding %s", val)
+	}
+	return s, nil
+}
+
+func validateSubGraphForCSV(sg *SubGraph) error {
+	if sg.IsGroupBy() {
+		return errors.New("groupby is not supported in the csv output format")
+	}
+	for _, child := range sg.Children {
+		if child.Attr == "uid" && child.Params.DoCount && child.IsInternal() {
+			return errors.New("uid count is not supported in the csv output format")
+		}
+	}
+	if sg.Params.Normalize {
+		// The nested results are flattened into the root-level ones.
+		return nil
+	}
+	if sg.Params.Recurse {
+		return errors.New("recurse queries are not supported in the csv output format," +
+			" use @normalize to flatten them")
+	}
+	for _, child := range sg.Children {
+		if len(child.Children) > 0 {
+			return errors.Errorf("nested objects are not supported in the csv output format,"+
+				" use @normalize to flatten %s", child.fieldName())
+		}
+	}
+	return nil
+}
+
+// graphsonTyped is a GraphSON 3.0 typed value.
+type graphsonTyped struct {
+	Type  string      `json:"@type"`
+	Value interface{} `json:"@value"`
+}
+
+type graphsonVertex struct {
+	ID         string                     `json:"id"`
+	Label      string                     `json:"label"`
+	Properties map[string][]graphsonTyped `json:"properties,omitempty"`
+}
+
+type graphsonProperty struct {
+	ID    string      `json:"id"`
+	Value interface{} `json:"value"`
+	Label string      `json:"label"`
+}
+
+type graphsonEdge struct {
+	ID        string `json:"id"`
+	Label     string `json:"label"`
+	InVLabel  string `json:"inVLabel"`
+	OutVLabel string `json:"outVLabel"`
+	InV       string `json:"inV"`
+	OutV      string `json:"outV"`
+}
+
+// defaultVertexLabel is the label of the vertices without a dgraph.type.
+const defaultVertexLabel = "vertex"
+
+// graphsonBuilder is used to generate GraphSON from subgraph. It walks the subgraphs like
+// rdfBuilder: the uid predicates with children become edges, the other fields properties of
+// the vertices.
+type graphsonBuilder struct {
+	vertices map[uint64]*graphsonVertex
+	// order holds the uids of the vertices in the order they were found.
+	order []uint64
+	edges []*graphsonEdge
+	// edgeIDs holds the ids of the edges already added, as an edge can be reached more than
+	// once, like in recurse queries.
+	edgeIDs map[string]struct{}
+}
+
+// ToGraphSON converts the given subgraph list into a GraphSON 3.0 list of the vertices
+// followed by the edges found in the results. The uids are the ids of the vertices, and the
+// first dgraph.type of a vertex its label if it was queried. A vertex reached more than once
+// keeps the values of a property first found.
+func ToGraphSON(l *Latency, sgl []*SubGraph) ([]byte, error) {
+	encodingStart := time.Now()
+	defer func() {
+		l.Json = time.Since(encodingStart)
+	}()
+
+	b := &graphsonBuilder{
+		vertices: make(map[uint64]*graphsonVertex),
+		edgeIDs:  make(map[string]struct{}),
+	}
+	for _, sg := range sgl {
+		if !hasOutput(sg) {
+			continue
+		}
+		if err := validateSubGraphForGraph(sg, "graphson"); err != nil {
+			return nil, err
+		}
+		// Skip parent graph. we don't want parent values.
+		for _, child := range sg.Children {
+			if err := b.castToGraphSON(child); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return json.Marshal(b.list())
+}
+
+func (b *graphsonBuilder) vertex(uid uint64) *graphsonVertex {
+	v, ok := b.vertices[uid]
+	if !ok {
+		v = &graphsonVertex{
+			ID:         fmt.Sprintf("%#x", uid),
+			Label:      defaultVertexLabel,
+			Properties: make(map[string][]graphsonTyped),
+		}
+		b.vertices[uid] = v
+		b.order = append(b.order, uid)
+	}
+	return v
+}
+
+// list returns the GraphSON list of the vertices and the edges found.
+func (b *graphsonBuilder) list() graphsonTyped {
+	items := make([]graphsonTyped, 0, len(b.order)+len(b.edges))
+	labels := make(map[string]string, len(b.order))
+	for _, uid := range b.order {
+		v := b.vertices[uid]
+		if typ, ok := v.Properties["dgraph.type"]; ok {
+			if label, ok := typ[0].Value.(graphsonProperty).Value.(string); ok {
+				v.Label = label
+			}
+		}
+		labels[v.ID] = v.Label
+		items = append(items, graphsonTyped{Type: "g:Vertex", Value: v})
+	}
+	for _, e := range b.edges {
+		e.OutVLabel, e.InVLabel = labels[e.OutV], labels[e.InV]
+		items = append(items, graphsonTyped{Type: "g:Edge", Value: e})
+	}
+	return graphsonTyped{Type: "g:List", Value: items}
+}
+
+// castToGraphSON adds the vertices and edges of the given subgraph and its children.
+func (b *graphsonBuilder) castToGraphSON(sg *SubGraph) error {
+	if err := validateSubGraphForGraph(sg, "graphson"); err != nil {
+		return err
+	}
+	if sg.SrcUIDs != nil {
+		if err := b.graphsonForSubgraph(sg); err != nil {
+			return err
+		}
+	}
+	for _, child := range sg.Children {
+		if err := b.castToGraphSON(child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *graphsonBuilder) graphsonForSubgraph(sg *SubGraph) error {
+	// Like for RDF, do not add anything if all the children of sg have a null uidMatrix,
+	// which happens for recurse queries.
+	nonNullChild := false
+	for _, ch := range sg.Children {
+		if len(ch.uidMatrix) != 0 {
+			nonNullChild = true
+		}
+	}
+	if len(sg.Children) > 0 && !nonNullChild {
+		return nil
+	}
+
+	// The uid of a vertex is its id.
+	if sg.Attr == "uid" && !sg.IsInternal() {
+		return nil
+	}
+	for i, uid := range sg.SrcUIDs.Uids {
+		if sg.Params.IgnoreResult {
+			// Skip ignored values.
+			continue
+		}
+		switch {
+		case sg.IsInternal():
+			if sg.Params.Expand != "" {
+				continue
+			}
+			val, ok := sg.Params.UidToVal[uid]
+			if !ok || val.Value == nil {
+				continue
+			}
+			b.addProperty(uid, sg.aggWithVarFieldName(), []types.Val{val})
+		case len(sg.counts) > 0:
+			fieldName := sg.Params.Alias
+			if fieldName == "" {
+				fieldName = fmt.Sprintf("count(%s)", sg.Attr)
+			}
+			count := types.Val{Tid: types.IntID, Value: int64(sg.counts[i])}
+			b.addProperty(uid, fieldName, []types.Val{count})
+		case i < len(sg.uidMatrix) && len(sg.uidMatrix[i].Uids) != 0 && len(sg.Children) > 0:
+			b.addEdges(uid, sg.uidMatrix[i], sg)
+		case i < len(sg.valueMatrix):
+			var values []types.Val
+			for _, tv := range sg.valueMatrix[i].Values {
+				val, err := convertWithBestEffort(tv, sg.Attr)
+				if err != nil {
+					continue
+				}
+				values = append(values, val)
+			}
+			b.addProperty(uid, sg.fieldName(), values)
+		}
+	}
+	return nil
+}
+
+// addProperty sets the property name of the vertex of uid, unless it was already set.
+func (b *graphsonBuilder) addProperty(uid uint64, name string, values []types.Val) {
+	if len(values) == 0 {
+		return
+	}
+	v := b.vertex(uid)
+	if _, ok := v.Properties[name]; ok {
+		return
+	}
+	for i, val := range values {
+		value, err := graphsonValue(val)
+		if err != nil {
+			// Like for RDF, the values that can't be written are skipped.
+			continue
+		}
+		v.Properties[name] = append(v.Properties[name], graphsonTyped{
+			Type: "g:VertexProperty",
+			Value: graphsonProperty{
+				ID:    fmt.Sprintf("%s|%s|%d", v.ID, name, i),
+				Value: value,
+				Label: name,
+			},
+		})
+	}
+}
+
+func (b *graphsonBuilder) addEdges(uid uint64, list *pb.List, sg *SubGraph) {
+	out := b.vertex(uid)
+	for _, destUID := range list.Uids {
+		if algo.IndexOf(sg.DestUIDs, destUID) < 0 {
+			// This uid is filtered.
+			continue
+		}
+		in := b.vertex(destUID)
+		id := fmt.Sprintf("%s|%s|%s", out.ID, sg.fieldName(), in.ID)
+		if _, ok := b.edgeIDs[id]; ok {
+			continue
+		}
+		b.edgeIDs[id] = struct{}{}
+		b.edges = append(b.edges, &graphsonEdge{
+			ID:    id,
+			Label: sg.fieldName(),
+			InV:   in.ID,
+			OutV:  out.ID,
+		})
+	}
+}
+
+// graphsonValue returns the GraphSON value of v: the numbers and the dates are typed, the
+// other values are written as JSON strings or booleans.
+func graphsonValue(v types.Val) (interface{}, error) {
+	switch v.Tid {
+	case types.IntID:
+		return graphsonTyped{Type: "g:Int64", Value: v.Value}, nil
+	case types.FloatID:
+		f, ok := v.Value.(float64)
+		// +Inf, -Inf and NaN are not representable in JSON.
+		if !ok || math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, errors.New("Unsupported floating point number in float field")
+		}
+		return graphsonTyped{Type: "g:Double", Value: f}, nil
+	case types.BoolID:
+		return v.Value, nil
+	case types.DateTimeID:
+		// g:Date is the number of milliseconds since the epoch.
+		return graphsonTyped{Type: "g:Date", Value: v.Value.(time.Time).UnixMilli()}, nil
+	case types.UidID:
+		return fmt.Sprintf("%#x", v.Value), nil
+	}
+	out := types.ValueForType(types.StringID)
+	if err := types.Marshal(v, &out); err != nil {
+		return nil, err
+	}
+	return out.Value, nil
+}
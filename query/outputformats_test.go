@@ -0,0 +1,161 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/types"
+)
+
+// valueChild returns the SubGraph of the scalar predicate attr of the nodes of uids, with
+// the given values for each of them.
+func valueChild(attr string, uids *pb.List, values ...[]*pb.TaskValue) *SubGraph {
+	sg := &SubGraph{Attr: attr, SrcUIDs: uids, DestUIDs: &pb.List{}}
+	for i := range uids.Uids {
+		sg.uidMatrix = append(sg.uidMatrix, &pb.List{})
+		sg.valueMatrix = append(sg.valueMatrix, &pb.ValueList{Values: values[i]})
+	}
+	return sg
+}
+
+func strVal(s string) []*pb.TaskValue {
+	return []*pb.TaskValue{{Val: []byte(s), ValType: pb.Posting_ValType(types.StringID)}}
+}
+
+func intVal(n int64) []*pb.TaskValue {
+	b := make([]byte, 8)
+	binary.LittleEndian.PutUint64(b, uint64(n))
+	return []*pb.TaskValue{{Val: b, ValType: pb.Posting_ValType(types.IntID)}}
+}
+
+// formatsBlock returns the query block q of the people 1 and 2: their name, their age and
+// the people they follow, with their name.
+func formatsBlock(follows bool) *SubGraph {
+	uids := &pb.List{Uids: []uint64{1, 2}}
+	children := []*SubGraph{
+		valueChild("name", uids, strVal("Alice"), strVal(`Bob, "the" builder`)),
+		valueChild("age", uids, intVal(30), nil),
+	}
+	if follows {
+		followed := &pb.List{Uids: []uint64{2, 3}}
+		children = append(children, &SubGraph{
+			Attr:      "follows",
+			SrcUIDs:   uids,
+			DestUIDs:  followed,
+			uidMatrix: []*pb.List{{Uids: []uint64{2, 3}}, {Uids: []uint64{}}},
+			Children: []*SubGraph{
+				valueChild("name", followed, strVal(`Bob, "the" builder`), strVal("Carol")),
+			},
+		})
+	}
+	return &SubGraph{
+		Params:    params{Alias: "q"},
+		SrcUIDs:   uids,
+		DestUIDs:  uids,
+		uidMatrix: []*pb.List{uids},
+		Children:  children,
+	}
+}
+
+func TestToNDJSON(t *testing.T) {
+	ndjson, err := ToNDJSON(&Latency{}, []*SubGraph{
+		formatsBlock(false),
+		{Params: params{Alias: "var"}},
+		{Params: params{Alias: "empty"}, uidMatrix: []*pb.List{{}}, DestUIDs: &pb.List{}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, `{"name":"Alice","age":30}
+{"name":"Bob, \"the\" builder"}
+`, string(ndjson))
+
+	groupby := formatsBlock(false)
+	groupby.Params.IsGroupBy = true
+	_, err = ToNDJSON(&Latency{}, []*SubGraph{groupby})
+	require.EqualError(t, err, "groupby at the root is not supported in the ndjson output format")
+}
+
+func TestToCSV(t *testing.T) {
+	csv, err := ToCSV(&Latency{}, []*SubGraph{formatsBlock(false), {Params: params{Alias: "var"}}})
+	require.NoError(t, err)
+	require.Equal(t, `name,age
+Alice,30
+"Bob, ""the"" builder",
+`, string(csv))
+
+	_, err = ToCSV(&Latency{}, []*SubGraph{formatsBlock(true)})
+	require.EqualError(t, err, "nested objects are not supported in the csv output format,"+
+		" use @normalize to flatten follows")
+
+	_, err = ToCSV(&Latency{}, []*SubGraph{formatsBlock(false), formatsBlock(false)})
+	require.EqualError(t, err, "csv output format supports a single query block, got 2")
+
+	count := formatsBlock(false)
+	count.Children = append(count.Children, &SubGraph{
+		Attr:   "uid",
+		Params: params{DoCount: true, IsInternal: true},
+	})
+	_, err = ToCSV(&Latency{}, []*SubGraph{count})
+	require.EqualError(t, err, "uid count is not supported in the csv output format")
+}
+
+func TestToGraphSON(t *testing.T) {
+	graphson, err := ToGraphSON(&Latency{}, []*SubGraph{formatsBlock(true)})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"@type": "g:List", "@value": [
+		{"@type": "g:Vertex", "@value": {"id": "0x1", "label": "vertex", "properties": {
+			"name": [{"@type": "g:VertexProperty",
+				"@value": {"id": "0x1|name|0", "value": "Alice", "label": "name"}}],
+			"age": [{"@type": "g:VertexProperty", "@value": {"id": "0x1|age|0",
+				"value": {"@type": "g:Int64", "@value": 30}, "label": "age"}}]}}},
+		{"@type": "g:Vertex", "@value": {"id": "0x2", "label": "vertex", "properties": {
+			"name": [{"@type": "g:VertexProperty",
+				"@value": {"id": "0x2|name|0", "value": "Bob, \"the\" builder", "label": "name"}}]}}},
+		{"@type": "g:Vertex", "@value": {"id": "0x3", "label": "vertex", "properties": {
+			"name": [{"@type": "g:VertexProperty",
+				"@value": {"id": "0x3|name|0", "value": "Carol", "label": "name"}}]}}},
+		{"@type": "g:Edge", "@value": {"id": "0x1|follows|0x2", "label": "follows",
+			"inVLabel": "vertex", "outVLabel": "vertex", "inV": "0x2", "outV": "0x1"}},
+		{"@type": "g:Edge", "@value": {"id": "0x1|follows|0x3", "label": "follows",
+			"inVLabel": "vertex", "outVLabel": "vertex", "inV": "0x3", "outV": "0x1"}}
+	]}`, string(graphson))
+
+	normalize := formatsBlock(true)
+	normalize.Params.Normalize = true
+	_, err = ToGraphSON(&Latency{}, []*SubGraph{normalize})
+	require.EqualError(t, err, "normalize directive is not supported in the graphson output format")
+}
+
+func TestGraphSONVertexLabel(t *testing.T) {
+	uids := &pb.List{Uids: []uint64{1}}
+	block := &SubGraph{
+		Params:    params{Alias: "q"},
+		SrcUIDs:   uids,
+		DestUIDs:  uids,
+		uidMatrix: []*pb.List{uids},
+		Children: []*SubGraph{
+			valueChild("dgraph.type", uids, append(strVal("Person"), strVal("Employee")...)),
+		},
+	}
+	graphson, err := ToGraphSON(&Latency{}, []*SubGraph{block})
+	require.NoError(t, err)
+	require.Contains(t, string(graphson), `"id":"0x1","label":"Person"`)
+}
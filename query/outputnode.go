@@ -38,6 +38,7 @@ import (
 
 	"github.com/dgraph-io/dgo/v230/protos/api"
 	"github.com/dgraph-io/dgraph/v24/algo"
+	"github.com/dgraph-io/dgraph/v24/dql"
 	gqlSchema "github.com/dgraph-io/dgraph/v24/graphql/schema"
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
 	"github.com/dgraph-io/dgraph/v24/task"
@@ -52,7 +53,8 @@ func ToJson(ctx context.Context, l *Latency, sgl []*SubGraph, field gqlSchema.Fi
 	error) {
 	sgr := &SubGraph{}
 	for _, sg := range sgl {
-		if sg.Params.Alias == "var" || sg.Params.Alias == "shortest" {
+		if sg.Params.Alias == "var" || sg.Params.Alias == "shortest" ||
+			dql.IsGraphAlgorithm(sg.Params.Alias) {
 			continue
 		}
 		if sg.Params.GetUid {
@@ -701,6 +703,10 @@ func valToBytes(v types.Val) ([]byte, error) {
 		return []byte(fmt.Sprintf("%q", v.Value.(string))), nil
 	case types.VFloatID:
 		return json.Marshal(v.Value.([]float32))
+	case types.VInt8ID:
+		return json.Marshal(v.Value.([]int8))
+	case types.VBinaryID:
+		return []byte(types.BinaryVectorAsString(v.Value.([]byte))), nil
 	default:
 		return nil, errors.New("Unsupported types.Val.Tid")
 	}
@@ -1135,43 +1141,11 @@ func processNodeUids(fj fastJsonNode, enc *encoder, sg *SubGraph) error {
 			// This UID was filtered. So Ignore it.
 			continue
 		}
-
-		n1 := enc.newNode(attrID)
-		enc.setAttr(n1, enc.idForAttr(sg.Params.Alias))
-		if err := sg.preTraverse(enc, uid, n1); err != nil {
-			if err.Error() == "_INV_" {
-				continue
-			}
-			return err
-		}
-
-		if enc.IsEmpty(n1) {
-			continue
-		}
-
-		hasChild = true
-		if !sg.Params.Normalize {
-			enc.AddListChild(fj, n1)
-			continue
-		}
-
-		// With the new changes we store children in reverse order(check addChildren method). This
-		// leads to change of order of field responses for existing Normalize test cases. To
-		// minimize the changes of existing tests case we are fixing order of node children before
-		// calling normalize() on it. Also once we have fixed order for children, we don't need to
-		// fix its order again. Hence mark the newly created node visited immediately.
-		enc.fixOrder(n1)
-		// Lets normalize the response now.
-		normalized, err := enc.normalize(n1)
+		added, err := sg.addRootNode(enc, fj, attrID, uid)
 		if err != nil {
 			return err
 		}
-		for _, c := range normalized {
-			node := enc.newNode(attrID)
-			enc.setVisited(node, true)
-			enc.addChildren(node, c)
-			enc.AddListChild(fj, node)
-		}
+		hasChild = hasChild || added
 	}
 
 	if !hasChild {
@@ -1181,11 +1155,55 @@ func processNodeUids(fj fastJsonNode, enc *encoder, sg *SubGraph) error {
 	return nil
 }
 
+// addRootNode adds the node of uid to the root-level results fj of sg. It returns false if
+// the node was empty, hence not added.
+func (sg *SubGraph) addRootNode(enc *encoder, fj fastJsonNode, attrID uint16,
+	uid uint64) (bool, error) {
+	n1 := enc.newNode(attrID)
+	enc.setAttr(n1, enc.idForAttr(sg.Params.Alias))
+	if err := sg.preTraverse(enc, uid, n1); err != nil {
+		if err.Error() == "_INV_" {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if enc.IsEmpty(n1) {
+		return false, nil
+	}
+
+	if !sg.Params.Normalize {
+		enc.AddListChild(fj, n1)
+		return true, nil
+	}
+
+	// With the new changes we store children in reverse order(check addChildren method). This
+	// leads to change of order of field responses for existing Normalize test cases. To
+	// minimize the changes of existing tests case we are fixing order of node children before
+	// calling normalize() on it. Also once we have fixed order for children, we don't need to
+	// fix its order again. Hence mark the newly created node visited immediately.
+	enc.fixOrder(n1)
+	// Lets normalize the response now.
+	normalized, err := enc.normalize(n1)
+	if err != nil {
+		return false, err
+	}
+	for _, c := range normalized {
+		node := enc.newNode(attrID)
+		enc.setVisited(node, true)
+		enc.addChildren(node, c)
+		enc.AddListChild(fj, node)
+	}
+	return true, nil
+}
+
 // Extensions represents the extra information appended to query results.
 type Extensions struct {
 	Latency *api.Latency    `json:"server_latency,omitempty"`
 	Txn     *api.TxnContext `json:"txn,omitempty"`
 	Metrics *api.Metrics    `json:"metrics,omitempty"`
+	// Profile is the plan or the profile of the query, see ProfileMode.
+	Profile json.RawMessage `json:"profile,omitempty"`
 }
 
 func (sg *SubGraph) toFastJSON(ctx context.Context, l *Latency, field gqlSchema.Field) ([]byte,
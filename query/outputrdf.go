@@ -211,24 +211,32 @@ func buildTriple(val []byte) []byte {
 }
 
 func validateSubGraphForRDF(sg *SubGraph) error {
+	return validateSubGraphForGraph(sg, "rdf")
+}
+
+// validateSubGraphForGraph checks that the results of sg can be written as the nodes and the
+// edges of a graph, in the given output format.
+func validateSubGraphForGraph(sg *SubGraph, format string) error {
 	if sg.IsGroupBy() {
-		return errors.New("groupby is not supported in rdf output format")
+		return errors.Errorf("groupby is not supported in %s output format", format)
 	}
 	uidCount := sg.Attr == "uid" && sg.Params.DoCount && sg.IsInternal()
 	if uidCount {
-		return errors.New("uid count is not supported in the rdf output format")
+		return errors.Errorf("uid count is not supported in the %s output format", format)
 	}
 	if sg.Params.Normalize {
-		return errors.New("normalize directive is not supported in the rdf output format")
+		return errors.Errorf("normalize directive is not supported in the %s output format",
+			format)
 	}
 	if sg.Params.IgnoreReflex {
-		return errors.New("ignorereflex directive is not supported in the rdf output format")
+		return errors.Errorf("ignorereflex directive is not supported in the %s output format",
+			format)
 	}
 	if sg.SrcFunc != nil && sg.SrcFunc.Name == "checkpwd" {
-		return errors.New("chkpwd function is not supported in the rdf output format")
+		return errors.Errorf("chkpwd function is not supported in the %s output format", format)
 	}
 	if sg.Params.Facet != nil && !sg.Params.ExpandAll {
-		return errors.New("facets are not supported in the rdf output format")
+		return errors.Errorf("facets are not supported in the %s output format", format)
 	}
 	return nil
 }
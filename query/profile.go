@@ -0,0 +1,211 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/worker"
+)
+
+// ProfileMode tells what is reported about the execution of a query, along with its
+// result.
+type ProfileMode int
+
+const (
+	// NoProfile reports nothing.
+	NoProfile ProfileMode = iota
+	// Explain reports the plan of the query without running it: the function, the index
+	// and the group of every SubGraph. The query returns no data.
+	Explain
+	// Profile runs the query and reports its plan along with the number of uids and the
+	// time spent by every SubGraph.
+	Profile
+)
+
+// GetProfileMode returns the ProfileMode of the query of ctx. gRPC clients set it with
+// the "explain" or "profile" metadata, and get the result in the x.DgraphProfileTrailer
+// trailer. HTTP sets it with ProfileKey, and returns the result in the extensions of the
+// response. Explain takes precedence over Profile if both are set.
+func GetProfileMode(ctx context.Context) ProfileMode {
+	if mode, ok := ctx.Value(ProfileKey).(ProfileMode); ok && mode != NoProfile {
+		return mode
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return NoProfile
+	}
+	isSet := func(key string) bool {
+		if len(md[key]) == 0 {
+			return false
+		}
+		// An invalid value is ignored, like for debug.
+		set, _ := strconv.ParseBool(md[key][0])
+		return set
+	}
+	switch {
+	case isSet("explain"):
+		return Explain
+	case isSet("profile"):
+		return Profile
+	}
+	return NoProfile
+}
+
+// isProfiling tells whether the query of ctx is run in Profile mode, as set by
+// Request.ProcessQuery.
+func isProfiling(ctx context.Context) bool {
+	mode, _ := ctx.Value(ProfileKey).(ProfileMode)
+	return mode == Profile
+}
+
+// ProfileNode describes how a SubGraph of a query is, or would be, executed.
+type ProfileNode struct {
+	Attr  string   `json:"attr,omitempty"`
+	Alias string   `json:"alias,omitempty"`
+	Func  string   `json:"func,omitempty"`
+	Args  []string `json:"args,omitempty"`
+	// Index is the index the function is evaluated with. It's empty if the function reads
+	// the values of the predicate instead.
+	Index string `json:"index,omitempty"`
+	// Group is the group serving the predicate. It's zero if no task was sent for the
+	// SubGraph, like for a uid function or a value variable.
+	Group  uint32   `json:"group,omitempty"`
+	Order  []string `json:"order,omitempty"`
+	First  int      `json:"first,omitempty"`
+	Offset int      `json:"offset,omitempty"`
+
+	FilterOp string         `json:"filter_op,omitempty"`
+	Filters  []*ProfileNode `json:"filters,omitempty"`
+	Children []*ProfileNode `json:"children,omitempty"`
+
+	// Stats is only set once the query was run, in Profile mode.
+	Stats *ProfileStats `json:"stats,omitempty"`
+}
+
+// ProfileStats is what is measured while running a SubGraph.
+type ProfileStats struct {
+	UidsIn  int `json:"uids_in"`
+	UidsOut int `json:"uids_out"`
+	// DurationNs is the time spent running the SubGraph, including its filters and
+	// children.
+	DurationNs uint64 `json:"duration_ns"`
+}
+
+// sendsTask tells whether ProcessGraph sends a task to the group serving the predicate of
+// the SubGraph.
+func (sg *SubGraph) sendsTask() bool {
+	switch sg.Attr {
+	case "", "uid", "expand", dql.VectorDistanceAttr, dql.HybridScoreAttr:
+		return false
+	}
+	if dql.IsRecursePathAttr(sg.Attr) {
+		return false
+	}
+	if sg.IsInternal() {
+		return false
+	}
+	if sg.SrcFunc == nil {
+		return true
+	}
+	return sg.SrcFunc.Name != "hybrid_search" &&
+		!(isInequalityFn(sg.SrcFunc.Name) && (sg.SrcFunc.IsValueVar || sg.SrcFunc.IsLenVar))
+}
+
+func newProfileNode(sg *SubGraph) *ProfileNode {
+	node := &ProfileNode{
+		Attr:     sg.Attr,
+		Alias:    sg.Params.Alias,
+		First:    sg.Params.Count,
+		Offset:   sg.Params.Offset,
+		FilterOp: sg.FilterOp,
+	}
+	if sg.SrcFunc != nil {
+		node.Func = sg.SrcFunc.Name
+		for _, arg := range sg.SrcFunc.Args {
+			node.Args = append(node.Args, arg.Value)
+		}
+	}
+	for _, order := range sg.Params.Order {
+		if order.Desc {
+			node.Order = append(node.Order, order.Attr+" desc")
+		} else {
+			node.Order = append(node.Order, order.Attr+" asc")
+		}
+	}
+	return node
+}
+
+// explain returns the plan of sg, asking the groups serving the predicates which index
+// they would use. The parent of sg is nil at root.
+func explain(ctx context.Context, sg, parent *SubGraph) (*ProfileNode, error) {
+	node := newProfileNode(sg)
+	if sg.sendsTask() {
+		taskQuery, err := createTaskQuery(ctx, sg)
+		if err != nil {
+			return nil, err
+		}
+		if parent != nil {
+			// Below the root, the task is given the uids of the parent, which aren't known
+			// before running the query.
+			taskQuery.UidList = &pb.List{}
+		}
+		if node.Group, node.Index, err = worker.PlanTask(ctx, taskQuery); err != nil {
+			return nil, err
+		}
+	}
+	for _, filter := range sg.Filters {
+		child, err := explain(ctx, filter, sg)
+		if err != nil {
+			return nil, err
+		}
+		node.Filters = append(node.Filters, child)
+	}
+	for _, sgChild := range sg.Children {
+		child, err := explain(ctx, sgChild, sg)
+		if err != nil {
+			return nil, err
+		}
+		node.Children = append(node.Children, child)
+	}
+	return node, nil
+}
+
+// profile returns the plan of sg along with what was measured while running it.
+func profile(sg *SubGraph) *ProfileNode {
+	node := newProfileNode(sg)
+	node.Index = sg.index
+	node.Group = sg.groupId
+	node.Stats = &ProfileStats{
+		UidsIn:     len(sg.SrcUIDs.GetUids()),
+		UidsOut:    len(sg.DestUIDs.GetUids()),
+		DurationNs: uint64(sg.elapsed.Nanoseconds()),
+	}
+	for _, filter := range sg.Filters {
+		node.Filters = append(node.Filters, profile(filter))
+	}
+	for _, child := range sg.Children {
+		node.Children = append(node.Children, profile(child))
+	}
+	return node
+}
@@ -0,0 +1,109 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+)
+
+func TestGetProfileMode(t *testing.T) {
+	ctx := context.Background()
+	require.Equal(t, NoProfile, GetProfileMode(ctx))
+	require.Equal(t, Profile, GetProfileMode(context.WithValue(ctx, ProfileKey, Profile)))
+
+	incoming := func(kv ...string) context.Context {
+		return metadata.NewIncomingContext(ctx, metadata.Pairs(kv...))
+	}
+	require.Equal(t, Explain, GetProfileMode(incoming("explain", "true")))
+	require.Equal(t, Profile, GetProfileMode(incoming("profile", "1")))
+	require.Equal(t, Explain, GetProfileMode(incoming("profile", "true", "explain", "true")))
+	require.Equal(t, NoProfile, GetProfileMode(incoming("profile", "yes please")))
+}
+
+func TestProfileTree(t *testing.T) {
+	filter := &SubGraph{
+		Attr:     "age",
+		SrcFunc:  &Function{Name: "gt", Args: []dql.Arg{{Value: "10"}}},
+		SrcUIDs:  &pb.List{Uids: []uint64{1, 2, 3}},
+		DestUIDs: &pb.List{Uids: []uint64{2}},
+		index:    "int",
+		groupId:  2,
+		elapsed:  time.Millisecond,
+	}
+	child := &SubGraph{
+		Attr:     "friend",
+		Params:   params{Count: 10, Order: []*pb.Order{{Attr: "name", Desc: true}}},
+		SrcUIDs:  &pb.List{Uids: []uint64{2}},
+		DestUIDs: &pb.List{},
+		groupId:  1,
+	}
+	root := &SubGraph{
+		Attr:     "name",
+		Params:   params{Alias: "me"},
+		SrcFunc:  &Function{Name: "eq", Args: []dql.Arg{{Value: "alice"}}},
+		DestUIDs: &pb.List{Uids: []uint64{1, 2, 3}},
+		Filters:  []*SubGraph{filter},
+		Children: []*SubGraph{child},
+		index:    "exact",
+		groupId:  1,
+		elapsed:  3 * time.Millisecond,
+	}
+
+	require.Equal(t, &ProfileNode{
+		Attr:  "name",
+		Alias: "me",
+		Func:  "eq",
+		Args:  []string{"alice"},
+		Index: "exact",
+		Group: 1,
+		Filters: []*ProfileNode{{
+			Attr:  "age",
+			Func:  "gt",
+			Args:  []string{"10"},
+			Index: "int",
+			Group: 2,
+			Stats: &ProfileStats{UidsIn: 3, UidsOut: 1, DurationNs: 1e6},
+		}},
+		Children: []*ProfileNode{{
+			Attr:  "friend",
+			Group: 1,
+			Order: []string{"name desc"},
+			First: 10,
+			Stats: &ProfileStats{UidsIn: 1},
+		}},
+		Stats: &ProfileStats{UidsOut: 3, DurationNs: 3e6},
+	}, profile(root))
+}
+
+func TestSendsTask(t *testing.T) {
+	require.True(t, (&SubGraph{Attr: "name"}).sendsTask())
+	require.True(t, (&SubGraph{Attr: "name", SrcFunc: &Function{Name: "eq"}}).sendsTask())
+	require.False(t, (&SubGraph{Attr: "uid"}).sendsTask())
+	require.False(t, (&SubGraph{Attr: "expand"}).sendsTask())
+	require.False(t, (&SubGraph{SrcFunc: &Function{Name: "uid"}}).sendsTask())
+	require.False(t, (&SubGraph{Attr: "age",
+		SrcFunc: &Function{Name: "gt", IsValueVar: true}}).sendsTask())
+	require.False(t, (&SubGraph{Attr: "name", Params: params{IsInternal: true}}).sendsTask())
+}
@@ -175,11 +175,31 @@ type params struct {
 	MaxWeight float64
 	// MinWeight is the min weight allowed in a path returned by the shortest path algorithm.
 	MinWeight float64
-
-	// ExploreDepth is used by recurse and shortest path queries to specify the maximum graph
-	// depth to explore.
+	// AllPaths is true if the shortest path query returns all the paths of minimum cost, up
+	// to NumPaths if it's set.
+	AllPaths bool
+	// MaxHops is the max number of edges in a path returned by the shortest path algorithm,
+	// whatever their weight.
+	MaxHops *uint64
+	// Alternate is true if the edges of the paths returned by the shortest path algorithm
+	// follow the predicates of the query in turn.
+	Alternate bool
+	// Bidirectional is true if the shortest path query searches from both ends at once, over
+	// the reverse edges from the destination.
+	Bidirectional bool
+
+	// ExploreDepth is used by recurse, shortest path and graph algorithm queries to specify
+	// the maximum graph depth to explore.
 	ExploreDepth *uint64
 
+	// Damping is the probability to follow an edge rather than jump to any node in PageRank.
+	Damping float64
+	// Iterations is the maximum number of iterations of the pagerank and communities
+	// algorithms.
+	Iterations int
+	// Tolerance stops PageRank once the ranks change by less than it in an iteration.
+	Tolerance float64
+
 	// IsInternal determines if processTask has to be called or not.
 	IsInternal bool
 	// IgnoreResult is true if the node results are to be ignored.
@@ -191,6 +211,8 @@ type params struct {
 	IsGroupBy bool // True if @groupby is specified.
 	// GroupbyAttrs holds the list of attributes to group by.
 	GroupbyAttrs []dql.GroupByAttr
+	// GroupbyArgs holds the arguments filtering, ordering and paginating the groups.
+	GroupbyArgs dql.GroupByArgs
 
 	// ParentIds is a stack that is maintained and passed down to children.
 	ParentIds []uint64
@@ -300,6 +322,26 @@ type SubGraph struct {
 	pathMeta *pathMetadata
 
 	vectorMetrics map[string]uint64
+	// vectorDistances maps every uid returned by a similar_to function to
+	// its distance from the query vector.
+	vectorDistances map[uint64]float64
+	// hybridScores maps every uid returned by a hybrid_search function to its
+	// fused score.
+	hybridScores map[uint64]float64
+	// algoValues maps every node reached by a graph algorithm block to its score or
+	// label.
+	algoValues map[uint64]types.Val
+
+	// index and groupId are the index used and the group that served the task of the
+	// SubGraph, if any. elapsed is the time spent processing the SubGraph, including its
+	// filters and children. They are reported in the profile of the query.
+	index   string
+	groupId uint32
+	elapsed time.Duration
+
+	// streamed is set on the query blocks whose results are streamed. Their children are
+	// processed by StreamJson, one batch of root uids at a time, instead of all at once.
+	streamed bool
 }
 
 func (sg *SubGraph) recurse(set func(sg *SubGraph)) {
@@ -569,6 +611,7 @@ func treeCopy(gq *dql.GraphQuery, sg *SubGraph) error {
 			Order:        gchild.Order,
 			Var:          gchild.Var,
 			GroupbyAttrs: gchild.GroupbyAttrs,
+			GroupbyArgs:  gchild.GroupbyArgs,
 			IsGroupBy:    gchild.IsGroupby,
 			IsInternal:   gchild.IsInternal,
 			Cascade:      &CascadeArgs{},
@@ -725,6 +768,30 @@ func (args *params) fill(gq *dql.GraphQuery) error {
 			args.MinWeight = -math.MaxFloat64
 		}
 
+		if v, ok := gq.Args["maxhops"]; ok {
+			maxHops, err := strconv.ParseUint(v, 0, 64)
+			if err != nil {
+				return err
+			}
+			args.MaxHops = &maxHops
+		}
+
+		for key, flag := range map[string]*bool{
+			"allpaths":      &args.AllPaths,
+			"alternate":     &args.Alternate,
+			"bidirectional": &args.Bidirectional,
+		} {
+			v, ok := gq.Args[key]
+			if !ok {
+				continue
+			}
+			set, err := strconv.ParseBool(v)
+			if err != nil {
+				return errors.Errorf("%s should be true or false. Got: %s", key, v)
+			}
+			*flag = set
+		}
+
 		if gq.ShortestPathArgs.From == nil || gq.ShortestPathArgs.To == nil {
 			return errors.Errorf("from/to can't be nil for shortest path")
 		}
@@ -746,6 +813,57 @@ func (args *params) fill(gq *dql.GraphQuery) error {
 	return nil
 }
 
+// fillGraphAlgorithmArgs fills the parameters of a graph algorithm block and checks that
+// its body only holds the predicates to follow.
+func (args *params) fillGraphAlgorithmArgs(gq *dql.GraphQuery) error {
+	if gq.Func == nil && len(gq.UID) == 0 {
+		return errors.Errorf("%s needs a function at root to start from", gq.Alias)
+	}
+	if len(gq.Children) == 0 {
+		return errors.Errorf("%s needs at least one predicate to follow", gq.Alias)
+	}
+	for _, child := range gq.Children {
+		if child.Expand != "" || child.Var != "" || len(child.Children) > 0 ||
+			child.IsInternal || child.IsCount || child.Func != nil || child.Facets != nil {
+			return errors.Errorf("Only predicates to follow allowed inside %s. Got: %s",
+				gq.Alias, child.Attr)
+		}
+	}
+
+	args.Damping = defaultDamping
+	if v, ok := gq.Args["damping"]; ok {
+		damping, err := strconv.ParseFloat(v, 64)
+		if err != nil || damping < 0 || damping > 1 {
+			return errors.Errorf("damping should be a number between 0 and 1. Got: %s", v)
+		}
+		args.Damping = damping
+	}
+	args.Iterations = defaultIterations
+	if v, ok := gq.Args["iterations"]; ok {
+		iterations, err := strconv.ParseUint(v, 0, 32)
+		if err != nil {
+			return errors.Errorf("iterations should be a non-negative integer. Got: %s", v)
+		}
+		args.Iterations = int(iterations)
+	}
+	args.Tolerance = defaultTolerance
+	if v, ok := gq.Args["tolerance"]; ok {
+		tolerance, err := strconv.ParseFloat(v, 64)
+		if err != nil || tolerance < 0 {
+			return errors.Errorf("tolerance should be a non-negative number. Got: %s", v)
+		}
+		args.Tolerance = tolerance
+	}
+	if v, ok := gq.Args["depth"]; ok {
+		depth, err := strconv.ParseUint(v, 0, 64)
+		if err != nil {
+			return err
+		}
+		args.ExploreDepth = &depth
+	}
+	return nil
+}
+
 // ToSubGraph converts the GraphQuery into the pb.SubGraph instance type.
 func ToSubGraph(ctx context.Context, gq *dql.GraphQuery) (*SubGraph, error) {
 	sg, err := newGraph(ctx, gq)
@@ -765,6 +883,8 @@ type ContextKey int
 const (
 	// DebugKey is the key used to toggle debug mode.
 	DebugKey ContextKey = iota
+	// ProfileKey is the key used to set the ProfileMode.
+	ProfileKey
 )
 
 func isDebug(ctx context.Context) bool {
@@ -817,6 +937,7 @@ func newGraph(ctx context.Context, gq *dql.GraphQuery) (*SubGraph, error) {
 		ShortestPathArgs: gq.ShortestPathArgs,
 		Var:              gq.Var,
 		GroupbyAttrs:     gq.GroupbyAttrs,
+		GroupbyArgs:      gq.GroupbyArgs,
 		IsGroupBy:        gq.IsGroupby,
 		AllowedPreds:     gq.AllowedPreds,
 	}
@@ -835,6 +956,11 @@ func newGraph(ctx context.Context, gq *dql.GraphQuery) (*SubGraph, error) {
 	if err := args.fill(gq); err != nil {
 		return nil, errors.Wrapf(err, "while filling args")
 	}
+	if dql.IsGraphAlgorithm(gq.Alias) {
+		if err := args.fillGraphAlgorithmArgs(gq); err != nil {
+			return nil, err
+		}
+	}
 
 	sg := &SubGraph{Params: args}
 
@@ -954,6 +1080,7 @@ func createTaskQuery(ctx context.Context, sg *SubGraph) (*pb.Query, error) {
 		ExpandAll:    sg.Params.ExpandAll,
 		First:        first,
 		Offset:       offset,
+		Profile:      isProfiling(ctx),
 	}
 
 	if sg.SrcUIDs != nil {
@@ -1032,8 +1159,9 @@ func evalLevelAgg(
 		// corresponding to uid 0 to avoid defining another field in SubGraph.
 		vals := doneVars[needsVar].Vals
 
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
+		ag, err := newAggregator(sg.SrcFunc)
+		if err != nil {
+			return nil, err
 		}
 		for _, val := range vals {
 			err := ag.Apply(val)
@@ -1077,8 +1205,9 @@ func evalLevelAgg(
 	mp = make(map[uint64]types.Val)
 	// Go over the sibling node and aggregate.
 	for i, list := range relSG.uidMatrix {
-		ag := aggregator{
-			name: sg.SrcFunc.Name,
+		ag, err := newAggregator(sg.SrcFunc)
+		if err != nil {
+			return nil, err
 		}
 		for _, uid := range list.Uids {
 			if val, ok := vals[uid]; ok {
@@ -1479,6 +1608,14 @@ func (sg *SubGraph) populateUidValVar(doneVars map[string]varValue, sgPath []*Su
 	var ok bool
 
 	switch {
+	case sg.algoValues != nil:
+		// 0. The variable of a graph algorithm block is a value variable holding the score or
+		// the label of every node reached. Its path is the block itself, as the values
+		// aren't related to the nodes of any other block.
+		doneVars[sg.Params.Var] = varValue{
+			Vals: sg.algoValues,
+			path: []*SubGraph{sg},
+		}
 	case len(sg.counts) > 0:
 		// 1. When count of a predicate is assigned a variable, we store the mapping of uid =>
 		// count(predicate).
@@ -1902,6 +2039,403 @@ func (sg *SubGraph) appendDummyValues() {
 	}
 }
 
+// collectVectorDistances returns the distances computed by the similar_to
+// functions used by sg, either at its root or inside its filters.
+func (sg *SubGraph) collectVectorDistances(distances map[uint64]float64) {
+	for uid, d := range sg.vectorDistances {
+		distances[uid] = d
+	}
+	for _, filter := range sg.Filters {
+		filter.collectVectorDistances(distances)
+	}
+}
+
+// collectHybridScores returns the scores computed by the hybrid_search
+// function used at the root of sg.
+func (sg *SubGraph) collectHybridScores(scores map[uint64]float64) {
+	for uid, s := range sg.hybridScores {
+		scores[uid] = s
+	}
+}
+
+// fillPseudoValues populates the values of a pseudo-predicate, like _distance_
+// or _score_, using the values that collect gathers from the functions of the
+// parent. Nodes that were not matched by such a function get no value.
+func (sg *SubGraph) fillPseudoValues(parent *SubGraph,
+	collect func(sg *SubGraph, values map[uint64]float64)) error {
+	sg.DestUIDs = &pb.List{}
+	if sg.SrcUIDs == nil || len(sg.SrcUIDs.Uids) == 0 {
+		return nil
+	}
+
+	scores := make(map[uint64]float64)
+	if parent != nil {
+		collect(parent, scores)
+	}
+	values := make(map[uint64]types.Val, len(scores))
+	for uid, d := range scores {
+		values[uid] = types.Val{Tid: types.FloatID, Value: d}
+	}
+	return sg.fillValues(values)
+}
+
+// fillValues populates the values of a pseudo-predicate with the value of every source uid
+// in values. The uids without one get no value.
+func (sg *SubGraph) fillValues(values map[uint64]types.Val) error {
+	for _, uid := range sg.SrcUIDs.Uids {
+		sg.uidMatrix = append(sg.uidMatrix, &pb.List{})
+		vl := &pb.ValueList{}
+		if v, ok := values[uid]; ok {
+			data := types.ValueForType(types.BinaryID)
+			if err := types.Marshal(v, &data); err != nil {
+				return err
+			}
+			vl.Values = []*pb.TaskValue{{ValType: v.Tid.Enum(), Val: data.Value.([]byte)}}
+		}
+		sg.valueMatrix = append(sg.valueMatrix, vl)
+	}
+	return nil
+}
+
+// applyFilters runs the filters of sg on its DestUIDs and keeps the uids that pass them.
+func (sg *SubGraph) applyFilters(ctx context.Context) error {
+	if len(sg.Filters) == 0 {
+		return nil
+	}
+	// Run all filters in parallel.
+	filterChan := make(chan error, len(sg.Filters))
+	for _, filter := range sg.Filters {
+		isUidFuncWithoutVar := filter.SrcFunc != nil && filter.SrcFunc.Name == "uid" &&
+			len(filter.Params.NeedsVar) == 0
+		// For uid function filter, no need for processing. User already gave us the
+		// list. Lets just update DestUIDs.
+		if isUidFuncWithoutVar {
+			filter.DestUIDs = filter.SrcUIDs
+			filterChan <- nil
+			continue
+		}
+
+		filter.SrcUIDs = sg.DestUIDs
+		if len(filter.SrcUIDs.Uids) == 0 {
+			filterChan <- nil
+			continue
+		}
+		// Passing the pointer is okay since the filter only reads.
+		filter.Params.ParentVars = sg.Params.ParentVars // Pass to the child.
+		go ProcessGraph(ctx, filter, sg, filterChan)
+	}
+
+	var filterErr error
+	for range sg.Filters {
+		if err := <-filterChan; err != nil {
+			// Store error in a variable and wait for all filters to run
+			// before returning. Else tracing causes crashes.
+			filterErr = err
+		}
+	}
+
+	if filterErr != nil {
+		return filterErr
+	}
+
+	// Now apply the results from filter.
+	var lists []*pb.List
+	for _, filter := range sg.Filters {
+		lists = append(lists, filter.DestUIDs)
+	}
+
+	switch {
+	case sg.FilterOp == "or":
+		sg.DestUIDs = algo.MergeSorted(lists)
+	case sg.FilterOp == "not":
+		x.AssertTrue(len(sg.Filters) == 1)
+		sg.DestUIDs = algo.Difference(sg.DestUIDs, sg.Filters[0].DestUIDs)
+	case sg.FilterOp == "and":
+		sg.DestUIDs = algo.IntersectSorted(lists)
+	default:
+		// We need to also intersect the original dest uids in this case to get the final
+		// DestUIDs.
+		// me(func: eq(key, "key1")) @filter(eq(key, "key2"))
+
+		// TODO - See if the server performing the filter can intersect with the srcUIDs before
+		// returning them in this case.
+		lists = append(lists, sg.DestUIDs)
+		sg.DestUIDs = algo.IntersectSorted(lists)
+	}
+	return nil
+}
+
+// maxVectorFilterCandidates is the largest number of uids that can pass the filters
+// of a similar_to function at root which are sent along with its task, so that the
+// vector search compares the query against them instead of searching the index.
+const maxVectorFilterCandidates = 1000
+
+// filteredVectorSearch runs the task q of the similar_to function at root of sg, which
+// has filters. The filters are sent along with the task as a VectorFilter, checked by
+// the vector search for every node it comes across, so that the search returns the
+// nearest nodes which pass them and the filters don't need to be applied to its result.
+// When few uids can pass the filters (see vectorSearchCandidates), they are sent too,
+// and the search only considers them. The filters aren't sent at all if those are
+// exactly the uids which pass them.
+func (sg *SubGraph) filteredVectorSearch(ctx context.Context, q *pb.Query) (*pb.Result, error) {
+	candidates, exact, err := sg.vectorSearchCandidates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if candidates != nil {
+		q.UidList = candidates
+		if exact {
+			return worker.ProcessTaskOverNetwork(ctx, q)
+		}
+	}
+	// Filters of a node are implicitly ANDed.
+	if q.VectorFilter, err = vectorFilterOf(ctx, &SubGraph{Filters: sg.Filters}); err != nil {
+		return nil, err
+	}
+	return worker.ProcessTaskOverNetwork(ctx, q)
+}
+
+// vectorFilterOf returns the filter tree f as a VectorFilter. Its leaves are the
+// tasks of the functions of f, or the uids accepted by the functions evaluated
+// without a task. A leaf which accepts every uid, or none, is an and, or an or,
+// without children.
+func vectorFilterOf(ctx context.Context, f *SubGraph) (*pb.VectorFilter, error) {
+	if len(f.Filters) > 0 {
+		out := &pb.VectorFilter{Op: f.FilterOp}
+		if out.Op != "or" && out.Op != "not" {
+			out.Op = "and"
+		}
+		for _, filter := range f.Filters {
+			child, err := vectorFilterOf(ctx, filter)
+			if err != nil {
+				return nil, err
+			}
+			out.Children = append(out.Children, child)
+		}
+		// Check the leaves which don't run a task first.
+		sort.SliceStable(out.Children, func(i, j int) bool {
+			return isUidsFilter(out.Children[i]) && !isUidsFilter(out.Children[j])
+		})
+		return out, nil
+	}
+
+	fn := f.SrcFunc
+	switch {
+	case fn == nil:
+		return &pb.VectorFilter{Op: "and"}, nil
+	case fn.Name == "uid" && len(f.Params.NeedsVar) == 0:
+		return &pb.VectorFilter{Uids: f.SrcUIDs}, nil
+	case fn.Name == "uid":
+		// The variables have already been filled into DestUIDs by fillVars.
+		return &pb.VectorFilter{Uids: &pb.List{Uids: f.DestUIDs.GetUids()}}, nil
+	case isInequalityFn(fn.Name) && fn.IsValueVar:
+		temp := &SubGraph{SrcFunc: fn, DestUIDs: &pb.List{}}
+		temp.Params.UidToVal = f.Params.UidToVal
+		if err := temp.applyIneqFunc(); err != nil {
+			return nil, err
+		}
+		return &pb.VectorFilter{Uids: temp.DestUIDs}, nil
+	case isInequalityFn(fn.Name) && fn.IsLenVar:
+		val := fn.Args[0].Value
+		dst, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(val)}, types.IntID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid argument %v. Comparing with different type", val)
+		}
+		curVal := types.Val{Tid: types.IntID, Value: int64(len(f.DestUIDs.GetUids()))}
+		if types.CompareVals(fn.Name, curVal, dst) {
+			return &pb.VectorFilter{Op: "and"}, nil
+		}
+		return &pb.VectorFilter{Op: "or"}, nil
+	}
+	q, err := createTaskQuery(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+	q.UidList = nil
+	return &pb.VectorFilter{Query: q}, nil
+}
+
+func isUidsFilter(f *pb.VectorFilter) bool {
+	return f.Op == "" && f.Query == nil
+}
+
+// vectorSearchCandidates evaluates the filters of a similar_to function at root
+// on their own, so that the vector search only considers the uids which can pass
+// them. Filters that need source uids to be evaluated (like a not which isn't part
+// of an and), or which accept more than maxVectorFilterCandidates uids, are skipped,
+// which can make the returned list a superset of the uids accepted by the filters.
+// The returned bool is true when it isn't. A nil list means that the search can't
+// be restricted to few enough uids.
+func (sg *SubGraph) vectorSearchCandidates(ctx context.Context) (*pb.List, bool, error) {
+	// Filters of a node are implicitly ANDed.
+	l, exact, err := filterCandidates(ctx, &SubGraph{FilterOp: "and", Filters: sg.Filters})
+	if err != nil || l == nil || len(l.Uids) > maxVectorFilterCandidates {
+		return nil, false, err
+	}
+	return l, exact, nil
+}
+
+// filterCandidates returns the uids that could pass the filter f, or nil if they
+// can't be computed without source uids. The returned bool is true when every
+// uid of the list is known to pass the filter.
+func filterCandidates(ctx context.Context, f *SubGraph) (*pb.List, bool, error) {
+	switch f.FilterOp {
+	case "and":
+		var lists, excluded []*pb.List
+		exact := true
+		for _, filter := range f.Filters {
+			if filter.FilterOp == "not" {
+				// The complement can't be computed, but the uids accepted
+				// by the negated filter can be removed from the others.
+				l, lExact, err := filterCandidates(ctx, filter.Filters[0])
+				if err != nil {
+					return nil, false, err
+				}
+				if l != nil && lExact {
+					excluded = append(excluded, l)
+				} else {
+					exact = false
+				}
+				continue
+			}
+			l, lExact, err := filterCandidates(ctx, filter)
+			if err != nil {
+				return nil, false, err
+			}
+			if l == nil {
+				exact = false
+				continue
+			}
+			lists = append(lists, l)
+			exact = exact && lExact
+		}
+		if len(lists) == 0 {
+			return nil, false, nil
+		}
+		out := algo.IntersectSorted(lists)
+		for _, l := range excluded {
+			out = algo.Difference(out, l)
+		}
+		return out, exact, nil
+	case "or":
+		var lists []*pb.List
+		exact := true
+		for _, filter := range f.Filters {
+			l, lExact, err := filterCandidates(ctx, filter)
+			if err != nil || l == nil {
+				return nil, false, err
+			}
+			lists = append(lists, l)
+			exact = exact && lExact
+		}
+		return algo.MergeSorted(lists), exact, nil
+	case "not":
+		return nil, false, nil
+	}
+	l, err := funcCandidates(ctx, f)
+	if l != nil && len(l.Uids) > maxVectorFilterCandidates {
+		// Too many uids to be worth restricting the search to them.
+		return nil, false, err
+	}
+	return l, l != nil, err
+}
+
+// funcCandidates returns the uids that pass the function of the filter f, or nil
+// if they can't be computed without source uids.
+func funcCandidates(ctx context.Context, f *SubGraph) (*pb.List, error) {
+	fn := f.SrcFunc
+	if fn == nil || fn.IsCount || fn.IsValueVar || fn.IsLenVar {
+		return nil, nil
+	}
+	switch {
+	case fn.Name == "uid" && len(f.Params.NeedsVar) == 0:
+		return f.SrcUIDs, nil
+	case fn.Name == "uid":
+		// The variables have already been filled into DestUIDs by fillVars.
+		return &pb.List{Uids: f.DestUIDs.GetUids()}, nil
+	case fn.Name == "uid_in":
+		return uidInCandidates(ctx, f)
+	case isInequalityFn(fn.Name), types.IsGeoFunc(fn.Name):
+	case fn.Name == "anyofterms", fn.Name == "allofterms", fn.Name == "anyoftext",
+		fn.Name == "alloftext", fn.Name == "regexp", fn.Name == "match",
+		fn.Name == "anyof", fn.Name == "allof":
+	default:
+		return nil, nil
+	}
+
+	// Evaluate the function as if it was used at root, which requires the
+	// predicate to be indexed.
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !schema.State().IsIndexed(ctx, x.NamespaceAttr(ns, f.Attr)) {
+		return nil, nil
+	}
+	temp := &SubGraph{
+		Attr:    f.Attr,
+		SrcFunc: fn,
+		ReadTs:  f.ReadTs,
+		Cache:   f.Cache,
+	}
+	temp.Params.Langs = f.Params.Langs
+	return processCandidatesTask(ctx, temp)
+}
+
+// uidInCandidates returns the uids that could pass the uid_in filter f. These
+// are found through the reverse edges of the predicate, so the predicate needs
+// the @reverse directive.
+func uidInCandidates(ctx context.Context, f *SubGraph) (*pb.List, error) {
+	ns, err := x.ExtractNamespace(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if strings.HasPrefix(f.Attr, "~") ||
+		!schema.State().IsReversed(ctx, x.NamespaceAttr(ns, f.Attr)) {
+		return nil, nil
+	}
+	uids := make([]uint64, 0, len(f.SrcFunc.Args))
+	for _, arg := range f.SrcFunc.Args {
+		uid, err := strconv.ParseUint(arg.Value, 0, 64)
+		if err != nil {
+			// Let the filter itself report the invalid argument.
+			return nil, nil
+		}
+		uids = append(uids, uid)
+	}
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	temp := &SubGraph{
+		Attr:    "~" + f.Attr,
+		SrcUIDs: &pb.List{Uids: uids},
+		ReadTs:  f.ReadTs,
+		Cache:   f.Cache,
+	}
+	return processCandidatesTask(ctx, temp)
+}
+
+// processCandidatesTask runs the task for sg and returns the uids it matched.
+// Failures to evaluate the task only mean that no candidates could be computed,
+// the filter will report them when it's applied to the search results.
+func processCandidatesTask(ctx context.Context, sg *SubGraph) (*pb.List, error) {
+	taskQuery, err := createTaskQuery(ctx, sg)
+	if err != nil {
+		return nil, err
+	}
+	result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+	switch {
+	case err != nil && strings.Contains(err.Error(), worker.ErrNonExistentTabletMessage):
+		// Nothing can match a predicate which doesn't exist.
+		return &pb.List{}, nil
+	case err != nil:
+		glog.V(2).Infof("Unable to compute vector search candidates for %s: %v", sg.Attr, err)
+		return nil, nil
+	case result.IntersectDest:
+		return algo.IntersectSorted(result.UidMatrix), nil
+	default:
+		return algo.MergeSorted(result.UidMatrix), nil
+	}
+}
+
 func getPredsFromVals(vl []*pb.ValueList) []string {
 	preds := make([]string, 0)
 	for _, l := range vl {
@@ -2065,6 +2599,15 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 // ProcessGraph processes the SubGraph instance accumulating result for the query
 // from different instances. Note: taskQuery is nil for root node.
 func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
+	// The time spent must be known before the parent is told that the SubGraph is done.
+	start := time.Now()
+	done := make(chan error, 1)
+	processGraph(ctx, sg, parent, done)
+	sg.elapsed = time.Since(start)
+	rch <- <-done
+}
+
+func processGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 	var suffix string
 	if len(sg.Params.Alias) > 0 {
 		suffix += "." + sg.Params.Alias
@@ -2084,7 +2627,24 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		rch <- nil
 		return
 	}
+	if sg.Attr == dql.VectorDistanceAttr {
+		// The distances were computed by the similar_to function of the
+		// parent, there is nothing to fetch over the network.
+		rch <- sg.fillPseudoValues(parent, (*SubGraph).collectVectorDistances)
+		return
+	}
+	if sg.Attr == dql.HybridScoreAttr {
+		// Same as above, with the scores of the hybrid_search function.
+		rch <- sg.fillPseudoValues(parent, (*SubGraph).collectHybridScores)
+		return
+	}
+	if dql.IsRecursePathAttr(sg.Attr) {
+		// The values are filled by the @recurse block once all its levels are expanded.
+		rch <- errors.Errorf("%s is only supported in a @recurse block with path: true", sg.Attr)
+		return
+	}
 	var err error
+	var filtered bool
 	switch {
 	case parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == "uid":
 		// I'm root and I'm using some variable that has been populated.
@@ -2161,13 +2721,29 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			} else {
 				sg.DestUIDs.Uids = nil
 			}
+		case sg.SrcFunc != nil && sg.SrcFunc.Name == "hybrid_search":
+			if parent != nil {
+				rch <- errors.Errorf("hybrid_search can only be used at root")
+				return
+			}
+			if err = sg.hybridSearch(ctx); err != nil {
+				rch <- err
+				return
+			}
 		default:
 			taskQuery, err := createTaskQuery(ctx, sg)
 			if err != nil {
 				rch <- err
 				return
 			}
-			result, err := worker.ProcessTaskOverNetwork(ctx, taskQuery)
+			var result *pb.Result
+			if parent == nil && sg.SrcFunc != nil && sg.SrcFunc.Name == "similar_to" &&
+				len(sg.Filters) > 0 {
+				result, err = sg.filteredVectorSearch(ctx, taskQuery)
+				filtered = err == nil
+			} else {
+				result, err = worker.ProcessTaskOverNetwork(ctx, taskQuery)
+			}
 			switch {
 			case err != nil && strings.Contains(err.Error(), worker.ErrNonExistentTabletMessage):
 				sg.UnknownAttr = true
@@ -2185,6 +2761,14 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 			sg.LangTags = result.LangMatrix
 			sg.List = result.List
 			sg.vectorMetrics = result.VectorMetrics
+			sg.index = result.Index
+			sg.groupId = result.GroupId
+			if len(result.VectorDistances) > 0 {
+				sg.vectorDistances = make(map[uint64]float64, len(result.VectorDistances))
+				for i, uid := range result.UidMatrix[0].GetUids() {
+					sg.vectorDistances[uid] = result.VectorDistances[i]
+				}
+			}
 
 			if sg.Params.DoCount {
 				if len(sg.Filters) == 0 {
@@ -2218,69 +2802,12 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		}
 	}
 
-	// Run filters if any.
-	if len(sg.Filters) > 0 {
-		// Run all filters in parallel.
-		filterChan := make(chan error, len(sg.Filters))
-		for _, filter := range sg.Filters {
-			isUidFuncWithoutVar := filter.SrcFunc != nil && filter.SrcFunc.Name == "uid" &&
-				len(filter.Params.NeedsVar) == 0
-			// For uid function filter, no need for processing. User already gave us the
-			// list. Lets just update DestUIDs.
-			if isUidFuncWithoutVar {
-				filter.DestUIDs = filter.SrcUIDs
-				filterChan <- nil
-				continue
-			}
-
-			filter.SrcUIDs = sg.DestUIDs
-			if len(filter.SrcUIDs.Uids) == 0 {
-				filterChan <- nil
-				continue
-			}
-			// Passing the pointer is okay since the filter only reads.
-			filter.Params.ParentVars = sg.Params.ParentVars // Pass to the child.
-			go ProcessGraph(ctx, filter, sg, filterChan)
-		}
-
-		var filterErr error
-		for range sg.Filters {
-			if err = <-filterChan; err != nil {
-				// Store error in a variable and wait for all filters to run
-				// before returning. Else tracing causes crashes.
-				filterErr = err
-			}
-		}
-
-		if filterErr != nil {
-			rch <- filterErr
+	// Run filters if any, unless the vector search already did.
+	if !filtered {
+		if err = sg.applyFilters(ctx); err != nil {
+			rch <- err
 			return
 		}
-
-		// Now apply the results from filter.
-		var lists []*pb.List
-		for _, filter := range sg.Filters {
-			lists = append(lists, filter.DestUIDs)
-		}
-
-		switch {
-		case sg.FilterOp == "or":
-			sg.DestUIDs = algo.MergeSorted(lists)
-		case sg.FilterOp == "not":
-			x.AssertTrue(len(sg.Filters) == 1)
-			sg.DestUIDs = algo.Difference(sg.DestUIDs, sg.Filters[0].DestUIDs)
-		case sg.FilterOp == "and":
-			sg.DestUIDs = algo.IntersectSorted(lists)
-		default:
-			// We need to also intersect the original dest uids in this case to get the final
-			// DestUIDs.
-			// me(func: eq(key, "key1")) @filter(eq(key, "key2"))
-
-			// TODO - See if the server performing the filter can intersect with the srcUIDs before
-			// returning them in this case.
-			lists = append(lists, sg.DestUIDs)
-			sg.DestUIDs = algo.IntersectSorted(lists)
-		}
 	}
 
 	if len(sg.Params.Order) == 0 && len(sg.Params.FacetsOrder) == 0 {
@@ -2354,6 +2881,12 @@ func ProcessGraph(ctx context.Context, sg, parent *SubGraph, rch chan error) {
 		}
 	}
 
+	if parent == nil && sg.streamed {
+		// The children are processed with the batches of root uids, see streamJson.
+		rch <- nil
+		return
+	}
+
 	childChan := make(chan error, len(sg.Children))
 	for i := 0; i < len(sg.Children); i++ {
 		child := sg.Children[i]
@@ -2653,7 +3186,8 @@ func (sg *SubGraph) sortAndPaginateUsingVar(ctx context.Context) error {
 func isValidArg(a string) bool {
 	switch a {
 	case "numpaths", "from", "to", "orderasc", "orderdesc", "first", "offset", "after", "depth",
-		"minweight", "maxweight":
+		"minweight", "maxweight", "damping", "iterations", "tolerance", "allpaths", "maxhops",
+		"alternate", "bidirectional":
 		return true
 	}
 	return false
@@ -2663,7 +3197,8 @@ func isValidArg(a string) bool {
 func isValidFuncName(f string) bool {
 	switch f {
 	case "anyofterms", "allofterms", "val", "regexp", "anyoftext", "alloftext",
-		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to":
+		"has", "uid", "uid_in", "anyof", "allof", "type", "match", "similar_to",
+		"hybrid_search":
 		return true
 	}
 	return isInequalityFn(f) || types.IsGeoFunc(f)
@@ -2682,7 +3217,7 @@ func isAggregatorFn(f string) bool {
 	case "min", "max", "sum", "avg":
 		return true
 	}
-	return false
+	return isStatAggregator(f)
 }
 
 func isUidFnWithoutVar(f *dql.Function) bool {
@@ -2762,6 +3297,11 @@ type Request struct {
 	Cache    int    // 0 represents use txn cache, 1 represents not to use cache.
 	Latency  *Latency
 	DqlQuery *dql.Result
+	// Profile tells whether the plan or the profile of the query is returned.
+	Profile ProfileMode
+	// Stream tells that the results are streamed with StreamJson. The children of the query
+	// blocks which can be streamed are then processed by batch while they are encoded.
+	Stream bool
 
 	Subgraphs []*SubGraph
 
@@ -2776,6 +3316,10 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 	stop := x.SpanTimer(span, "query.ProcessQuery")
 	defer stop()
 
+	// The tasks only report what the profile needs when it is asked for, see
+	// isProfiling.
+	ctx = context.WithValue(ctx, ProfileKey, req.Profile)
+
 	// Vars stores the processed variables.
 	req.Vars = make(map[string]varValue)
 	loopStart := time.Now()
@@ -2797,10 +3341,18 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 			sg.ReadTs = req.ReadTs
 			sg.Cache = req.Cache
 		})
+		sg.recurse(func(sg *SubGraph) {
+			sg.useCompositeIndexes(ctx)
+		})
+		sg.streamed = req.Stream && canStream(sg, req.DqlQuery.QueryVars[i])
 		span.Annotate(nil, "Query parsed")
 		req.Subgraphs = append(req.Subgraphs, sg)
 	}
 	req.Latency.Parsing += time.Since(loopStart)
+	if req.Profile == Explain {
+		// The plan of the query is all that is returned, see Process.
+		return nil
+	}
 
 	execStart := time.Now()
 	hasExecuted := make([]bool, len(req.Subgraphs))
@@ -2861,9 +3413,10 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 				continue
 			}
 
-			// Just as above, no need to execute "similar_to" query if the
-			// vector parameter was a Var and evaluated as empty
-			if sg.SrcFunc != nil && sg.SrcFunc.Name == "similar_to" &&
+			// Just as above, no need to execute "similar_to" or "hybrid_search"
+			// query if the vector parameter was a Var and evaluated as empty
+			if sg.SrcFunc != nil &&
+				(sg.SrcFunc.Name == "similar_to" || sg.SrcFunc.Name == "hybrid_search") &&
 				len(sg.SrcFunc.Args) == 1 && len(sg.Params.NeedsVar) > 0 {
 				errChan <- nil
 				continue
@@ -2880,6 +3433,10 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 				go func() {
 					errChan <- recurse(ctx, sg)
 				}()
+			case dql.IsGraphAlgorithm(sg.Params.Alias):
+				go func() {
+					errChan <- runGraphAlgorithm(ctx, sg)
+				}()
 			default:
 				go ProcessGraph(ctx, sg, nil, errChan)
 			}
@@ -2900,6 +3457,11 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 		// If the executed subgraph had some variable defined in it, Populate it in the map.
 		for _, idx := range idxList {
 			sg := req.Subgraphs[idx]
+			if sg.streamed {
+				// Its children aren't processed yet, and there is nothing to populate, see
+				// canStream.
+				continue
+			}
 
 			var sgPath []*SubGraph
 			if err := sg.populateVarMap(req.Vars, sgPath); err != nil {
@@ -2938,12 +3500,41 @@ func (req *Request) ProcessQuery(ctx context.Context) (err error) {
 	return nil
 }
 
+// canStream tells whether the children of the query block sg can be processed by batch of
+// root uids. It can't be done when they are needed as a whole, to define variables, to
+// apply @cascade or to compute @groupby, aggregations, math and val() after they are
+// processed (see populatePostAggregation), or when the root isn't a plain list of uids.
+func canStream(sg *SubGraph, vars *dql.Vars) bool {
+	if len(vars.Defines) > 0 || sg.Params.IsEmpty || sg.Params.Recurse ||
+		sg.Params.Alias == "shortest" || sg.Params.Alias == "var" ||
+		dql.IsGraphAlgorithm(sg.Params.Alias) {
+		return false
+	}
+	ok := true
+	sg.recurse(func(node *SubGraph) {
+		switch {
+		case node.Params.Cascade != nil && len(node.Params.Cascade.Fields) > 0:
+			ok = false
+		case node.IsGroupBy() || node.MathExp != nil:
+			ok = false
+		case node.SrcFunc != nil && isAggregatorFn(node.SrcFunc.Name):
+			ok = false
+		case node != sg && node.IsInternal() && len(node.Params.NeedsVar) > 0:
+			// The values of val() are filled after the children are processed.
+			ok = false
+		}
+	})
+	return ok
+}
+
 // ExecutionResult holds the result of running a query.
 type ExecutionResult struct {
 	Subgraphs  []*SubGraph
 	SchemaNode []*pb.SchemaNode
 	Types      []*pb.TypeUpdate
 	Metrics    map[string]uint64
+	// Profile holds the plan or the profile of every query block, see ProfileMode.
+	Profile []*ProfileNode
 }
 
 // Process handles a query request.
@@ -2952,7 +3543,22 @@ func (req *Request) Process(ctx context.Context) (er ExecutionResult, err error)
 	if err != nil {
 		return er, err
 	}
+	if req.Profile == Explain {
+		for _, sg := range req.Subgraphs {
+			node, err := explain(ctx, sg, nil)
+			if err != nil {
+				return er, errors.Wrapf(err, "while explaining query")
+			}
+			er.Profile = append(er.Profile, node)
+		}
+		return er, nil
+	}
 	er.Subgraphs = req.Subgraphs
+	if req.Profile == Profile {
+		for _, sg := range er.Subgraphs {
+			er.Profile = append(er.Profile, profile(sg))
+		}
+	}
 	// calculate metrics.
 	metrics := make(map[string]uint64)
 	for _, sg := range er.Subgraphs {
@@ -3021,6 +3627,12 @@ func filterSchemaNodeForNamespace(namespace uint64, nodes []*pb.SchemaNode) []*p
 			continue
 		}
 		node.Predicate = attrName
+		for i, pred := range node.UniqueWith {
+			node.UniqueWith[i] = x.ParseAttr(pred)
+		}
+		for i, pred := range node.CompositeWith {
+			node.CompositeWith[i] = x.ParseAttr(pred)
+		}
 		out = append(out, node)
 	}
 	return out
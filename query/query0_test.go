@@ -964,6 +964,33 @@ func TestQueryVarValAggSince(t *testing.T) {
 		js)
 }
 
+func TestQueryVarValStringDateFuncs(t *testing.T) {
+	query := `
+		{
+			f as var(func: anyofterms(name, "Michonne Andrea Rick")) {
+				n as name
+				a as dob
+				u as math(upper(substr(n, 0, 3)))
+				y as math(year(a))
+				m as math(date_trunc("month", a))
+				d as math(date_diff("day", a, "1910-01-03T00:00:00Z"))
+			}
+
+			me(func: uid(f), orderasc: val(a)) {
+				val(u)
+				val(y)
+				val(m)
+				val(d)
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[
+		{"val(u)":"AND","val(y)":1901,"val(m)":"1901-01-01T00:00:00Z","val(d)":3275},
+		{"val(u)":"MIC","val(y)":1910,"val(m)":"1910-01-01T00:00:00Z","val(d)":2},
+		{"val(u)":"RIC","val(y)":1910,"val(m)":"1910-01-01T00:00:00Z","val(d)":1}]}}`, js)
+}
+
 func TestQueryVarValAggNestedFuncConst(t *testing.T) {
 	query := `
 		{
@@ -1723,6 +1750,64 @@ func TestGroupByAgg(t *testing.T) {
 		js)
 }
 
+func TestGroupByCountDistinct(t *testing.T) {
+	query := `
+		{
+			me(func: uid( 1)) {
+				friend @groupby(age) {
+					names: count_distinct(name)
+				}
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t,
+		`{"data": {"me":[{"friend":[{"@groupby":[{"age":17,"names":1},{"age":19,"names":1},{"age":15,"names":2}]}]}]}}`,
+		js)
+}
+
+func TestGroupByOrderFirst(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(age, orderdesc: count, first: 1) {
+					count(uid)
+				}
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"friend":[{"@groupby":[{"age":15,"count":2}]}]}]}}`, js)
+}
+
+func TestGroupByHaving(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(school, having: gt(count, 2)) {
+					count(uid)
+				}
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"friend":[{"@groupby":[{"school":"0x1389","count":3}]}]}]}}`, js)
+}
+
+func TestGroupByArgsUnknownAggregate(t *testing.T) {
+	query := `
+		{
+			me(func: uid(1)) {
+				friend @groupby(age, orderdesc: oldest) {
+					count(uid)
+				}
+			}
+		}
+	`
+	_, err := processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, "Unknown key or aggregate oldest in groupby arguments")
+}
+
 func TestGroupByMulti(t *testing.T) {
 	query := `
 		{
@@ -1438,6 +1438,25 @@ func TestAggregateRoot2(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"avg(val(a))":24.000000},{"min(val(a))":15},{"max(val(a))":38}]}}`, js)
 }
 
+func TestAggregateRootStats(t *testing.T) {
+
+	query := `
+		{
+			var(func: anyofterms(name, "Rick Michonne Andrea")) {
+				a as age
+			}
+
+			me() {
+				median: median(val(a))
+				p100: percentile(val(a), 100)
+				distinct: count_distinct(val(a))
+			}
+		}
+	`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me":[{"median":19.0},{"p100":38.0},{"distinct":3}]}}`, js)
+}
+
 func TestAggregateRoot3(t *testing.T) {
 
 	query := `
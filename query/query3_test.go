@@ -23,14 +23,20 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 
+	"github.com/dgraph-io/dgo/v230/protos/api"
 	"github.com/dgraph-io/dgraph/v24/dgraphapi"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
 	"github.com/dgraph-io/dgraph/v24/testutil"
+	"github.com/dgraph-io/dgraph/v24/x"
 )
 
 func TestRecurseError(t *testing.T) {
@@ -279,6 +285,100 @@ func TestRecurseVariable2(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me":[{"name":"Glenn Rhee"},{"name":"Andrea"},{"name":"Alice"},{"name":"Bob"},{"name":"Matt"},{"name":"John"}],"me2":[{"name":"Michonne"},{"name":"Rick Grimes"},{"name":"Glenn Rhee"},{"name":"Daryl Dixon"},{"name":"Andrea"}]}}`, js)
 }
 
+func TestRecursePath(t *testing.T) {
+	query := `
+		{
+			me(func: uid(0x01)) @recurse(path: true) {
+				friend
+				name
+				_depth_
+				_recurse_path_
+				_cycle_
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{"me":[{"name":"Michonne","_depth_":0,
+		"_recurse_path_":[{"uid":"0x1"}],"friend":[
+		{"name":"Rick Grimes","_depth_":1,"_recurse_path_":[{"uid":"0x1"},{"uid":"0x17"}],
+			"_cycle_":[{"uid":"0x1"}],
+			"friend":[{"name":"Michonne","_depth_":0,"_recurse_path_":[{"uid":"0x1"}]}]},
+		{"name":"Glenn Rhee","_depth_":1,"_recurse_path_":[{"uid":"0x1"},{"uid":"0x18"}]},
+		{"name":"Daryl Dixon","_depth_":1,"_recurse_path_":[{"uid":"0x1"},{"uid":"0x19"}]},
+		{"name":"Andrea","_depth_":1,"_recurse_path_":[{"uid":"0x1"},{"uid":"0x1f"}],
+			"friend":[{"name":"Glenn Rhee","_depth_":1,
+				"_recurse_path_":[{"uid":"0x1"},{"uid":"0x18"}]}]},
+		{"_depth_":1,"_recurse_path_":[{"uid":"0x1"},{"uid":"0x65"}]}]}]}}`, js)
+}
+
+func TestRecurseMinDepth(t *testing.T) {
+	query := `
+		{
+			me(func: uid(0x01)) @recurse(mindepth: 3, path: true) {
+				follow
+				name
+				_depth_
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{"me":[{"name":"Michonne","_depth_":0,"follow":[
+		{"name":"Andrea","_depth_":1,"follow":[
+			{"name":"Bob","_depth_":2,"follow":[
+				{"name":"Alice","_depth_":3},
+				{"name":"John","_depth_":3,"follow":[
+					{"name":"Matt","_depth_":4,"follow":[{"name":"Alice","_depth_":3}]}]}]}]}]}]}}`,
+		js)
+}
+
+func TestRecurseMinDepthVariables(t *testing.T) {
+	query := `
+		{
+			var(func: uid(0x01)) @recurse(mindepth: 3, path: true) {
+				deps as follow
+				d as _depth_
+				p as _recurse_path_
+			}
+
+			me(func: uid(deps)) {
+				name
+				depth: val(d)
+			}
+
+			path(func: uid(p)) {
+				uid
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{"me":[
+		{"name":"Alice","depth":3},
+		{"name":"Matt","depth":4},
+		{"name":"John","depth":3}],
+		"path":[{"uid":"0x1"},{"uid":"0x18"},{"uid":"0x1f"},{"uid":"0x3e8"},{"uid":"0x3e9"},
+			{"uid":"0x3ea"},{"uid":"0x3eb"}]}}`, js)
+}
+
+func TestRecursePathError(t *testing.T) {
+	query := `
+		{
+			me(func: uid(0x01)) @recurse {
+				friend
+				_depth_
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "_depth_ is only supported in a @recurse block with path: true")
+
+	query = `
+		{
+			me(func: uid(0x01)) @recurse(depth: 2, mindepth: 3) {
+				friend
+			}
+		}`
+	_, err = processQuery(context.Background(), t, query)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Mindepth can't be greater than depth for recurse query")
+}
+
 func TestShortestPath_ExpandError(t *testing.T) {
 
 	query := `
@@ -313,6 +413,223 @@ func TestShortestPath_NoPath(t *testing.T) {
 	require.JSONEq(t, `{"data": {"me": []}}`, js)
 }
 
+func TestGraphAlgorithmPageRank(t *testing.T) {
+	query := `
+		{
+			pr as pagerank(func: uid(31)) {
+				friend
+			}
+
+			me(func: uid(pr), orderdesc: val(pr)) {
+				uid
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [{"uid": "0x18"}, {"uid": "0x1f"}]}}`, js)
+}
+
+func TestGraphAlgorithmComponents(t *testing.T) {
+	query := `
+		{
+			cc as components(func: uid(501, 504)) {
+				newfriend
+			}
+
+			me(func: uid(501, 508, 504, 512)) {
+				uid
+				component: val(cc)
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [
+		{"uid": "0x1f5", "component": 501},
+		{"uid": "0x1f8", "component": 504},
+		{"uid": "0x1fc", "component": 501},
+		{"uid": "0x200", "component": 504}
+	]}}`, js)
+}
+
+func TestGraphAlgorithmCommunities(t *testing.T) {
+	query := `
+		{
+			comm as communities(func: uid(504)) {
+				newfriend
+			}
+
+			me(func: uid(comm)) {
+				uid
+				community: val(comm)
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"me": [
+		{"uid": "0x1f8", "community": 504},
+		{"uid": "0x1fd", "community": 504},
+		{"uid": "0x1fe", "community": 510},
+		{"uid": "0x1ff", "community": 510},
+		{"uid": "0x200", "community": 510}
+	]}}`, js)
+}
+
+func TestGraphAlgorithmNestedBlockError(t *testing.T) {
+	query := `
+		{
+			pr as pagerank(func: uid(1)) {
+				friend {
+					name
+				}
+			}
+
+			me(func: uid(pr)) {
+				name
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, "Only predicates to follow allowed inside pagerank")
+}
+
+func processQueryForProfile(t *testing.T, query, mode string) (string, []*ProfileNode) {
+	conn, err := grpc.Dial(testutil.SockAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, conn.Close()) }()
+
+	// The profile is returned in a trailer, the JSON of the response only holds the data.
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(mode, "true"))
+	var trailer metadata.MD
+	res, err := api.NewDgraphClient(conn).Query(ctx,
+		&api.Request{Query: query, ReadOnly: true}, grpc.Trailer(&trailer))
+	require.NoError(t, err)
+	require.Len(t, trailer.Get(x.DgraphProfileTrailer), 1)
+	var profile []*ProfileNode
+	require.NoError(t, json.Unmarshal([]byte(trailer.Get(x.DgraphProfileTrailer)[0]), &profile))
+	require.NotEmpty(t, profile)
+	require.NotContains(t, string(res.Json), "extensions")
+	return string(res.Json), profile
+}
+
+func TestExplainQuery(t *testing.T) {
+	query := `
+		{
+			me(func: eq(name, "Michonne")) @filter(gt(age, 10)) {
+				name
+				friend {
+					name
+				}
+			}
+		}`
+	js, nodes := processQueryForProfile(t, query, "explain")
+	require.JSONEq(t, `{}`, js)
+	require.Len(t, nodes, 1)
+
+	me := nodes[0]
+	require.Equal(t, "eq", me.Func)
+	require.Equal(t, []string{"Michonne"}, me.Args)
+	require.Equal(t, "exact", me.Index)
+	require.NotZero(t, me.Group)
+	require.Nil(t, me.Stats)
+	require.Len(t, me.Filters, 1)
+	require.Equal(t, "age", me.Filters[0].Attr)
+	require.Equal(t, "int", me.Filters[0].Index)
+	require.Len(t, me.Children, 2)
+	require.Equal(t, "friend", me.Children[1].Attr)
+	require.Empty(t, me.Children[1].Index)
+	require.NotZero(t, me.Children[1].Group)
+}
+
+func TestProfileQuery(t *testing.T) {
+	query := `
+		{
+			me(func: eq(name, "Michonne")) @filter(gt(age, 10)) {
+				name
+				friend {
+					name
+				}
+			}
+		}`
+	js, nodes := processQueryForProfile(t, query, "profile")
+	require.Contains(t, js, `"name":"Michonne"`)
+	require.Len(t, nodes, 1)
+
+	me := nodes[0]
+	require.Equal(t, "exact", me.Index)
+	require.NotZero(t, me.Group)
+	require.Equal(t, 1, me.Stats.UidsOut)
+	require.NotZero(t, me.Stats.DurationNs)
+	require.Equal(t, 1, me.Filters[0].Stats.UidsIn)
+	require.Equal(t, 1, me.Filters[0].Stats.UidsOut)
+	friend := me.Children[1]
+	require.Equal(t, "friend", friend.Attr)
+	require.Equal(t, 1, friend.Stats.UidsIn)
+	require.Equal(t, 5, friend.Stats.UidsOut)
+	require.LessOrEqual(t, friend.Stats.DurationNs, me.Stats.DurationNs)
+}
+
+func TestExplainMutationError(t *testing.T) {
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("explain", "true"))
+	txn := client.NewTxn()
+	defer func() { _ = txn.Discard(ctx) }()
+
+	_, err := txn.Mutate(ctx, &api.Mutation{SetNquads: []byte(`_:a <name> "a" .`)})
+	require.ErrorContains(t, err, "Explain can't be used with mutations")
+}
+
+func TestStreamQuery(t *testing.T) {
+	conn, err := grpc.Dial(testutil.SockAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, conn.Close()) }()
+
+	query := `
+		{
+			me(func: uid(0x01, 23, 24)) {
+				name
+			}
+			count(func: uid(0x01, 23, 24)) {
+				count(uid)
+			}
+		}`
+	stream, err := pb.NewQueryStreamerClient(conn).StreamQuery(context.Background(),
+		&api.Request{Query: query, ReadOnly: true})
+	require.NoError(t, err)
+
+	var chunks []string
+	var last *pb.QueryChunk
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		if len(chunk.Json) > 0 {
+			chunks = append(chunks, string(chunk.Json))
+		}
+		last = chunk
+	}
+	require.Len(t, chunks, 2)
+	require.JSONEq(t, `{"me":[{"name":"Michonne"},{"name":"Rick Grimes"},{"name":"Glenn Rhee"}]}`,
+		chunks[0])
+	require.JSONEq(t, `{"count":[{"count":3}]}`, chunks[1])
+	require.NotNil(t, last.Txn)
+	require.NotNil(t, last.Latency)
+	require.Empty(t, last.Json)
+	// The children processed by batch are counted in the metrics.
+	require.Equal(t, uint64(3), last.Metrics.NumUids["name"])
+}
+
+func TestStreamQueryMutationError(t *testing.T) {
+	conn, err := grpc.Dial(testutil.SockAddr,
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() { require.NoError(t, conn.Close()) }()
+
+	stream, err := pb.NewQueryStreamerClient(conn).StreamQuery(context.Background(),
+		&api.Request{Mutations: []*api.Mutation{{SetNquads: []byte(`_:a <name> "a" .`)}}})
+	require.NoError(t, err)
+	_, err = stream.Recv()
+	require.ErrorContains(t, err, "Mutations can't be streamed")
+}
+
 func TestKShortestPath_NoPath(t *testing.T) {
 
 	query := `
@@ -976,6 +1293,164 @@ func TestShortestPathPassword(t *testing.T) {
 			"me":[{"name":"Michonne"},{"name":"Andrea"}]}}`, js)
 }
 
+func TestAllShortestPaths(t *testing.T) {
+	query := `
+		{
+			shortest(from: 1, to: 1003, allpaths: true) {
+				path
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	// Both paths have 4 hops, they are returned in any order.
+	var res, expected struct {
+		Data struct {
+			Path []interface{} `json:"_path_"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(js), &res))
+	require.NoError(t, json.Unmarshal([]byte(`{"data": {"_path_":[
+		{"uid":"0x1","_weight_":4,"path":{"uid":"0x1f","path":{"uid":"0x3e8",
+			"path":{"uid":"0x3e9","path":{"uid":"0x3eb"}}}}},
+		{"uid":"0x1","_weight_":4,"path":{"uid":"0x1f","path":{"uid":"0x3e8",
+			"path":{"uid":"0x3ea","path":{"uid":"0x3eb"}}}}}]}}`), &expected))
+	require.ElementsMatch(t, expected.Data.Path, res.Data.Path)
+}
+
+func TestShortestPathMaxHops(t *testing.T) {
+	// The cheapest path has 5 hops, the cheapest one with at most 4 hops goes through 0x3ea.
+	query := `
+		{
+			shortest(from: 1, to: 1003, maxhops: 4) {
+				path @facets(weight)
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `
+		{
+		  "data": {
+		    "_path_": [
+		      {
+		        "path": {
+		          "path": {
+		            "path": {
+		              "path": {
+		                "uid": "0x3eb",
+		                "path|weight": 0.6
+		              },
+		              "uid": "0x3ea",
+		              "path|weight": 0.7
+		            },
+		            "uid": "0x3e8",
+		            "path|weight": 0.1
+		          },
+		          "uid": "0x1f",
+		          "path|weight": 0.1
+		        },
+		        "uid": "0x1",
+		        "_weight_": 1.5
+		      }
+		    ]
+		  }
+		}
+	`, js)
+
+	query = `
+		{
+			shortest(from: 1, to: 1003, maxhops: 3) {
+				path @facets(weight)
+			}
+		}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{}}`, js)
+}
+
+func TestShortestPathExcludeNodes(t *testing.T) {
+	query := `
+		{
+			shortest(from: 1, to: 1003) @filter(not uid(1002)) {
+				path @facets(weight)
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `
+		{
+		  "data": {
+		    "_path_": [
+		      {
+		        "path": {
+		          "path": {
+		            "path": {
+		              "path": {
+		                "uid": "0x3eb",
+		                "path|weight": 1.5
+		              },
+		              "uid": "0x3e9",
+		              "path|weight": 0.1
+		            },
+		            "uid": "0x3e8",
+		            "path|weight": 0.1
+		          },
+		          "uid": "0x1f",
+		          "path|weight": 0.1
+		        },
+		        "uid": "0x1",
+		        "_weight_": 1.8
+		      }
+		    ]
+		  }
+		}
+	`, js)
+}
+
+func TestShortestPathAlternate(t *testing.T) {
+	// Without alternating, the shortest path is 0x1 -path-> 0x1f -path-> 0x3e8.
+	query := `
+		{
+			shortest(from: 1, to: 1000, alternate: true) {
+				path
+				follow
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"_path_":[{"uid":"0x1","_weight_":4,"path":{"uid":"0x1f",
+		"follow":{"uid":"0x3e9","path":{"uid":"0x3ea","follow":{"uid":"0x3e8"}}}}}]}}`, js)
+}
+
+func TestShortestPathBidirectional(t *testing.T) {
+	query := `
+		{
+			A as shortest(from: 23, to: 24, bidirectional: true) {
+				friend
+			}
+
+			me(func: uid(A)) {
+				name
+			}
+		}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"_path_":[{"uid":"0x17","_weight_":2,
+		"friend":{"uid":"0x1","friend":{"uid":"0x18"}}}],
+		"me":[{"name":"Michonne"},{"name":"Rick Grimes"},{"name":"Glenn Rhee"}]}}`, js)
+
+	query = `
+		{
+			shortest(from: 23, to: 24, bidirectional: true, maxhops: 1) {
+				friend
+			}
+		}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data":{}}`, js)
+
+	query = `
+		{
+			shortest(from: 23, to: 24, bidirectional: true) {
+				friend @facets(weight)
+			}
+		}`
+	_, err := processQuery(context.Background(), t, query)
+	require.ErrorContains(t, err, "Bidirectional shortest path only supports predicates")
+}
+
 func TestShortestPathWithUidVariable(t *testing.T) {
 	query := `
 	{
@@ -20,13 +20,193 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 
 	"github.com/pkg/errors"
 
 	"github.com/dgraph-io/dgraph/v24/algo"
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/types"
 	"github.com/dgraph-io/dgraph/v24/x"
 )
 
+// recursePaths tracks the paths taken by a @recurse block with path: true or a mindepth to
+// reach the nodes.
+type recursePaths struct {
+	// depth is the number of hops from the root every node was first reached at.
+	depth map[uint64]uint64
+	// parent is the node every node was first reached from. The roots have none.
+	parent map[uint64]uint64
+	// cycles holds, for every node, the nodes of its path that the edges leaving it lead
+	// back to.
+	cycles map[uint64][]uint64
+}
+
+func newRecursePaths(roots []uint64) *recursePaths {
+	p := &recursePaths{
+		depth:  make(map[uint64]uint64),
+		parent: make(map[uint64]uint64),
+		cycles: make(map[uint64][]uint64),
+	}
+	for _, uid := range roots {
+		p.depth[uid] = 0
+	}
+	return p
+}
+
+// add records the edges from the node from to the nodes to, found at the given depth.
+func (p *recursePaths) add(from uint64, to []uint64, depth uint64) {
+	for _, uid := range to {
+		if _, ok := p.depth[uid]; !ok {
+			p.depth[uid] = depth
+			p.parent[uid] = from
+			continue
+		}
+		if p.onPath(from, uid) && !slices.Contains(p.cycles[from], uid) {
+			p.cycles[from] = append(p.cycles[from], uid)
+		}
+	}
+}
+
+// onPath returns true if target is on the path to uid, uid included.
+func (p *recursePaths) onPath(uid, target uint64) bool {
+	for {
+		if uid == target {
+			return true
+		}
+		parent, ok := p.parent[uid]
+		if !ok {
+			return false
+		}
+		uid = parent
+	}
+}
+
+// path returns the nodes of the path to uid, from the root.
+func (p *recursePaths) path(uid uint64) []uint64 {
+	path := []uint64{uid}
+	for {
+		parent, ok := p.parent[uid]
+		if !ok {
+			break
+		}
+		path = append(path, parent)
+		uid = parent
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// depths returns the number of hops from the root every node reached was first reached at,
+// the values of RecurseDepthAttr.
+func (p *recursePaths) depths() map[uint64]types.Val {
+	values := make(map[uint64]types.Val, len(p.depth))
+	for uid, depth := range p.depth {
+		values[uid] = types.Val{Tid: types.IntID, Value: int64(depth)}
+	}
+	return values
+}
+
+// edges returns the nodes every node reached leads to through the pseudo-predicate attr:
+// the nodes of its path from the root, in order, for RecursePathAttr and the nodes of that
+// path its edges lead back to for RecurseCycleAttr.
+func (p *recursePaths) edges(attr string) map[uint64][]uint64 {
+	edges := make(map[uint64][]uint64)
+	switch attr {
+	case dql.RecursePathAttr:
+		for uid := range p.depth {
+			edges[uid] = p.path(uid)
+		}
+	case dql.RecurseCycleAttr:
+		for uid, targets := range p.cycles {
+			edges[uid] = targets
+		}
+	}
+	return edges
+}
+
+// fillEdges populates the uid lists of sg, a uid pseudo-predicate of the path tracking, with
+// the nodes every source uid leads to in edges, in their order. Like the nodes of a shortest
+// path, they are returned with their uid, as sg has no children.
+func (sg *SubGraph) fillEdges(edges map[uint64][]uint64) {
+	var dest []uint64
+	for _, uid := range sg.SrcUIDs.Uids {
+		sg.uidMatrix = append(sg.uidMatrix, &pb.List{Uids: slices.Clone(edges[uid])})
+		dest = append(dest, edges[uid]...)
+	}
+	slices.Sort(dest)
+	sg.DestUIDs = &pb.List{Uids: slices.Compact(dest)}
+	sg.List = true
+	sg.Params.Shortest = true
+}
+
+// pruneRecurse removes the nodes reached by sg, a level of a @recurse block, that were first
+// reached at less than minDepth hops from the root, unless some node kept deeper in the
+// tree is reached through them. The DestUIDs, and so the variables, of the level only hold
+// the nodes reached at minDepth hops or more.
+func (sg *SubGraph) pruneRecurse(minDepth uint64, paths *recursePaths) {
+	if dql.IsRecursePathAttr(sg.Attr) {
+		// The nodes of a path are kept whatever their depth.
+		return
+	}
+	for _, child := range sg.Children {
+		child.pruneRecurse(minDepth, paths)
+	}
+	if sg.DestUIDs == nil || len(sg.DestUIDs.Uids) == 0 {
+		return
+	}
+
+	for i, ul := range sg.uidMatrix {
+		var fl []*pb.Facets
+		if i < len(sg.facetsMatrix) {
+			fl = sg.facetsMatrix[i].FacetsList
+		}
+		var kept int
+		for j, uid := range ul.Uids {
+			if paths.depth[uid] < minDepth && !sg.reachesDeeper(uid) {
+				continue
+			}
+			ul.Uids[kept] = uid
+			if len(fl) == len(ul.Uids) {
+				fl[kept] = fl[j]
+			}
+			kept++
+		}
+		if len(fl) == len(ul.Uids) {
+			sg.facetsMatrix[i].FacetsList = fl[:kept]
+		}
+		ul.Uids = ul.Uids[:kept]
+	}
+	// The children hold the DestUIDs as their SrcUIDs, so the list can't be updated in place.
+	// The rows may not be sorted if the level is ordered.
+	var dest []uint64
+	for _, ul := range sg.uidMatrix {
+		for _, uid := range ul.Uids {
+			if paths.depth[uid] >= minDepth {
+				dest = append(dest, uid)
+			}
+		}
+	}
+	slices.Sort(dest)
+	sg.DestUIDs = &pb.List{Uids: slices.Compact(dest)}
+}
+
+// reachesDeeper returns true if the children of sg, a level of a @recurse block, reach
+// some node from uid.
+func (sg *SubGraph) reachesDeeper(uid uint64) bool {
+	for _, child := range sg.Children {
+		if dql.IsRecursePathAttr(child.Attr) {
+			continue
+		}
+		idx := algo.IndexOf(child.SrcUIDs, uid)
+		if idx >= 0 && idx < len(child.uidMatrix) && len(child.uidMatrix[idx].Uids) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
 func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error {
 	// Note: Key format is - "attr|fromUID|toUID"
 	reachMap := make(map[string]struct{})
@@ -61,12 +241,21 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 		return err
 	}
 
+	// The pseudo-predicates of the path tracking are filled once all the levels are
+	// expanded, as the edges leaving the nodes of a level are only found at the next one.
+	args := start.Params.RecurseArgs
+	var paths *recursePaths
+	var pseudo []*SubGraph
+	if args.Path || args.MinDepth > 0 {
+		paths = newRecursePaths(start.DestUIDs.Uids)
+	}
+	if args.Path {
+		exec = splitRecursePseudo(exec, &pseudo)
+	}
+
 	dummy := &SubGraph{}
 	var depth uint64
-	for {
-		if depth >= maxDepth {
-			return nil
-		}
+	for depth < maxDepth {
 		depth++
 
 		// When the maximum depth has been reached, avoid retrieving any facets as
@@ -137,6 +326,9 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 						return true
 					})
 				}
+				if paths != nil {
+					paths.add(fromUID, sg.uidMatrix[mIdx].Uids, depth)
+				}
 			}
 			if len(sg.Params.Order) > 0 || len(sg.Params.FacetsOrder) > 0 {
 				// Can't use merge sort if the UIDs are not sorted.
@@ -168,11 +360,60 @@ func (start *SubGraph) expandRecurse(ctx context.Context, maxDepth uint64) error
 				x.Config.LimitQueryEdge, numEdges)
 		}
 
+		if args.Path {
+			out = splitRecursePseudo(out, &pseudo)
+		}
 		if len(out) == 0 {
-			return nil
+			break
 		}
 		exec = out
 	}
+
+	var depths map[uint64]types.Val
+	edges := make(map[string]map[uint64][]uint64)
+	for _, sg := range pseudo {
+		if sg.Attr == dql.RecurseDepthAttr {
+			if depths == nil {
+				depths = paths.depths()
+			}
+			if err := sg.fillValues(depths); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, ok := edges[sg.Attr]; !ok {
+			edges[sg.Attr] = paths.edges(sg.Attr)
+		}
+		sg.fillEdges(edges[sg.Attr])
+	}
+	if args.MinDepth > 0 {
+		for _, child := range start.Children {
+			child.pruneRecurse(args.MinDepth, paths)
+		}
+		// The roots are at depth 0, they are only kept if some node is reached through them.
+		roots := &pb.List{}
+		for _, uid := range start.DestUIDs.Uids {
+			if start.reachesDeeper(uid) {
+				roots.Uids = append(roots.Uids, uid)
+			}
+		}
+		start.DestUIDs = roots
+	}
+	return nil
+}
+
+// splitRecursePseudo moves the pseudo-predicates of the path tracking from sgs to pseudo and
+// returns the other levels.
+func splitRecursePseudo(sgs []*SubGraph, pseudo *[]*SubGraph) []*SubGraph {
+	out := sgs[:0]
+	for _, sg := range sgs {
+		if dql.IsRecursePathAttr(sg.Attr) {
+			*pseudo = append(*pseudo, sg)
+			continue
+		}
+		out = append(out, sg)
+	}
+	return out
 }
 
 // expandChildren adds child nodes to a SubGraph with no children, expanding them if necessary.
@@ -214,6 +455,9 @@ func recurse(ctx context.Context, sg *SubGraph) error {
 		// or we see reach too many nodes.
 		depth = math.MaxUint64
 	}
+	if sg.Params.RecurseArgs.MinDepth > depth {
+		return errors.Errorf("Mindepth can't be greater than depth for recurse query")
+	}
 
 	for _, child := range sg.Children {
 		if len(child.Children) > 0 {
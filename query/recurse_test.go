@@ -0,0 +1,50 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/types"
+)
+
+func TestRecursePaths(t *testing.T) {
+	// 1 -> 2 -> 3 -> 1, 1 -> 3 and 3 -> 3, level by level.
+	p := newRecursePaths([]uint64{1})
+	p.add(1, []uint64{2, 3}, 1)
+	p.add(2, []uint64{3}, 2)
+	p.add(3, []uint64{1, 3}, 2)
+	p.add(3, []uint64{1}, 3)
+
+	require.Equal(t, map[uint64]uint64{1: 0, 2: 1, 3: 1}, p.depth)
+	require.Equal(t, []uint64{1, 3}, p.path(3))
+	require.Equal(t, []uint64{1}, p.path(1))
+	// 2 -> 3 isn't a cycle, 3 is reached from 1 directly.
+	require.Equal(t, map[uint64][]uint64{3: {1, 3}}, p.cycles)
+
+	require.Equal(t, map[uint64]types.Val{
+		1: {Tid: types.IntID, Value: int64(0)},
+		2: {Tid: types.IntID, Value: int64(1)},
+		3: {Tid: types.IntID, Value: int64(1)},
+	}, p.depths())
+	require.Equal(t, map[uint64][]uint64{1: {1}, 2: {1, 2}, 3: {1, 3}},
+		p.edges(dql.RecursePathAttr))
+	require.Equal(t, map[uint64][]uint64{3: {1, 3}}, p.edges(dql.RecurseCycleAttr))
+}
@@ -105,6 +105,34 @@ type mapItem struct {
 	facet *pb.Facets
 }
 
+// cheapest returns the cheapest of the edges between two nodes, the last one found if there
+// are several.
+func cheapest(edges []mapItem) mapItem {
+	best := edges[0]
+	for _, edge := range edges[1:] {
+		if edge.cost <= best.cost {
+			best = edge
+		}
+	}
+	return best
+}
+
+// edgeAlong returns the edge along the predicate attr among the edges between two nodes.
+func edgeAlong(edges []mapItem, attr string) (mapItem, bool) {
+	for _, edge := range edges {
+		if edge.attr == attr {
+			return edge, true
+		}
+	}
+	return mapItem{}, false
+}
+
+// sameCost tells whether the costs of two paths are equal, up to the rounding errors of
+// summing their weights in different orders.
+func sameCost(a, b float64) bool {
+	return math.Abs(a-b) <= 1e-9*math.Max(1, math.Max(math.Abs(a), math.Abs(b)))
+}
+
 // We manintain a map from UID to nodeInfo for Djikstras.
 type nodeInfo struct {
 	mapItem
@@ -149,8 +177,54 @@ func (sg *SubGraph) getCost(matrix, list int) (cost float64,
 	return cost, fcs, rerr
 }
 
+// cloneFilter returns a copy of the filter f that can be run without changing f, so that
+// it can be run again.
+func cloneFilter(f *SubGraph) *SubGraph {
+	c := new(SubGraph)
+	*c = *f
+	if f.DestUIDs != nil {
+		c.DestUIDs = &pb.List{Uids: append([]uint64(nil), f.DestUIDs.Uids...)}
+	}
+	c.Filters = nil
+	for _, child := range f.Filters {
+		c.Filters = append(c.Filters, cloneFilter(child))
+	}
+	return c
+}
+
+// excludedNodes returns the nodes of uids that don't match the filters of the shortest path
+// block sg, which can't be in a path. The ends of the paths are never excluded.
+func (sg *SubGraph) excludedNodes(ctx context.Context, uids *pb.List) (map[uint64]struct{},
+	error) {
+	if len(sg.Filters) == 0 || len(uids.GetUids()) == 0 {
+		return nil, nil
+	}
+	temp := &SubGraph{
+		ReadTs:   sg.ReadTs,
+		SrcUIDs:  uids,
+		FilterOp: sg.FilterOp,
+		Params:   params{ParentVars: sg.Params.ParentVars},
+	}
+	for _, filter := range sg.Filters {
+		temp.Filters = append(temp.Filters, cloneFilter(filter))
+	}
+	rch := make(chan error, 1)
+	ProcessGraph(ctx, temp, &SubGraph{}, rch)
+	if err := <-rch; err != nil {
+		return nil, err
+	}
+
+	excluded := make(map[uint64]struct{})
+	for _, uid := range algo.Difference(uids, temp.DestUIDs).Uids {
+		if uid != sg.Params.From && uid != sg.Params.To {
+			excluded[uid] = struct{}{}
+		}
+	}
+	return excluded, nil
+}
+
 func (sg *SubGraph) expandOut(ctx context.Context,
-	adjacencyMap map[uint64]map[uint64]mapItem, next chan bool, rch chan error) {
+	adjacencyMap map[uint64]map[uint64][]mapItem, next chan bool, rch chan error) {
 
 	var numEdges uint64
 	var exec []*SubGraph
@@ -188,6 +262,18 @@ func (sg *SubGraph) expandOut(ctx context.Context,
 			}
 		}
 
+		var targets []*pb.List
+		for _, subgraph := range exec {
+			if !subgraph.UnknownAttr {
+				targets = append(targets, subgraph.DestUIDs)
+			}
+		}
+		excluded, ferr := sg.excludedNodes(ctx, algo.MergeSorted(targets))
+		if ferr != nil {
+			rch <- ferr
+			return
+		}
+
 		for _, subgraph := range exec {
 			select {
 			case <-ctx.Done():
@@ -213,7 +299,10 @@ func (sg *SubGraph) expandOut(ctx context.Context,
 
 					for lIdx, toUID := range subgraph.uidMatrix[mIdx].Uids {
 						if adjacencyMap[fromUID] == nil {
-							adjacencyMap[fromUID] = make(map[uint64]mapItem)
+							adjacencyMap[fromUID] = make(map[uint64][]mapItem)
+						}
+						if _, ok := excluded[toUID]; ok {
+							continue
 						}
 						// The default cost we'd use is 1.
 						cost, facet, err := subgraph.getCost(mIdx, lIdx)
@@ -226,13 +315,13 @@ func (sg *SubGraph) expandOut(ctx context.Context,
 							return
 						}
 
-						// TODO - This simplify overrides the adjacency matrix. What happens if the
-						// cost along the second attribute is more than that along the first.
-						adjacencyMap[fromUID][toUID] = mapItem{
+						// The edges along every predicate are kept, the paths can be constrained
+						// to some of them.
+						adjacencyMap[fromUID][toUID] = append(adjacencyMap[fromUID][toUID], mapItem{
 							cost:  cost,
 							facet: facet,
 							attr:  subgraph.Attr,
-						}
+						})
 						numEdges++
 					}
 				}
@@ -263,10 +352,11 @@ func (sg *SubGraph) expandOut(ctx context.Context,
 
 					temp.SrcUIDs = subgraph.DestUIDs
 					// Remove those nodes which we have already traversed. As this cannot be
-					// in the path again.
+					// in the path again. The excluded nodes aren't traversed either.
 					algo.ApplyFilter(temp.SrcUIDs, func(uid uint64, i int) bool {
 						_, ok := adjacencyMap[uid]
-						return !ok
+						_, isExcluded := excluded[uid]
+						return !ok && !isExcluded
 					})
 					subgraph.Children = append(subgraph.Children, temp)
 					out = append(out, temp)
@@ -301,6 +391,10 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	}
 
 	numPaths := sg.Params.NumPaths
+	if numPaths == 0 && !sg.Params.AllPaths {
+		// Return 1 path by default, all the paths of minimum cost with allpaths.
+		numPaths = 1
+	}
 	var kroutes []route
 	pq := make(priorityQueue, 0)
 
@@ -318,6 +412,13 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	if sg.Params.ExploreDepth != nil {
 		maxHops = int(*sg.Params.ExploreDepth)
 	}
+	// The paths can't have more hops than maxPathHops, whatever their weight. There is no
+	// need to explore further.
+	maxPathHops := math.MaxInt32
+	if sg.Params.MaxHops != nil {
+		maxPathHops = int(*sg.Params.MaxHops)
+		maxHops = min(maxHops, maxPathHops)
+	}
 	if maxHops == 0 {
 		return nil, nil
 	}
@@ -326,7 +427,7 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	maxWeight := sg.Params.MaxWeight
 	next := make(chan bool, 2)
 	expandErr := make(chan error, 2)
-	adjacencyMap := make(map[uint64]map[uint64]mapItem)
+	adjacencyMap := make(map[uint64]map[uint64][]mapItem)
 	go sg.expandOut(ctx, adjacencyMap, next, expandErr)
 
 	// In k shortest path we can't have this. We store the path till a node in every
@@ -335,6 +436,11 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	var stopExpansion bool
 	for pq.Len() > 0 {
 		item := heap.Pop(&pq).(*queueItem)
+		if sg.Params.AllPaths && len(kroutes) > 0 && item.cost > kroutes[0].totalWeight &&
+			!sameCost(item.cost, kroutes[0].totalWeight) {
+			// The paths are found by increasing cost, all the paths of minimum cost were.
+			break
+		}
 		if item.uid == sg.Params.To {
 			// Ignore paths that do not meet the minimum weight requirement.
 			if item.cost < minWeight {
@@ -380,8 +486,20 @@ func runKShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 			return nil, ctx.Err()
 		default:
 		}
+		if item.hop >= maxPathHops {
+			pathPool.Put(item.path.route)
+			continue
+		}
 		neighbours := adjacencyMap[item.uid]
-		for toUid, info := range neighbours {
+		for toUid, edges := range neighbours {
+			info := cheapest(edges)
+			if sg.Params.Alternate {
+				// The predicates are followed in turn.
+				var ok bool
+				if info, ok = edgeAlong(edges, sg.Children[item.hop%len(sg.Children)].Attr); !ok {
+					continue
+				}
+			}
 			cost := info.cost
 			// Skip neighbour if the cost is greater than the maximum weight allowed.
 			if item.cost+cost > maxWeight {
@@ -476,7 +594,13 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		numPaths = 1
 	}
 
-	if numPaths > 1 {
+	if sg.Params.Bidirectional {
+		return bidirectionalShortestPaths(ctx, sg)
+	}
+	// Dijkstra's algorithm finds a single path, and the cheapest path to a node isn't always
+	// part of the cheapest path allowed by the constraints on the whole path. The paths are
+	// searched one by one instead.
+	if numPaths > 1 || sg.Params.AllPaths || sg.Params.MaxHops != nil || sg.Params.Alternate {
 		return runKShortestPaths(ctx, sg)
 	}
 	pq := make(priorityQueue, 0)
@@ -501,7 +625,7 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 	// next is a channel on to which we send a signal so as to perform another level of expansion.
 	next := make(chan bool, 2)
 	expandErr := make(chan error, 2)
-	adjacencyMap := make(map[uint64]map[uint64]mapItem)
+	adjacencyMap := make(map[uint64]map[uint64][]mapItem)
 	// TODO - Check if this goroutine actually improves performance. It doesn't look like it
 	// because we need to fill the adjacency map before we can make progress.
 	go sg.expandOut(ctx, adjacencyMap, next, expandErr)
@@ -552,7 +676,8 @@ func shortestPath(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 		}
 
 		neighbours := adjacencyMap[item.uid]
-		for toUID, neighbour := range neighbours {
+		for toUID, edges := range neighbours {
+			neighbour := cheapest(edges)
 			d, ok := dist[toUID]
 			// Cost of reaching this neighbour node from srcNode is item.cost + neighbour.cost
 			nodeCost := item.cost + neighbour.cost
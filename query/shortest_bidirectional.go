@@ -0,0 +1,262 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+// searchSide is one side of a bidirectional shortest path search: the nodes found from one
+// end of the paths, level by level.
+type searchSide struct {
+	// end is the node the side starts from.
+	end uint64
+	// reverse is true if the side follows the edges backwards, from the destination.
+	reverse bool
+	// depth is the number of levels explored.
+	depth int
+	// dist is the number of hops from end to every node found.
+	dist map[uint64]int
+	// parents holds, for every node found, the nodes of the previous level it is connected to
+	// and the predicate connecting them.
+	parents map[uint64][]pathInfo
+	// frontier holds the sorted uids of the nodes of the last level.
+	frontier []uint64
+	// excluded holds the nodes found that don't match the filters of the block.
+	excluded map[uint64]struct{}
+}
+
+func newSearchSide(end uint64, reverse bool) *searchSide {
+	return &searchSide{
+		end:      end,
+		reverse:  reverse,
+		dist:     map[uint64]int{end: 0},
+		parents:  make(map[uint64][]pathInfo),
+		frontier: []uint64{end},
+		excluded: make(map[uint64]struct{}),
+	}
+}
+
+// reverseAttr returns the predicate that follows the edges of attr backwards.
+func reverseAttr(attr string) string {
+	if strings.HasPrefix(attr, "~") {
+		return attr[1:]
+	}
+	return "~" + attr
+}
+
+// expandSide explores the next level of side, following the predicates of the shortest path
+// block sg. It returns the number of edges found.
+func (sg *SubGraph) expandSide(ctx context.Context, side *searchSide) (uint64, error) {
+	var exec []*SubGraph
+	for _, child := range sg.Children {
+		temp := new(SubGraph)
+		temp.copyFiltersRecurse(child)
+		if side.reverse {
+			temp.Attr = reverseAttr(child.Attr)
+		}
+		temp.SrcUIDs = &pb.List{Uids: side.frontier}
+		exec = append(exec, temp)
+	}
+	rch := make(chan error, len(exec))
+	dummy := &SubGraph{}
+	for _, subgraph := range exec {
+		go ProcessGraph(ctx, subgraph, dummy, rch)
+	}
+	for range exec {
+		select {
+		case err := <-rch:
+			if err != nil {
+				return 0, err
+			}
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+
+	var numEdges uint64
+	var next []uint64
+	for i, subgraph := range exec {
+		if subgraph.UnknownAttr {
+			continue
+		}
+		subgraph.updateUidMatrix()
+		for j, from := range subgraph.SrcUIDs.Uids {
+			if j >= len(subgraph.uidMatrix) {
+				continue
+			}
+			for _, to := range subgraph.uidMatrix[j].Uids {
+				numEdges++
+				if _, ok := side.excluded[to]; ok {
+					continue
+				}
+				d, ok := side.dist[to]
+				if ok && d <= side.depth {
+					// The node was reached by a shorter path.
+					continue
+				}
+				if !ok {
+					side.dist[to] = side.depth + 1
+					next = append(next, to)
+				}
+				// The path goes through the edges in the direction of the predicates of the
+				// block, whichever side found them.
+				side.parents[to] = append(side.parents[to],
+					pathInfo{uid: from, attr: sg.Children[i].Attr})
+			}
+		}
+	}
+	sort.Slice(next, func(i, j int) bool { return next[i] < next[j] })
+
+	excluded, err := sg.excludedNodes(ctx, &pb.List{Uids: next})
+	if err != nil {
+		return 0, err
+	}
+	side.frontier = next[:0]
+	for _, uid := range next {
+		if _, ok := excluded[uid]; ok {
+			side.excluded[uid] = struct{}{}
+			delete(side.dist, uid)
+			delete(side.parents, uid)
+			continue
+		}
+		side.frontier = append(side.frontier, uid)
+	}
+	side.depth++
+	return numEdges, nil
+}
+
+// walk calls fn with every path from the node uid back to the end of side, as the steps
+// taken from uid, until fn returns false. The attr of a step is the predicate between its
+// node and the previous one.
+func (side *searchSide) walk(uid uint64, steps []pathInfo, fn func([]pathInfo) bool) bool {
+	if uid == side.end {
+		return fn(steps)
+	}
+	for _, parent := range side.parents[uid] {
+		if !side.walk(parent.uid, append(steps, parent), fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// bidirectionalShortestPaths finds the paths with the fewest hops between the ends of the
+// shortest path block sg by exploring the graph from both ends at once, a level at a time
+// from the end with the fewest nodes to expand. The destination side follows the reverse
+// edges, so the predicates need a @reverse index. The search stops at the first level where
+// the sides meet: every node found on both sides at that level is in the middle of some of
+// the paths, which are all the paths with the fewest hops.
+func bidirectionalShortestPaths(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
+	for _, child := range sg.Children {
+		if child.Params.Facet != nil || len(child.Filters) > 0 {
+			return nil, errors.Errorf("Bidirectional shortest path only supports predicates"+
+				" without facets or filters. Got: %s", child.Attr)
+		}
+	}
+	if sg.Params.Alternate {
+		return nil, errors.Errorf("Bidirectional shortest path doesn't support alternate")
+	}
+
+	maxHops := math.MaxInt32
+	if sg.Params.ExploreDepth != nil {
+		maxHops = int(*sg.Params.ExploreDepth)
+	}
+	if sg.Params.MaxHops != nil {
+		maxHops = min(maxHops, int(*sg.Params.MaxHops))
+	}
+
+	from, to := newSearchSide(sg.Params.From, false), newSearchSide(sg.Params.To, true)
+	var middle []uint64
+	if sg.Params.From == sg.Params.To {
+		middle = append(middle, sg.Params.From)
+	}
+	var numEdges uint64
+	for len(middle) == 0 {
+		if from.depth+to.depth >= maxHops || len(from.frontier) == 0 || len(to.frontier) == 0 {
+			sg.DestUIDs = &pb.List{}
+			return nil, nil
+		}
+		side, other := from, to
+		if len(to.frontier) < len(from.frontier) {
+			side, other = to, from
+		}
+		n, err := sg.expandSide(ctx, side)
+		if err != nil {
+			return nil, err
+		}
+		if numEdges += n; numEdges > x.Config.LimitQueryEdge {
+			return nil, errors.Errorf("Exceeded query edge limit = %v. Found %v edges.",
+				x.Config.LimitQueryEdge, numEdges)
+		}
+		for _, uid := range side.frontier {
+			if _, ok := other.dist[uid]; ok {
+				middle = append(middle, uid)
+			}
+		}
+	}
+
+	// Every edge costs 1.
+	weight := float64(from.depth + to.depth)
+	if weight < sg.Params.MinWeight || weight > sg.Params.MaxWeight {
+		sg.DestUIDs = &pb.List{}
+		return nil, nil
+	}
+	numPaths := sg.Params.NumPaths
+	if numPaths == 0 && !sg.Params.AllPaths {
+		numPaths = 1
+	}
+
+	var kroutes []route
+	for _, mid := range middle {
+		more := from.walk(mid, nil, func(head []pathInfo) bool {
+			return to.walk(mid, nil, func(tail []pathInfo) bool {
+				// head goes from mid back to the source, turn it around.
+				path := make([]pathInfo, 0, len(head)+len(tail)+1)
+				path = append(path, pathInfo{uid: sg.Params.From})
+				for i := len(head) - 1; i >= 0; i-- {
+					uid := mid
+					if i > 0 {
+						uid = head[i-1].uid
+					}
+					path = append(path, pathInfo{uid: uid, attr: head[i].attr})
+				}
+				path = append(path, tail...)
+				kroutes = append(kroutes, route{route: &path, totalWeight: weight})
+				return len(kroutes) != numPaths
+			})
+		})
+		if !more {
+			break
+		}
+	}
+
+	var res []uint64
+	for _, it := range *kroutes[0].route {
+		res = append(res, it.uid)
+	}
+	sg.DestUIDs.Uids = res
+	return createkroutesubgraph(ctx, kroutes), nil
+}
@@ -0,0 +1,67 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchSideWalk(t *testing.T) {
+	// 1 -> 2 -> 4 and 1 -> 3 -> 4, found from 1.
+	side := newSearchSide(1, false)
+	side.parents[2] = []pathInfo{{uid: 1, attr: "a"}}
+	side.parents[3] = []pathInfo{{uid: 1, attr: "b"}}
+	side.parents[4] = []pathInfo{{uid: 2, attr: "c"}, {uid: 3, attr: "d"}}
+
+	var walks [][]pathInfo
+	side.walk(4, nil, func(steps []pathInfo) bool {
+		walks = append(walks, append([]pathInfo(nil), steps...))
+		return true
+	})
+	require.Equal(t, [][]pathInfo{
+		{{uid: 2, attr: "c"}, {uid: 1, attr: "a"}},
+		{{uid: 3, attr: "d"}, {uid: 1, attr: "b"}},
+	}, walks)
+
+	// The walk stops once fn returns false.
+	walks = nil
+	side.walk(4, nil, func(steps []pathInfo) bool {
+		walks = append(walks, steps)
+		return false
+	})
+	require.Len(t, walks, 1)
+}
+
+func TestReverseAttr(t *testing.T) {
+	require.Equal(t, "~friend", reverseAttr("friend"))
+	require.Equal(t, "friend", reverseAttr("~friend"))
+}
+
+func TestShortestPathEdges(t *testing.T) {
+	edges := []mapItem{{attr: "a", cost: 2}, {attr: "b", cost: 1}, {attr: "c", cost: 1}}
+	require.Equal(t, "c", cheapest(edges).attr)
+	edge, ok := edgeAlong(edges, "a")
+	require.True(t, ok)
+	require.Equal(t, 2.0, edge.cost)
+	_, ok = edgeAlong(edges, "d")
+	require.False(t, ok)
+
+	require.True(t, sameCost(0.1+0.2, 0.3))
+	require.False(t, sameCost(0.3, 0.31))
+}
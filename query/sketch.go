@@ -0,0 +1,161 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"math"
+	"math/bits"
+	"sort"
+)
+
+const (
+	// tDigestCompression bounds the number of centroids of a tDigest to a
+	// small multiple of it. Higher values give more accurate quantiles.
+	tDigestCompression = 100
+	// hllPrecision is the number of bits of the hash picking the register of
+	// a hyperLogLog. The standard error of the count is 1.04/sqrt(2^hllPrecision),
+	// about 1.6%.
+	hllPrecision = 12
+)
+
+type centroid struct {
+	mean  float64
+	count float64
+}
+
+// tDigest estimates the quantiles of a stream of values in bounded memory. The
+// values are summarized by centroids, which are kept small close to the
+// extreme quantiles and larger in the middle, so that the estimation is most
+// accurate for quantiles like p99.
+// See https://arxiv.org/abs/1902.04023 for the merging variant implemented here.
+type tDigest struct {
+	centroids []centroid
+	buffer    []float64
+	count     float64
+	min, max  float64
+}
+
+func (d *tDigest) Add(v float64) {
+	if d.count == 0 && len(d.buffer) == 0 {
+		d.min, d.max = v, v
+	}
+	d.min, d.max = math.Min(d.min, v), math.Max(d.max, v)
+	d.buffer = append(d.buffer, v)
+	if len(d.buffer) >= 5*tDigestCompression {
+		d.compress()
+	}
+}
+
+// scale maps the quantile q to the index of the centroid holding it. A
+// centroid may only span a single unit of the scale.
+func (d *tDigest) scale(q float64) float64 {
+	return tDigestCompression / (2 * math.Pi) * math.Asin(2*q-1)
+}
+
+// compress merges the buffered values into the centroids.
+func (d *tDigest) compress() {
+	if len(d.buffer) == 0 {
+		return
+	}
+	all := make([]centroid, 0, len(d.centroids)+len(d.buffer))
+	all = append(all, d.centroids...)
+	for _, v := range d.buffer {
+		all = append(all, centroid{mean: v, count: 1})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+	d.count += float64(len(d.buffer))
+	d.buffer = d.buffer[:0]
+
+	merged := all[:1]
+	var before float64
+	for _, c := range all[1:] {
+		cur := &merged[len(merged)-1]
+		if d.scale((before+cur.count+c.count)/d.count)-d.scale(before/d.count) <= 1 {
+			cur.count += c.count
+			cur.mean += (c.mean - cur.mean) * c.count / cur.count
+			continue
+		}
+		before += cur.count
+		merged = append(merged, c)
+	}
+	d.centroids = append(d.centroids[:0:0], merged...)
+}
+
+// Quantile returns the estimation of the q quantile of the values added, with
+// q between 0 and 1. It returns NaN if no value was added.
+func (d *tDigest) Quantile(q float64) float64 {
+	d.compress()
+	if d.count == 0 {
+		return math.NaN()
+	}
+	// Every centroid is placed at the middle of the values it holds, and the
+	// quantile is interpolated between the two centroids around it. The
+	// minimum and the maximum bound the first and last centroids.
+	target := q * d.count
+	prevMean, prevCenter := d.min, 0.0
+	var before float64
+	for _, c := range d.centroids {
+		center := before + c.count/2
+		if target < center {
+			return prevMean + (target-prevCenter)/(center-prevCenter)*(c.mean-prevMean)
+		}
+		prevMean, prevCenter = c.mean, center
+		before += c.count
+	}
+	if d.count == prevCenter {
+		return d.max
+	}
+	return prevMean + (target-prevCenter)/(d.count-prevCenter)*(d.max-prevMean)
+}
+
+// hyperLogLog estimates the number of distinct hashes added to it, in memory
+// independent of that number.
+// See http://algo.inria.fr/flajolet/Publications/FlFuGaMe07.pdf.
+type hyperLogLog struct {
+	registers []uint8
+}
+
+func newHyperLogLog() *hyperLogLog {
+	return &hyperLogLog{registers: make([]uint8, 1<<hllPrecision)}
+}
+
+func (h *hyperLogLog) Add(hash uint64) {
+	idx := hash >> (64 - hllPrecision)
+	// The guard bit bounds the rank when the remaining bits are all zero.
+	rank := uint8(bits.LeadingZeros64(hash<<hllPrecision|1<<(hllPrecision-1))) + 1
+	if rank > h.registers[idx] {
+		h.registers[idx] = rank
+	}
+}
+
+func (h *hyperLogLog) Count() uint64 {
+	m := float64(len(h.registers))
+	var sum float64
+	var zeros int
+	for _, r := range h.registers {
+		sum += math.Ldexp(1, -int(r))
+		if r == 0 {
+			zeros++
+		}
+	}
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// Linear counting is more accurate for small cardinalities.
+		estimate = m * math.Log(m/float64(zeros))
+	}
+	return uint64(estimate + 0.5)
+}
@@ -0,0 +1,226 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v24/algo"
+	"github.com/dgraph-io/dgraph/v24/dql"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+)
+
+// StreamJson encodes the results of sgl like ToJson, but sends them in chunks as soon as
+// they are encoded, so that the JSON of the whole response is never held at once. Every
+// chunk is a JSON object holding up to batchSize root-level results of a query block, like
+// {"q": [...]}. The blocks without root-level results, like aggregations or @groupby, are
+// sent in a single chunk.
+//
+// The children of the blocks processed with Request.Stream are processed here, one batch
+// of root uids at a time, and released once the batch is sent, so only the results of a
+// batch are held in memory. Their metrics are added to metrics. The results of the other
+// blocks were processed beforehand, only their encoding is done by batch.
+//
+// send is called with the chunks in order. It must not keep a chunk after returning, its
+// memory is reused. Blocking in send pauses the processing.
+func StreamJson(ctx context.Context, l *Latency, sgl []*SubGraph, batchSize int,
+	metrics map[string]uint64, send func([]byte) error) error {
+	encodingStart := time.Now()
+	defer func() {
+		l.Json = time.Since(encodingStart)
+	}()
+
+	for _, sg := range sgl {
+		if sg.Params.Alias == "var" || sg.Params.Alias == "shortest" ||
+			dql.IsGraphAlgorithm(sg.Params.Alias) {
+			continue
+		}
+		if err := sg.streamJson(ctx, batchSize, metrics, send); err != nil {
+			return errors.Wrapf(err, "while streaming the results of %s", sg.Params.Alias)
+		}
+	}
+	return nil
+}
+
+// streamJson sends the results of the query block sg in chunks of batchSize root-level
+// results, each encoded with its own encoder.
+func (sg *SubGraph) streamJson(ctx context.Context, batchSize int, metrics map[string]uint64,
+	send func([]byte) error) error {
+	var enc *encoder
+	var root fastJsonNode
+	release := func() {
+		if enc != nil {
+			// Put encoder's arena back to arena pool.
+			arenaPool.Put(enc.arena)
+			enc.alloc.Release()
+			enc = nil
+		}
+	}
+	defer release()
+	start := func() {
+		enc = newEncoder()
+		root = enc.newNode(enc.idForAttr("_root_"))
+	}
+	flush := func() error {
+		enc.fixOrder(root)
+		if err := sg.toDqlJSON(enc, root); err != nil {
+			return err
+		}
+		// Return error if encoded buffer size exceeds than a threshold size.
+		if uint64(enc.buf.Len()) > maxEncodedSize {
+			return fmt.Errorf("while writing to buffer. Encoded response size: %d"+
+				" is bigger than threshold: %d", enc.buf.Len(), maxEncodedSize)
+		}
+		err := send(enc.buf.Bytes())
+		release()
+		return err
+	}
+
+	start()
+	if sg.Params.IsEmpty || sg.uidMatrix == nil || sg.Params.IsGroupBy {
+		if err := processNodeUids(root, enc, sg); err != nil {
+			return err
+		}
+		return flush()
+	}
+
+	hasChild, err := sg.handleCountUIDNodes(enc, root, len(sg.DestUIDs.Uids))
+	if err != nil {
+		return err
+	}
+	// The batches hold the root uids in the order of the results, the uids which were
+	// filtered out are skipped.
+	var batches [][]uint64
+	var batch []uint64
+	for _, uid := range sg.uidMatrix[0].Uids {
+		if algo.IndexOf(sg.DestUIDs, uid) < 0 {
+			continue
+		}
+		if batch = append(batch, uid); len(batch) == batchSize {
+			batches = append(batches, batch)
+			batch = nil
+		}
+	}
+	if len(batch) > 0 {
+		batches = append(batches, batch)
+	}
+
+	for _, batch := range batches {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if enc == nil {
+			start()
+		}
+		if err := sg.addBatch(ctx, enc, root, batch, metrics, &hasChild); err != nil {
+			return err
+		}
+		if enc.children(root) == nil {
+			// Only the empty nodes were in the batch.
+			continue
+		}
+		if err := flush(); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case enc == nil:
+		// The last chunk was full, everything was sent.
+		return nil
+	case !hasChild:
+		// So that we return an empty key if the root didn't have any children.
+		enc.AddListChild(root, enc.newNode(enc.idForAttr(sg.Params.Alias)))
+	case enc.children(root) == nil:
+		// Only the empty nodes are left.
+		return nil
+	}
+	return flush()
+}
+
+// addBatch adds the results of the root uids of batch to root, processing the children of
+// sg for them first if sg is streamed. hasChild is set if any of them was added.
+func (sg *SubGraph) addBatch(ctx context.Context, enc *encoder, root fastJsonNode,
+	batch []uint64, metrics map[string]uint64, hasChild *bool) error {
+	if sg.streamed {
+		children, err := sg.processBatch(ctx, batch)
+		if err != nil {
+			return err
+		}
+		for _, child := range children {
+			calculateMetrics(child, metrics)
+		}
+		// The results of the batch are dropped once they are encoded.
+		templates := sg.Children
+		sg.Children = children
+		defer func() {
+			sg.Children = templates
+		}()
+	}
+
+	attr := enc.idForAttr(sg.Params.Alias)
+	for _, uid := range batch {
+		added, err := sg.addRootNode(enc, root, attr, uid)
+		if err != nil {
+			return err
+		}
+		if added {
+			*hasChild = true
+		}
+	}
+	return nil
+}
+
+// processBatch processes copies of the children of the streamed block sg for the root uids
+// of batch, like processGraph does for all of them. The children of sg are left as they
+// are, to be copied for the next batch.
+func (sg *SubGraph) processBatch(ctx context.Context, batch []uint64) ([]*SubGraph, error) {
+	srcUIDs := &pb.List{Uids: append([]uint64(nil), batch...)}
+	sort.Slice(srcUIDs.Uids, func(i, j int) bool { return srcUIDs.Uids[i] < srcUIDs.Uids[j] })
+
+	children := make([]*SubGraph, 0, len(sg.Children))
+	childChan := make(chan error, len(sg.Children))
+	var running int
+	for _, template := range sg.Children {
+		child := new(SubGraph)
+		recursiveCopy(child, template)
+		child.Params.ParentVars = make(map[string]varValue)
+		for k, v := range sg.Params.ParentVars {
+			child.Params.ParentVars[k] = v
+		}
+		child.SrcUIDs = srcUIDs
+		children = append(children, child)
+		if child.IsInternal() {
+			continue
+		}
+		running++
+		go ProcessGraph(ctx, child, sg, childChan)
+	}
+
+	var childErr error
+	for ; running > 0; running-- {
+		if err := <-childChan; err != nil {
+			childErr = err
+		}
+	}
+	return children, childErr
+}
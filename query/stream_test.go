@@ -0,0 +1,77 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package query
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+)
+
+func streamChunks(t *testing.T, sgl ...*SubGraph) []string {
+	var chunks []string
+	require.NoError(t, StreamJson(context.Background(), &Latency{}, sgl, 2, nil, func(js []byte) error {
+		chunks = append(chunks, string(js))
+		return nil
+	}))
+	return chunks
+}
+
+func TestStreamJson(t *testing.T) {
+	uids := &pb.List{Uids: []uint64{1, 2, 3, 4, 5}}
+	block := func(alias string, dest *pb.List) *SubGraph {
+		child := &SubGraph{Attr: "uid", SrcUIDs: uids}
+		for range uids.Uids {
+			child.uidMatrix = append(child.uidMatrix, &pb.List{})
+		}
+		return &SubGraph{
+			Params:    params{Alias: alias},
+			uidMatrix: []*pb.List{uids},
+			DestUIDs:  dest,
+			Children:  []*SubGraph{child},
+		}
+	}
+
+	// The uid 4 was filtered out.
+	require.Equal(t, []string{
+		`{"q":[{"uid":"0x1"},{"uid":"0x2"}]}`,
+		`{"q":[{"uid":"0x3"},{"uid":"0x5"}]}`,
+		`{"empty":[]}`,
+	}, streamChunks(t,
+		block("q", &pb.List{Uids: []uint64{1, 2, 3, 5}}),
+		&SubGraph{Params: params{Alias: "var"}},
+		block("empty", &pb.List{})))
+
+	require.Equal(t, []string{
+		`{"q":[{"uid":"0x1"},{"uid":"0x2"}]}`,
+		`{"q":[{"uid":"0x3"},{"uid":"0x4"}]}`,
+		`{"q":[{"uid":"0x5"}]}`,
+	}, streamChunks(t, block("q", uids)))
+
+	// A stopped stream stops the encoding.
+	var sent int
+	err := StreamJson(context.Background(), &Latency{}, []*SubGraph{block("q", uids)}, 2, nil,
+		func(js []byte) error {
+			sent++
+			return context.Canceled
+		})
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, sent)
+}
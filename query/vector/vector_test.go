@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -30,6 +31,7 @@ import (
 	"github.com/dgraph-io/dgo/v230/protos/api"
 	"github.com/dgraph-io/dgraph/v24/dgraphapi"
 	"github.com/dgraph-io/dgraph/v24/dgraphtest"
+	"github.com/dgraph-io/dgraph/v24/testutil"
 	"github.com/dgraph-io/dgraph/v24/x"
 	"github.com/stretchr/testify/require"
 )
@@ -791,3 +793,463 @@ func TestGetVector(t *testing.T) {
 	  }`
 	require.JSONEq(t, k, js)
 }
+
+func TestVectorDistance(t *testing.T) {
+	pred := "vdist"
+	dropPredicate(pred)
+	setSchema(fmt.Sprintf(vectorSchemaWithIndex, pred, "4", "euclidian"))
+
+	rdf := `<0x1> <vdist> "[1.0, 0.0]" .
+	<0x2> <vdist> "[3.0, 4.0]" .
+	<0x3> <vdist> "[0.0, 0.0]" .`
+	require.NoError(t, addTriplesToCluster(rdf))
+
+	query := `{
+		var(func: similar_to(vdist, 3, "[0.0, 0.0]")) {
+			d as _distance_
+		}
+		vector(func: uid(d), orderasc: val(d)) {
+			uid
+			distance: val(d)
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [
+		{"uid": "0x3", "distance": 0},
+		{"uid": "0x1", "distance": 1},
+		{"uid": "0x2", "distance": 5}
+	]}}`, js)
+
+	query = `{
+		vector(func: uid(0x1, 0x2, 0x3)) @filter(similar_to(vdist, 1, "[0.75, 0.0]")) {
+			uid
+			_distance_
+		}
+	}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x1", "_distance_": 0.25}]}}`, js)
+}
+
+func TestVectorFilteredSearch(t *testing.T) {
+	pred := "vfilter"
+	dropPredicate(pred)
+	dropPredicate("vfcategory")
+	dropPredicate("vfowner")
+	dropPredicate("vfrank")
+	setSchema(fmt.Sprintf(vectorSchemaWithIndex, pred, "4", "euclidian") + `
+		vfcategory: string @index(exact) .
+		vfowner: uid @reverse .
+		vfrank: int .
+		type VFItem {
+			vfilter
+			vfcategory
+		}`)
+
+	// The nodes closest to the origin are in category "a", so a search for
+	// the 2 nearest neighbors only finds nodes of category "b" if the filter
+	// is taken into account during the search.
+	rdf := `<0x1> <vfilter> "[0.0, 1.0]" .
+	<0x1> <vfcategory> "a" .
+	<0x2> <vfilter> "[0.0, 2.0]" .
+	<0x2> <vfcategory> "a" .
+	<0x3> <vfilter> "[0.0, 3.0]" .
+	<0x3> <vfcategory> "a" .
+	<0x4> <vfilter> "[0.0, 4.0]" .
+	<0x4> <vfcategory> "b" .
+	<0x5> <vfilter> "[0.0, 5.0]" .
+	<0x5> <vfcategory> "b" .
+	<0x5> <dgraph.type> "VFItem" .
+	<0x6> <vfilter> "[0.0, 6.0]" .
+	<0x6> <vfcategory> "b" .
+	<0x6> <dgraph.type> "VFItem" .
+	<0x3> <vfowner> <0x7> .
+	<0x6> <vfowner> <0x7> .
+	<0x1> <vfrank> "1" .
+	<0x4> <vfrank> "4" .
+	<0x6> <vfrank> "6" .`
+	require.NoError(t, addTriplesToCluster(rdf))
+
+	query := `{
+		vector(func: similar_to(vfilter, 2, "[0.0, 0.0]")) @filter(eq(vfcategory, "b")) {
+			uid
+		}
+	}`
+	js := processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x4"}, {"uid": "0x5"}]}}`, js)
+
+	query = `{
+		vector(func: similar_to(vfilter, 1, "[0.0, 0.0]")) @filter(type(VFItem)) {
+			uid
+			_distance_
+		}
+	}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x5", "_distance_": 5}]}}`, js)
+
+	query = `{
+		vector(func: similar_to(vfilter, 2, "[0.0, 0.0]")) @filter(uid_in(vfowner, 0x7)) {
+			uid
+		}
+	}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x3"}, {"uid": "0x6"}]}}`, js)
+
+	// None of the nearest neighbors the index returns first pass the filters, so the search
+	// is restricted to the uids that can pass them.
+	query = `{
+		vector(func: similar_to(vfilter, 1, "[0.0, 0.0]"))
+			@filter(uid_in(vfowner, 0x7) AND eq(vfcategory, "b")) {
+			uid
+			_distance_
+		}
+	}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x6", "_distance_": 6}]}}`, js)
+
+	query = `{
+		var(func: uid(0x2)) {
+			picked as uid
+		}
+		vector(func: similar_to(vfilter, 2, "[0.0, 0.0]"))
+			@filter(uid(picked) OR (uid_in(vfowner, 0x7) AND NOT eq(vfcategory, "a"))) {
+			uid
+		}
+	}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x2"}, {"uid": "0x6"}]}}`, js)
+
+	// Filters which can't be evaluated through an index are checked by the
+	// search for every node it comes across.
+	query = `{
+		vector(func: similar_to(vfilter, 2, "[0.0, 0.0]")) @filter(gt(vfrank, 2)) {
+			uid
+		}
+	}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x4"}, {"uid": "0x6"}]}}`, js)
+
+	query = `{
+		vector(func: similar_to(vfilter, 2, "[0.0, 0.0]"))
+			@filter(NOT eq(vfcategory, "a") AND NOT has(vfrank)) {
+			uid
+		}
+	}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x5"}]}}`, js)
+
+	query = `{
+		var(func: uid(0x1, 0x4, 0x6)) {
+			r as vfrank
+		}
+		vector(func: similar_to(vfilter, 1, "[0.0, 0.0]")) @filter(ge(val(r), 4)) {
+			uid
+		}
+	}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x4"}]}}`, js)
+
+	// Used as a filter, similar_to only considers the uids being filtered.
+	query = `{
+		vector(func: uid(0x5, 0x6)) @filter(similar_to(vfilter, 1, "[0.0, 0.0]")) {
+			uid
+		}
+	}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x5"}]}}`, js)
+}
+
+func TestVectorIVF(t *testing.T) {
+	pred := "vivf"
+	dropPredicate(pred)
+	setSchema(fmt.Sprintf(vectorSchemaWithIndex, pred, "4", "euclidian"))
+
+	rdf := `<0x1> <vivf> "[0.0, 1.0]" .
+	<0x2> <vivf> "[0.0, 2.0]" .
+	<0x3> <vivf> "[0.0, 3.0]" .
+	<0x4> <vivf> "[10.0, 0.0]" .
+	<0x5> <vivf> "[11.0, 0.0]" .
+	<0x6> <vivf> "[12.0, 0.0]" .`
+	require.NoError(t, addTriplesToCluster(rdf))
+
+	// Switching the index to IVF rebuilds it from the existing vectors. As
+	// it holds trainingSize vectors, the leader trains the codebook in the
+	// background.
+	setSchema(pred + `: float32vector @index(ivf(nlist: "2", nprobe: "1", trainingSize: "4")) .`)
+
+	query := `{
+		vector(func: similar_to(vivf, 2, "[0.0, 0.0]")) {
+			uid
+			_distance_
+		}
+	}`
+	trained := `{"data": {"vector": [{"uid": "0x1", "_distance_": 1}, {"uid": "0x2", "_distance_": 2}]}}`
+	var js string
+	require.Eventually(t, func() bool {
+		js = processQueryNoErr(t, query)
+		return testutil.EqualJSON(t, trained, js, "", true)
+	}, 30*time.Second, time.Second, "the IVF index wasn't trained: %s", js)
+
+	// Vectors inserted after the index was trained are assigned to the
+	// closest cluster, and updated ones move to their new cluster.
+	require.NoError(t, addTriplesToCluster(`<0x7> <vivf> "[0.0, 0.5]" .
+	<0x1> <vivf> "[13.0, 0.0]" .`))
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x7", "_distance_": 0.5}, {"uid": "0x2", "_distance_": 2}]}}`, js)
+
+	query = `{
+		vector(func: similar_to(vivf, 2, "[13.0, 0.0]")) {
+			uid
+		}
+	}`
+	js = processQueryNoErr(t, query)
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x1"}, {"uid": "0x6"}]}}`, js)
+}
+
+func TestVectorUpdateAndDeleteRepairIndex(t *testing.T) {
+	pred := "vrepair"
+	dropPredicate(pred)
+	setSchema(fmt.Sprintf(vectorSchemaWithIndex, pred, "4", "euclidian"))
+
+	var rdf strings.Builder
+	for i := 1; i <= 25; i++ {
+		rdf.WriteString(fmt.Sprintf("<0x%x> <vrepair> \"[%d.0, %d.0]\" .\n", i, (i-1)%5, (i-1)/5))
+	}
+	require.NoError(t, addTriplesToCluster(rdf.String()))
+
+	nearest := func(vec string) string {
+		return processQueryNoErr(t, fmt.Sprintf(`{
+			vector(func: similar_to(vrepair, 1, "%s")) {
+				uid
+			}
+		}`, vec))
+	}
+
+	// Overwriting a vector moves the node in the index.
+	require.NoError(t, addTriplesToCluster(`<0x7> <vrepair> "[3.6, 3.6]" .`))
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x7"}]}}`, nearest("[3.6, 3.6]"))
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0x7"}]}}`, nearest("[3.5, 3.5]"))
+
+	// A deleted node is never returned, and is found again once re-added.
+	deleteTriplesInCluster(`<0xd> <vrepair> "[2.0, 2.0]" .`)
+	require.NotContains(t, nearest("[2.0, 2.0]"), `"0xd"`)
+	require.NoError(t, addTriplesToCluster(`<0xd> <vrepair> "[2.0, 2.0]" .`))
+	require.JSONEq(t, `{"data": {"vector": [{"uid": "0xd"}]}}`, nearest("[2.0, 2.0]"))
+}
+
+func TestVectorHybridSearch(t *testing.T) {
+	dropPredicate("vhybrid")
+	dropPredicate("vhybrid_text")
+	setSchema(fmt.Sprintf(vectorSchemaWithIndex, "vhybrid", "4", "euclidian"))
+	setSchema(`vhybrid_text: string @index(fulltext) .`)
+
+	rdf := `<0x1> <vhybrid> "[0.0, 0.0]" .
+	<0x2> <vhybrid> "[1.0, 0.0]" .
+	<0x3> <vhybrid> "[5.0, 5.0]" .
+	<0x4> <vhybrid> "[0.0, 2.0]" .
+	<0x5> <vhybrid> "[9.0, 9.0]" .
+	<0x1> <vhybrid_text> "red shoes" .
+	<0x2> <vhybrid_text> "blue shoes" .
+	<0x3> <vhybrid_text> "red red shoes for running" .
+	<0x4> <vhybrid_text> "green hat" .
+	<0x5> <vhybrid_text> "red scarf" .`
+	require.NoError(t, addTriplesToCluster(rdf))
+
+	hybrid := func(args string) string {
+		return processQueryNoErr(t, fmt.Sprintf(`{
+			var(func: hybrid_search(vhybrid, 3, %s)) {
+				s as _score_
+			}
+			hybrid(func: uid(s), orderdesc: val(s)) {
+				uid
+			}
+		}`, args))
+	}
+
+	// 0x1 ranks first in both rankings, 0x2 second by vector and third by
+	// text, 0x3 is only a strong text match and 0x4 only a close vector.
+	require.JSONEq(t, `{"data": {"hybrid": [{"uid": "0x1"}, {"uid": "0x2"}, {"uid": "0x3"}]}}`,
+		hybrid(`"[0.0, 0.0]", vhybrid_text, "red shoes"`))
+	require.JSONEq(t, `{"data": {"hybrid": [{"uid": "0x3"}, {"uid": "0x1"}, {"uid": "0x5"}]}}`,
+		hybrid(`"[5.0, 5.0]", vhybrid_text, "red shoes", "weighted", "0.5"`))
+	// Only 0x1 and 0x3 contain all the terms, so 0x2 gets no text rank.
+	require.JSONEq(t, `{"data": {"hybrid": [{"uid": "0x3"}, {"uid": "0x1"}, {"uid": "0x4"}]}}`,
+		hybrid(`"[4.0, 4.0]", vhybrid_text, "red shoes", "alloftext"`))
+
+	// The scores and the distances of the nodes are exposed, and filters apply.
+	js := processQueryNoErr(t, `{
+		hybrid(func: hybrid_search(vhybrid, 3, "[0.0, 0.0]", vhybrid_text, "red shoes")) @filter(anyoftext(vhybrid_text, "blue")) {
+			uid
+			_score_
+			_distance_
+		}
+	}`)
+	require.JSONEq(t, fmt.Sprintf(`{"data": {"hybrid": [{"uid": "0x2", "_score_": %v, "_distance_": 1}]}}`,
+		1.0/61+1.0/63), js)
+
+	_, err := processQuery(context.Background(), t, `{
+		hybrid(func: hybrid_search(vhybrid, 3, "[0.0, 0.0]", vhybrid_text, "red shoes", "0.3")) {
+			uid
+		}
+	}`)
+	require.ErrorContains(t, err, "only accepts a weight with")
+}
+
+func TestVectorSearchOptions(t *testing.T) {
+	pred := "vopts"
+	dropPredicate(pred)
+	setSchema(fmt.Sprintf(vectorSchemaWithIndex, pred, "4", "euclidian"))
+
+	rdf, vectors := generateRandomVectors(100, 8, pred)
+	require.NoError(t, addTriplesToCluster(rdf))
+
+	query := generateRandomVector(8)
+	uids := func(options string) []string {
+		js := processQueryNoErr(t, fmt.Sprintf(`{
+			vector(func: similar_to(%s, 5, "%v", %s)) {
+				uid
+			}
+		}`, pred, query, options))
+		var res struct {
+			Data struct {
+				Vector []struct {
+					UID string `json:"uid"`
+				} `json:"vector"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(js), &res))
+		var uids []string
+		for _, v := range res.Data.Vector {
+			uids = append(uids, v.UID)
+		}
+		return uids
+	}
+
+	// An exact search returns the true nearest neighbors.
+	dist := func(i int) float32 {
+		var d float32
+		for j := range query {
+			d += (vectors[i][j] - query[j]) * (vectors[i][j] - query[j])
+		}
+		return d
+	}
+	nearest := make([]int, len(vectors))
+	for i := range nearest {
+		nearest[i] = i
+	}
+	sort.Slice(nearest, func(i, j int) bool { return dist(nearest[i]) < dist(nearest[j]) })
+	nearest = nearest[:5]
+	sort.Ints(nearest)
+	var expected []string
+	for _, i := range nearest {
+		expected = append(expected, fmt.Sprintf("0x%x", i+10))
+	}
+	require.Equal(t, expected, uids("exact: true"))
+
+	// A larger ef still returns k results.
+	require.Len(t, uids("ef: 200"), 5)
+	require.Len(t, uids(`"ef: 200", exact: false`), 5)
+
+	for options, msg := range map[string]string{
+		"ef: 0":      "must be positive",
+		"nprobe: 4":  "Unknown option",
+		"exact: yes": "Invalid value",
+	} {
+		_, err := processQuery(context.Background(), t, fmt.Sprintf(`{
+			vector(func: similar_to(%s, 5, "%v", %s)) {
+				uid
+			}
+		}`, pred, query, options))
+		require.ErrorContains(t, err, msg)
+	}
+
+	// For IVF indexes, ef sets the number of clusters probed, probing all of them is exact.
+	setSchema(pred + `: float32vector @index(ivf(nlist: "2", nprobe: "1", trainingSize: "4")) .`)
+	require.Equal(t, expected, uids("ef: 2"))
+	require.Equal(t, expected, uids("exact: true"))
+}
+
+func TestQuantizedVectors(t *testing.T) {
+	pred := "vint8"
+	dropPredicate(pred)
+	setSchema(pred + `: int8vector @index(hnsw(metric: "euclidian")) .`)
+	require.NoError(t, addTriplesToCluster(fmt.Sprintf(`
+		<0x10> <%[1]s> "[127, 0, -128]" .
+		<0x11> <%[1]s> "[0, 64, 0]" .
+		<0x12> <%[1]s> "[-100, -100, 100]" .`, pred)))
+	js := processQueryNoErr(t, fmt.Sprintf(`{
+		vector(func: similar_to(%s, 1, "[100, 0, -100]")) {
+			uid
+			%[1]s
+		}
+	}`, pred))
+	require.JSONEq(t, fmt.Sprintf(`{"data": {"vector": [{"uid": "0x10", "%s": [127, 0, -128]}]}}`, pred), js)
+
+	_, err := processQuery(context.Background(), t, fmt.Sprintf(`{
+		vector(func: similar_to(%s, 1, "[0.5, 0, 0]")) {
+			uid
+		}
+	}`, pred))
+	require.ErrorContains(t, err, "invalid value 0.5 for int8 vector")
+	require.Error(t, addTriplesToCluster(fmt.Sprintf(`<0x13> <%s> "[300, 0, 0]" .`, pred)))
+
+	pred = "vbinary"
+	dropPredicate(pred)
+	setSchema(pred + `: binaryvector @index(hnsw(metric: "hamming")) .`)
+	require.NoError(t, addTriplesToCluster(fmt.Sprintf(`
+		<0x10> <%[1]s> "[1, 1, 1, 1, 0, 0, 0, 0]" .
+		<0x11> <%[1]s> "[0, 0, 0, 0, 1, 1, 1, 1]" .
+		<0x12> <%[1]s> "[1, 0, 1, 0, 1, 0, 1, 0]" .`, pred)))
+	js = processQueryNoErr(t, fmt.Sprintf(`{
+		vector(func: similar_to(%s, 1, "[0, 0, 0, 1, 1, 1, 1, 1]")) {
+			uid
+			%[1]s
+		}
+	}`, pred))
+	require.JSONEq(t, fmt.Sprintf(`{"data": {"vector": [{"uid": "0x11", "%s": [0, 0, 0, 0, 1, 1, 1, 1]}]}}`,
+		pred), js)
+	require.Error(t, addTriplesToCluster(fmt.Sprintf(`<0x13> <%s> "[1, 0, 1]" .`, pred)))
+}
+
+func TestMultiVectorMaxSim(t *testing.T) {
+	pred := "vmulti"
+	dropPredicate(pred)
+	setSchema(pred + `: [float32vector] @index(hnsw(metric: "dotproduct")) .`)
+	// 0x11 holds a close match of each query vector, 0x10 only the best match
+	// of the first one, and 0x12 a single vector close to both.
+	require.NoError(t, addTriplesToCluster(fmt.Sprintf(`
+		<0x10> <%[1]s> "[1, 0, 0]" .
+		<0x10> <%[1]s> "[0, 0, 1]" .
+		<0x11> <%[1]s> "[0.9, 0, 0]" .
+		<0x11> <%[1]s> "[0, 0.9, 0]" .
+		<0x12> <%[1]s> "[0.6, 0.6, 0]" .`, pred)))
+
+	uids := func(args string) []string {
+		js := processQueryNoErr(t, fmt.Sprintf(`{
+			vector(func: similar_to(%s, 1, %s)) {
+				uid
+			}
+		}`, pred, args))
+		var res struct {
+			Data struct {
+				Vector []struct {
+					UID string `json:"uid"`
+				} `json:"vector"`
+			} `json:"data"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(js), &res))
+		var uids []string
+		for _, v := range res.Data.Vector {
+			uids = append(uids, v.UID)
+		}
+		return uids
+	}
+	require.Equal(t, []string{"0x11"}, uids(`"[[1, 0, 0], [0, 1, 0]]"`))
+	require.Equal(t, []string{"0x11"}, uids(`"[[1, 0, 0], [0, 1, 0]]", exact: true`))
+	require.Equal(t, []string{"0x10"}, uids(`"[1, 0, 0]"`))
+	// A node can be the query, with all its vectors.
+	require.Equal(t, []string{"0x11"}, uids("0x11"))
+
+	// Deleting a vector of a node removes it from the scores of the node.
+	deleteTriplesInCluster(fmt.Sprintf(`<0x11> <%s> "[0, 0.9, 0]" .`, pred))
+	require.Equal(t, []string{"0x12"}, uids(`"[[1, 0, 0], [0, 1, 0]]"`))
+}
@@ -18,8 +18,10 @@ package schema
 
 import (
 	"math"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -75,7 +77,73 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 	case "upsert":
 		schema.Upsert = true
 	case "unique":
-		schema.Unique = true
+		uniqueWith, err := parseUniqueDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		if len(uniqueWith) > 0 {
+			schema.UniqueWith = uniqueWith
+		} else {
+			schema.Unique = true
+		}
+	case "composite":
+		compositeWith, err := parseCompositeDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.CompositeWith = compositeWith
+	case "min", "max":
+		if t != types.IntID && t != types.FloatID && t != types.DateTimeID {
+			return next.Errorf("@%s directive can only be specified for int, float or datetime"+
+				" type. Got: [%v] for attr: [%v]", next.Val, t.Name(), x.ParseAttr(schema.Predicate))
+		}
+		vals, err := parseConstraintValues(it, schema.Predicate, next.Val, t)
+		if err != nil {
+			return err
+		}
+		if len(vals) != 1 {
+			return next.Errorf("@%s directive takes a single value for attr: [%v]",
+				next.Val, x.ParseAttr(schema.Predicate))
+		}
+		if next.Val == "min" {
+			schema.Min = vals[0]
+		} else {
+			schema.Max = vals[0]
+		}
+	case "regex":
+		if t != types.StringID {
+			return next.Errorf("@regex directive can only be specified for string type."+
+				" Got: [%v] for attr: [%v]", t.Name(), x.ParseAttr(schema.Predicate))
+		}
+		vals, err := parseConstraintValues(it, schema.Predicate, next.Val, t)
+		if err != nil {
+			return err
+		}
+		if len(vals) != 1 {
+			return next.Errorf("@regex directive takes a single pattern for attr: [%v]",
+				x.ParseAttr(schema.Predicate))
+		}
+		if _, err := regexp.Compile(vals[0]); err != nil {
+			return next.Errorf("Invalid pattern in @regex for attr: [%v]: %v",
+				x.ParseAttr(schema.Predicate), err)
+		}
+		schema.Regex = vals[0]
+	case "enum":
+		if t != types.StringID && t != types.IntID && t != types.FloatID {
+			return next.Errorf("@enum directive can only be specified for string, int or float"+
+				" type. Got: [%v] for attr: [%v]", t.Name(), x.ParseAttr(schema.Predicate))
+		}
+		vals, err := parseConstraintValues(it, schema.Predicate, next.Val, t)
+		if err != nil {
+			return err
+		}
+		schema.EnumValues = vals
+	case "ttl":
+		ttl, err := parseTTLDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.Ttl = ttl
 	case "noconflict":
 		schema.NoConflict = true
 	case "lang":
@@ -92,6 +160,210 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 	return nil
 }
 
+// parseUniqueDirective works on "@unique" or "@unique(pred1, pred2)". We assume that the
+// "@unique" has already been found. It returns the predicates in the parentheses, if any:
+// the tuple of values of the predicate and of those predicates must be unique, instead of
+// the value of the predicate alone.
+func parseUniqueDirective(it *lex.ItemIterator, predicate string) ([]string, error) {
+	if !it.Next() {
+		return nil, it.Item().Errorf("Invalid ending.")
+	}
+	if it.Item().Typ != itemLeftRound {
+		it.Prev() // Backup.
+		return nil, nil
+	}
+	return parseDirectivePredicates(it, predicate, "unique")
+}
+
+// parseCompositeDirective works on "@composite(pred1, pred2)". We assume that the
+// "@composite" has already been found. It returns the predicates in the parentheses, that
+// follow the predicate in the composite index.
+func parseCompositeDirective(it *lex.ItemIterator, predicate string) ([]string, error) {
+	if !it.Next() {
+		return nil, it.Item().Errorf("Invalid ending.")
+	}
+	if next := it.Item(); next.Typ != itemLeftRound {
+		return nil, next.Errorf("Expected '(' after @composite for predicate %s",
+			x.ParseAttr(predicate))
+	}
+	preds, err := parseDirectivePredicates(it, predicate, "composite")
+	if err != nil {
+		return nil, err
+	}
+	if len(preds) > 2 {
+		return nil, it.Item().Errorf("@composite supports at most 2 other predicates,"+
+			" got %d for predicate %s", len(preds), x.ParseAttr(predicate))
+	}
+	return preds, nil
+}
+
+// parseDirectivePredicates parses the predicates in the parentheses of a directive, up to
+// and including the closing parenthesis. We assume that the opening parenthesis has
+// already been found. The predicates are returned in the namespace of predicate, and
+// can't repeat predicate or each other.
+func parseDirectivePredicates(it *lex.ItemIterator, predicate,
+	directive string) ([]string, error) {
+	ns := x.ParseNamespace(predicate)
+	seen := map[string]bool{predicate: true}
+	var preds []string
+	for {
+		it.Next()
+		next := it.Item()
+		if next.Typ != itemText {
+			return nil, next.Errorf("Expected predicate name inside @%s, but found '%s'",
+				directive, next.Val)
+		}
+		pred := x.NamespaceAttr(ns, next.Val)
+		if seen[pred] {
+			return nil, next.Errorf("Duplicate predicate %s in @%s for predicate %s",
+				next.Val, directive, x.ParseAttr(predicate))
+		}
+		seen[pred] = true
+		preds = append(preds, pred)
+
+		it.Next()
+		next = it.Item()
+		if next.Typ == itemRightRound {
+			return preds, nil
+		}
+		if next.Typ != itemComma {
+			return nil, next.Errorf("Expected ',' or ')' but found '%s' for predicate '%s'",
+				next.Val, x.ParseAttr(predicate))
+		}
+	}
+}
+
+// parseConstraintValues parses the values in the parentheses of @min, @max, @regex or @enum,
+// up to and including the closing parenthesis. Values are numbers or quoted text, which is
+// kept as written without the quotes. Every value must be convertible to the type t.
+func parseConstraintValues(it *lex.ItemIterator, predicate, directive string,
+	t types.TypeID) ([]string, error) {
+	it.Next()
+	if next := it.Item(); next.Typ != itemLeftRound {
+		return nil, next.Errorf("Expected '(' after @%s for predicate %s",
+			directive, x.ParseAttr(predicate))
+	}
+	seen := make(map[string]bool)
+	var vals []string
+	for {
+		it.Next()
+		next := it.Item()
+		var val string
+		switch next.Typ {
+		case itemNumber:
+			val = next.Val
+		case itemQuotedText:
+			val = next.Val[1 : len(next.Val)-1]
+		default:
+			return nil, next.Errorf("Expected a number or quoted text inside @%s, but found '%s'",
+				directive, next.Val)
+		}
+		if _, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(val)}, t); err != nil {
+			return nil, next.Errorf("Invalid value %q in @%s for predicate %s of type %s",
+				val, directive, x.ParseAttr(predicate), t.Name())
+		}
+		if seen[val] {
+			return nil, next.Errorf("Duplicate value %q in @%s for predicate %s",
+				val, directive, x.ParseAttr(predicate))
+		}
+		seen[val] = true
+		vals = append(vals, val)
+
+		it.Next()
+		next = it.Item()
+		if next.Typ == itemRightRound {
+			return vals, nil
+		}
+		if next.Typ != itemComma {
+			return nil, next.Errorf("Expected ',' or ')' but found '%s' for predicate '%s'",
+				next.Val, x.ParseAttr(predicate))
+		}
+	}
+}
+
+// parseTTLDirective parses the duration in the parentheses of @ttl, up to and including the
+// closing parenthesis, and returns it in seconds. The duration is either written as is, like
+// @ttl(24h) or @ttl(7d), or quoted, like @ttl("1h30m").
+func parseTTLDirective(it *lex.ItemIterator, predicate string) (uint64, error) {
+	it.Next()
+	if next := it.Item(); next.Typ != itemLeftRound {
+		return 0, next.Errorf("Expected '(' after @ttl for predicate %s", x.ParseAttr(predicate))
+	}
+	var val string
+	for {
+		it.Next()
+		next := it.Item()
+		switch {
+		case next.Typ == itemRightRound:
+			ttl, err := ParseTTL(val)
+			if err != nil {
+				return 0, next.Errorf("Invalid duration %q in @ttl for predicate %s: %v",
+					val, x.ParseAttr(predicate), err)
+			}
+			return ttl, nil
+		case next.Typ == itemQuotedText && val == "":
+			val = next.Val[1 : len(next.Val)-1]
+		case next.Typ == itemNumber || next.Typ == itemText:
+			val += next.Val
+		default:
+			return 0, next.Errorf("Expected a duration inside @ttl, but found '%s'", next.Val)
+		}
+	}
+}
+
+// ParseTTL parses a duration like 30s, 1h30m or 7d and returns it in whole seconds. Besides
+// the units of time.ParseDuration, d can be used for days. The duration must be at least a
+// second.
+func ParseTTL(s string) (uint64, error) {
+	var d time.Duration
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.ParseUint(days, 10, 32)
+		if err != nil {
+			return 0, errors.Errorf("invalid number of days %q", days)
+		}
+		d = time.Duration(n) * 24 * time.Hour
+	} else {
+		var err error
+		if d, err = time.ParseDuration(s); err != nil {
+			return 0, err
+		}
+	}
+	if d < time.Second {
+		return 0, errors.Errorf("duration must be at least 1s")
+	}
+	return uint64(d / time.Second), nil
+}
+
+// FormatTTL returns the duration of ttl seconds the way it can be written in @ttl.
+func FormatTTL(ttl uint64) string {
+	const day = 24 * 60 * 60
+	if ttl%day == 0 {
+		return strconv.FormatUint(ttl/day, 10) + "d"
+	}
+	return (time.Duration(ttl) * time.Second).String()
+}
+
+// checkMinMax returns an error if both @min and @max are set on the predicate of the
+// update, and the minimum is greater than the maximum.
+func checkMinMax(update *pb.SchemaUpdate, t types.TypeID) error {
+	if update.Min == "" || update.Max == "" {
+		return nil
+	}
+	minVal, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(update.Min)}, t)
+	if err != nil {
+		return err
+	}
+	maxVal, err := types.Convert(types.Val{Tid: types.StringID, Value: []byte(update.Max)}, t)
+	if err != nil {
+		return err
+	}
+	if types.CompareVals("gt", minVal, maxVal) {
+		return errors.Errorf("@min(%s) is greater than @max(%s) for predicate %s",
+			update.Min, update.Max, x.ParseAttr(update.Predicate))
+	}
+	return nil
+}
+
 func parseScalarPair(it *lex.ItemIterator, predicate string, ns uint64) (*pb.SchemaUpdate, error) {
 	it.Next()
 	next := it.Item()
@@ -160,6 +432,9 @@ func parseScalarPair(it *lex.ItemIterator, predicate string, ns uint64) (*pb.Sch
 		}
 		next = it.Item()
 	}
+	if err := checkMinMax(schema, t); err != nil {
+		return nil, next.Errorf("%v", err)
+	}
 
 	if next.Typ != itemDot {
 		return nil, next.Errorf("Invalid ending")
@@ -288,7 +563,7 @@ func parseTokenOrVectorIndexSpec(
 	if found {
 		// TODO: Consider allowing IndexFactory types not related to
 		//       VectorIndex objects.
-		if typ != types.VFloatID {
+		if !typ.IsVector() {
 			return "", nil, false,
 				next.Errorf("IndexFactory: %s isn't valid for predicate: %s of type: %s",
 					factory.Name(), x.ParseAttr(predicate), typ.Name())
@@ -491,6 +766,16 @@ func parseTypeDeclaration(it *lex.ItemIterator, ns uint64) (*pb.TypeUpdate, erro
 	typeUpdate := &pb.TypeUpdate{TypeName: x.NamespaceAttr(ns, it.Item().Val)}
 
 	it.Next()
+	// The only directive of a type is @strict.
+	if it.Item().Typ == itemAt {
+		it.Next()
+		if it.Item().Typ != itemText || it.Item().Val != "strict" {
+			return nil, it.Item().Errorf("Invalid directive %v for type %v", it.Item().Val,
+				x.ParseAttr(typeUpdate.TypeName))
+		}
+		typeUpdate.Strict = true
+		it.Next()
+	}
 	if it.Item().Typ != itemLeftCurl {
 		return nil, it.Item().Errorf("Expected {. Got %v", it.Item().Val)
 	}
@@ -541,7 +826,21 @@ func parseTypeField(it *lex.ItemIterator, typeName string, ns uint64) (*pb.Schem
 	it.Next()
 
 	// Simplified type definitions only require the field name. If a new line is found,
-	// proceed to the next field in the type.
+	// proceed to the next field in the type. The name can be followed by '!' to require
+	// every node of the type to have a value for the field.
+	if it.Item().Typ == itemExclamationMark {
+		if strings.HasPrefix(x.ParseAttr(field.Predicate), "~") {
+			return nil, it.Item().Errorf("Reverse field %s in type %s can't be required",
+				x.ParseAttr(field.Predicate), x.ParseAttr(typeName))
+		}
+		field.NonNullable = true
+		it.Next()
+		if it.Item().Typ != itemNewLine {
+			return nil, it.Item().Errorf("Expected new line after field declaration. Got %v",
+				it.Item().Val)
+		}
+		return field, nil
+	}
 	if it.Item().Typ == itemNewLine {
 		return field, nil
 	}
@@ -629,7 +928,7 @@ func isTypeDeclaration(item lex.Item, it *lex.ItemIterator) bool {
 	case nextItems[0].Typ != itemText:
 		return false
 
-	case nextItems[1].Typ != itemLeftCurl:
+	case nextItems[1].Typ != itemLeftCurl && nextItems[1].Typ != itemAt:
 		return false
 	}
 
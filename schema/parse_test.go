@@ -55,6 +55,7 @@ name: string .
 amount: bigfloat .
 coordinates: float32vector .
 indexvector: float32vector @index(hnsw(metric:"euclidian")) .
+ivfvector: float32vector @index(ivf(nlist:"16", metric:"cosine")) .
 `
 
 func TestSchema(t *testing.T) {
@@ -101,6 +102,27 @@ func TestSchema(t *testing.T) {
 				},
 			},
 		}},
+		{x.GalaxyAttr("ivfvector"), &pb.SchemaUpdate{
+			Predicate: x.GalaxyAttr("ivfvector"),
+			ValueType: pb.Posting_VFLOAT,
+			Tokenizer: []string{},
+			Directive: pb.SchemaUpdate_INDEX,
+			IndexSpecs: []*pb.VectorIndexSpec{
+				{
+					Name: "ivf",
+					Options: []*pb.OptionPair{
+						{
+							Key:   "nlist",
+							Value: "16",
+						},
+						{
+							Key:   "metric",
+							Value: "cosine",
+						},
+					},
+				},
+			},
+		}},
 	})
 
 	typ, err := State().TypeOf(x.GalaxyAttr("age"))
@@ -400,6 +422,232 @@ func TestParseUpsert(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseUniqueWith(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		email: string @index(exact) @unique(tenant, org) .
+		name: string @index(exact) @unique .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Preds))
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate:  x.GalaxyAttr("email"),
+		ValueType:  9,
+		Directive:  pb.SchemaUpdate_INDEX,
+		Tokenizer:  []string{"exact"},
+		UniqueWith: []string{x.GalaxyAttr("tenant"), x.GalaxyAttr("org")},
+	}, result.Preds[0])
+	require.True(t, result.Preds[1].Unique)
+	require.Empty(t, result.Preds[1].UniqueWith)
+}
+
+func TestParseUniqueWithError(t *testing.T) {
+	reset()
+	_, err := Parse(`email: string @index(exact) @unique(tenant, tenant) .`)
+	require.ErrorContains(t, err, "Duplicate predicate tenant in @unique for predicate email")
+
+	_, err = Parse(`email: string @index(exact) @unique(email) .`)
+	require.ErrorContains(t, err, "Duplicate predicate email in @unique for predicate email")
+
+	_, err = Parse(`email: string @index(exact) @unique(tenant org) .`)
+	require.ErrorContains(t, err, "Expected ',' or ')' but found 'org' for predicate 'email'")
+
+	_, err = Parse(`email: string @index(exact) @unique() .`)
+	require.ErrorContains(t, err, "Expected predicate name inside @unique")
+}
+
+func TestParseComposite(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		country: string @composite(age, city) .
+		age: int .
+		city: string .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(result.Preds))
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate:     x.GalaxyAttr("country"),
+		ValueType:     9,
+		CompositeWith: []string{x.GalaxyAttr("age"), x.GalaxyAttr("city")},
+	}, result.Preds[0])
+}
+
+func TestParseCompositeError(t *testing.T) {
+	reset()
+	_, err := Parse(`country: string @composite .`)
+	require.ErrorContains(t, err, "Expected '(' after @composite for predicate country")
+
+	_, err = Parse(`country: string @composite(age, age) .`)
+	require.ErrorContains(t, err, "Duplicate predicate age in @composite for predicate country")
+
+	_, err = Parse(`country: string @composite(age, city, zip) .`)
+	require.ErrorContains(t, err, "@composite supports at most 2 other predicates, got 3")
+}
+
+func TestParseValueConstraints(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		age: int @min(0) @max(150) .
+		score: float @min("-1.5") .
+		born: datetime @max("2030-01-01") .
+		email: string @index(exact) @regex("^[^@]+@[^@]+$") .
+		status: [string] @enum("active", "inactive") .
+		level: int @enum(1, 2, 3) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 6, len(result.Preds))
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate: x.GalaxyAttr("age"),
+		ValueType: pb.Posting_INT,
+		Min:       "0",
+		Max:       "150",
+	}, result.Preds[0])
+	require.Equal(t, "-1.5", result.Preds[1].Min)
+	require.Equal(t, "2030-01-01", result.Preds[2].Max)
+	require.Equal(t, "^[^@]+@[^@]+$", result.Preds[3].Regex)
+	require.Equal(t, []string{"active", "inactive"}, result.Preds[4].EnumValues)
+	require.Equal(t, []string{"1", "2", "3"}, result.Preds[5].EnumValues)
+}
+
+func TestParseValueConstraintsError(t *testing.T) {
+	tests := []struct {
+		schema string
+		err    string
+	}{
+		{`name: string @min(1) .`, "@min directive can only be specified for int, float or datetime"},
+		{`age: int @max .`, "Expected '(' after @max for predicate age"},
+		{`age: int @min(1, 2) .`, "@min directive takes a single value for attr: [age]"},
+		{`age: int @min("a") .`, `Invalid value "a" in @min for predicate age of type int`},
+		{`age: int @min(10) @max(5) .`, "@min(10) is greater than @max(5) for predicate age"},
+		{`age: int @regex("a") .`, "@regex directive can only be specified for string type"},
+		{`name: string @regex("(a") .`, "Invalid pattern in @regex for attr: [name]"},
+		{`name: string @regex(a) .`, "Expected a number or quoted text inside @regex"},
+		{`born: datetime @enum("2020") .`, "@enum directive can only be specified for string, int"},
+		{`name: string @enum("a", "a") .`, `Duplicate value "a" in @enum for predicate name`},
+	}
+	for _, tc := range tests {
+		reset()
+		_, err := Parse(tc.schema)
+		require.ErrorContains(t, err, tc.err, tc.schema)
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		session: string @index(exact) @ttl(24h) .
+		token: string @ttl(30s) .
+		cart: [uid] @reverse @ttl(7d) .
+		visit: datetime @ttl("1h30m") .
+		seen: int @ttl(1h30m) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 5, len(result.Preds))
+	require.EqualValues(t, &pb.SchemaUpdate{
+		Predicate: x.GalaxyAttr("session"),
+		ValueType: pb.Posting_STRING,
+		Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"},
+		Ttl:       24 * 60 * 60,
+	}, result.Preds[0])
+	require.EqualValues(t, 30, result.Preds[1].Ttl)
+	require.EqualValues(t, 7*24*60*60, result.Preds[2].Ttl)
+	require.EqualValues(t, 90*60, result.Preds[3].Ttl)
+	require.EqualValues(t, 90*60, result.Preds[4].Ttl)
+
+	require.Equal(t, "1d", FormatTTL(24*60*60))
+	require.Equal(t, "30s", FormatTTL(30))
+	require.Equal(t, "1h30m0s", FormatTTL(90*60))
+}
+
+func TestParseTTLError(t *testing.T) {
+	tests := []struct {
+		schema string
+		err    string
+	}{
+		{`session: string @ttl .`, "Expected '(' after @ttl for predicate session"},
+		{`session: string @ttl() .`, `Invalid duration "" in @ttl for predicate session`},
+		{`session: string @ttl(24x) .`, `Invalid duration "24x" in @ttl for predicate session`},
+		{`session: string @ttl(500ms) .`, "duration must be at least 1s"},
+		{`session: string @ttl(1h, 2h) .`, "Expected a duration inside @ttl, but found ','"},
+	}
+	for _, tc := range tests {
+		reset()
+		_, err := Parse(tc.schema)
+		require.ErrorContains(t, err, tc.err, tc.schema)
+	}
+}
+
+func TestParseRequiredField(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type Person {
+			name!
+			address
+			email!
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(result.Types))
+	require.Equal(t, &pb.TypeUpdate{
+		TypeName: x.GalaxyAttr("Person"),
+		Fields: []*pb.SchemaUpdate{
+			{
+				Predicate:   x.GalaxyAttr("name"),
+				NonNullable: true,
+			},
+			{
+				Predicate: x.GalaxyAttr("address"),
+			},
+			{
+				Predicate:   x.GalaxyAttr("email"),
+				NonNullable: true,
+			},
+		},
+	}, result.Types[0])
+
+	_, err = Parse(`
+		type Person {
+			<~friend>!
+		}
+	`)
+	require.ErrorContains(t, err, "Reverse field ~friend in type Person can't be required")
+
+	_, err = Parse(`
+		type Person {
+			name! address
+		}
+	`)
+	require.ErrorContains(t, err, "Expected new line after field declaration")
+}
+
+func TestParseStrictType(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		type Person @strict {
+			name
+		}
+		type Animal {
+			name
+		}
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(result.Types))
+	require.Equal(t, &pb.TypeUpdate{
+		TypeName: x.GalaxyAttr("Person"),
+		Fields:   []*pb.SchemaUpdate{{Predicate: x.GalaxyAttr("name")}},
+		Strict:   true,
+	}, result.Types[0])
+	require.False(t, result.Types[1].Strict)
+
+	_, err = Parse(`
+		type Person @closed {
+			name
+		}
+	`)
+	require.ErrorContains(t, err, "Invalid directive closed for type Person")
+}
+
 func TestParseEmptyType(t *testing.T) {
 	reset()
 	result, err := Parse(`
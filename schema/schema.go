@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
 	"sync"
 
 	"github.com/golang/glog"
@@ -34,6 +35,7 @@ import (
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
 	"github.com/dgraph-io/dgraph/v24/tok"
 	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v24/tok/ivf"
 	"github.com/dgraph-io/dgraph/v24/types"
 	"github.com/dgraph-io/dgraph/v24/x"
 )
@@ -75,6 +77,15 @@ type state struct {
 	elog      trace.EventLog
 	// mutSchema holds the schema update that is being applied in the background.
 	mutSchema map[string]*pb.SchemaUpdate
+	// compositeOf maps every predicate of a composite index to the predicates the indexes
+	// it belongs to are kept under, in either schema. It's computed when first needed
+	// after a change of the schema, see CompositeIndexesOf.
+	compositeOf map[string][]string
+	// requiredBy maps every field required by a type (name!) to the types requiring it,
+	// and strictTypes holds the types declared with @strict. They're computed when first
+	// needed after a change of the types, see typeIndexes.
+	requiredBy  map[string][]string
+	strictTypes map[string]bool
 }
 
 // State returns the struct holding the current schema.
@@ -97,6 +108,8 @@ func (s *state) DeleteAll() {
 	for pred := range s.mutSchema {
 		delete(s.mutSchema, pred)
 	}
+	s.compositeOf = nil
+	s.requiredBy, s.strictTypes = nil, nil
 }
 
 // Delete updates the schema in memory and disk
@@ -116,6 +129,7 @@ func (s *state) Delete(attr string, ts uint64) error {
 
 	delete(s.predicate, attr)
 	delete(s.mutSchema, attr)
+	s.compositeOf = nil
 	return nil
 }
 
@@ -139,6 +153,7 @@ func (s *state) DeleteType(typeName string, ts uint64) error {
 	}
 
 	delete(s.types, typeName)
+	s.requiredBy, s.strictTypes = nil, nil
 	return nil
 }
 
@@ -172,6 +187,8 @@ func (s *state) DeletePredsForNs(delNs uint64) {
 			delete(s.mutSchema, pred)
 		}
 	}
+	s.compositeOf = nil
+	s.requiredBy, s.strictTypes = nil, nil
 	for typ := range s.types {
 		ns := x.ParseNamespace(typ)
 		if ns == delNs {
@@ -207,6 +224,7 @@ func (s *state) Set(pred string, schema *pb.SchemaUpdate) {
 	s.Lock()
 	defer s.Unlock()
 	s.predicate[pred] = schema
+	s.compositeOf = nil
 	s.elog.Printf(logUpdate(schema, pred))
 }
 
@@ -215,6 +233,7 @@ func (s *state) SetMutSchema(pred string, schema *pb.SchemaUpdate) {
 	s.Lock()
 	defer s.Unlock()
 	s.mutSchema[pred] = schema
+	s.compositeOf = nil
 }
 
 // DeleteMutSchema deletes the schema for given predicate from mutSchema.
@@ -222,6 +241,7 @@ func (s *state) DeleteMutSchema(pred string) {
 	s.Lock()
 	defer s.Unlock()
 	delete(s.mutSchema, pred)
+	s.compositeOf = nil
 }
 
 // GetIndexingPredicates returns the list of predicates for which we are building indexes.
@@ -246,6 +266,7 @@ func (s *state) SetType(typeName string, typ *pb.TypeUpdate) {
 	s.Lock()
 	defer s.Unlock()
 	s.types[typeName] = typ
+	s.requiredBy, s.strictTypes = nil, nil
 	s.elog.Printf(logTypeUpdate(typ, typeName))
 }
 
@@ -398,7 +419,7 @@ func (s *state) FactoryCreateSpec(ctx context.Context, pred string) ([]*tok.Fact
 	}
 	creates := make([]*tok.FactoryCreateSpec, 0, len(su.IndexSpecs))
 	for _, vs := range su.IndexSpecs {
-		c, err := tok.GetFactoryCreateSpecFromSpec(vs)
+		c, err := tok.GetFactoryCreateSpecFromSpec(vs, types.TypeID(su.ValueType), su.List)
 		if err != nil {
 			return nil, err
 		}
@@ -480,10 +501,15 @@ func (s *state) PredicatesToDelete(pred string) []string {
 	if schema, ok := s.predicate[pred]; ok {
 		preds = append(preds, pred)
 
-		if schema.ValueType == pb.Posting_VFLOAT && len(schema.IndexSpecs) != 0 {
+		if types.TypeID(schema.ValueType).IsVector() && len(schema.IndexSpecs) != 0 {
 			preds = append(preds, pred+hnsw.VecEntry)
 			preds = append(preds, pred+hnsw.VecKeyword)
 			preds = append(preds, pred+hnsw.VecDead)
+			preds = append(preds, pred+hnsw.VecMulti)
+			preds = append(preds, pred+hnsw.VecOwner)
+			preds = append(preds, pred+ivf.VecCodebook)
+			preds = append(preds, pred+ivf.VecList)
+			preds = append(preds, pred+ivf.VecAssignment)
 		}
 	}
 	return preds
@@ -523,6 +549,121 @@ func (s *state) HasNoConflict(pred string) bool {
 	return s.predicate[pred].GetNoConflict()
 }
 
+// CompositeUniques returns the composite unique constraints of the namespace ns. Every
+// constraint is the predicate declared with @unique(...) followed by the predicates in the
+// parentheses.
+func (s *state) CompositeUniques(ns uint64) [][]string {
+	s.RLock()
+	defer s.RUnlock()
+	var out [][]string
+	for pred, schema := range s.predicate {
+		if len(schema.UniqueWith) == 0 || x.ParseNamespace(pred) != ns {
+			continue
+		}
+		out = append(out, append([]string{pred}, schema.UniqueWith...))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i][0] < out[j][0] })
+	return out
+}
+
+// CompositeWith returns the predicates that follow pred in its composite index, set by
+// @composite(pred1, pred2), or nil if pred has none.
+func (s *state) CompositeWith(ctx context.Context, pred string) []string {
+	isWrite, _ := ctx.Value(IsWrite).(bool)
+	s.RLock()
+	defer s.RUnlock()
+	if isWrite {
+		if schema, ok := s.mutSchema[pred]; ok {
+			return schema.CompositeWith
+		}
+	}
+	if schema, ok := s.predicate[pred]; ok {
+		return schema.CompositeWith
+	}
+	return nil
+}
+
+// CompositeIndexesOf returns the predicates whose composite index pred is part of,
+// including pred itself if it has one. The indexes may only be in the schema being applied
+// in the background, CompositeWith tells which are in the schema of the context.
+func (s *state) CompositeIndexesOf(pred string) []string {
+	s.RLock()
+	if s.compositeOf != nil {
+		defer s.RUnlock()
+		return s.compositeOf[pred]
+	}
+	s.RUnlock()
+
+	s.Lock()
+	defer s.Unlock()
+	if s.compositeOf == nil {
+		s.compositeOf = make(map[string][]string)
+		add := func(attr string, schema *pb.SchemaUpdate) {
+			if len(schema.CompositeWith) == 0 {
+				return
+			}
+			for _, p := range append([]string{attr}, schema.CompositeWith...) {
+				found := false
+				for _, other := range s.compositeOf[p] {
+					found = found || other == attr
+				}
+				if !found {
+					s.compositeOf[p] = append(s.compositeOf[p], attr)
+				}
+			}
+		}
+		for attr, schema := range s.predicate {
+			add(attr, schema)
+		}
+		for attr, schema := range s.mutSchema {
+			add(attr, schema)
+		}
+	}
+	return s.compositeOf[pred]
+}
+
+// TypesRequiring returns a map of every field required by a type, declared as name! in the
+// type, to the types requiring it. The returned map must not be modified.
+func (s *state) TypesRequiring() map[string][]string {
+	requiredBy, _ := s.typeIndexes()
+	return requiredBy
+}
+
+// StrictTypes returns the set of types declared with @strict. The returned map must not be
+// modified.
+func (s *state) StrictTypes() map[string]bool {
+	_, strictTypes := s.typeIndexes()
+	return strictTypes
+}
+
+// typeIndexes returns requiredBy and strictTypes, computing them if the types changed.
+func (s *state) typeIndexes() (map[string][]string, map[string]bool) {
+	s.RLock()
+	if s.requiredBy != nil {
+		defer s.RUnlock()
+		return s.requiredBy, s.strictTypes
+	}
+	s.RUnlock()
+
+	s.Lock()
+	defer s.Unlock()
+	if s.requiredBy == nil {
+		s.requiredBy = make(map[string][]string)
+		s.strictTypes = make(map[string]bool)
+		for typeName, typ := range s.types {
+			if typ.Strict {
+				s.strictTypes[typeName] = true
+			}
+			for _, field := range typ.Fields {
+				if field.NonNullable {
+					s.requiredBy[field.Predicate] = append(s.requiredBy[field.Predicate], typeName)
+				}
+			}
+		}
+	}
+	return s.requiredBy, s.strictTypes
+}
+
 // IndexingInProgress checks whether indexing is going on for a given predicate.
 func (s *state) IndexingInProgress() bool {
 	s.RLock()
@@ -0,0 +1,235 @@
+//go:build integration
+
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v230"
+	"github.com/dgraph-io/dgo/v230/protos/api"
+)
+
+func TestValueConstraints(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		age: int @min(0) @max(150) .
+		email: string @regex("^[^@]+@[^@]+$") .
+		status: string @enum("active", "inactive") .`))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:a <age> "30" .
+		                   _:a <email> "bob@dgraph.io" .
+		                   _:a <status> "active" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:a <age> "200" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "Value 200 for predicate [age] is greater than its @max(150)")
+
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:a <email> "bob" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, `Value "bob" for predicate [email] doesn't match its @regex`)
+
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:a <status> "deleted" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "Value deleted for predicate [status] isn't one of its @enum")
+
+	resp, err := dg.Query(`schema(pred: [age]) { min max }`)
+	require.NoError(t, err)
+	var sch struct {
+		Schema []struct {
+			Min string `json:"min"`
+			Max string `json:"max"`
+		} `json:"schema"`
+	}
+	require.NoError(t, json.Unmarshal(resp.GetJson(), &sch))
+	require.Len(t, sch.Schema, 1)
+	require.Equal(t, "0", sch.Schema[0].Min)
+	require.Equal(t, "150", sch.Schema[0].Max)
+}
+
+func TestRequiredFields(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		name: string .
+		nick: string .
+		type Person {
+			name!
+			nick
+		}`))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:a <dgraph.type> "Person" .
+		                   _:a <nick> "bob" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "is missing a value for required field name")
+
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x100> <dgraph.type> "Person" .
+		                   <0x100> <name> "Bob" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// The value is read from the data when the type is set again.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x100> <dgraph.type> "Person" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	_, err = dg.Mutate(&api.Mutation{
+		DelNquads: []byte(`<0x100> <name> * .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "Can't remove the value of required field name of type Person"+
+		" from node 0x100")
+
+	// Replacing the value, or removing the type as well, is allowed.
+	_, err = dg.Mutate(&api.Mutation{
+		DelNquads: []byte(`<0x100> <name> * .`),
+		SetNquads: []byte(`<0x100> <name> "Robert" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	_, err = dg.Mutate(&api.Mutation{
+		DelNquads: []byte(`<0x100> <name> * .
+		                   <0x100> <dgraph.type> * .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// Setting the type of a node conflicts with removing a field required by the type.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x101> <name> "Carol" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+	retype, remove := dg.NewTxn(), dg.NewTxn()
+	_, err = retype.Mutate(ctx, &api.Mutation{SetNquads: []byte(`<0x101> <dgraph.type> "Person" .`)})
+	require.NoError(t, err)
+	_, err = remove.Mutate(ctx, &api.Mutation{DelNquads: []byte(`<0x101> <name> * .`)})
+	require.NoError(t, err)
+	require.NoError(t, retype.Commit(ctx))
+	require.ErrorIs(t, remove.Commit(ctx), dgo.ErrAborted)
+}
+
+func TestStrictTypes(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		name: string .
+		age: int .
+		color: string .
+		type Person @strict {
+			name
+			age
+		}
+		type Painter {
+			color
+		}`))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x100> <dgraph.type> "Person" .
+		                   <0x100> <name> "Bob" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x100> <color> "red" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "Can't set predicate color for node 0x100 of strict type Person")
+
+	// A type of the node having the predicate makes it allowed.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x100> <dgraph.type> "Painter" .
+		                   <0x100> <color> "red" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// Nodes without a strict type can have any predicate.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:a <name> "Alice" .
+		                   _:a <color> "blue" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// A node can't get a strict type while it has a value for a predicate outside its fields.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x101> <color> "green" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x101> <dgraph.type> "Person" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "Can't set strict type Person for node 0x101")
+
+	// Nor can an existing type be made strict.
+	require.NoError(t, dg.SetupSchema(`
+		type Sculptor {
+			name
+		}`))
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x102> <dgraph.type> "Sculptor" .
+		                   <0x102> <name> "Carl" .
+		                   <0x102> <color> "white" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	require.ErrorContains(t, dg.SetupSchema(`
+		type Sculptor @strict {
+			name
+		}`), "Can't make type Sculptor strict without field color: node 0x102")
+
+	// The field is used by 0x100 only through Person.
+	require.ErrorContains(t, dg.SetupSchema(`
+		type Person @strict {
+			age
+		}`), "Can't remove field name from strict type Person: node 0x100 of the type has a value")
+
+	// Painter has color too, and no node of Person has an age.
+	require.NoError(t, dg.SetupSchema(`
+		type Person @strict {
+			name
+			color
+		}`))
+	require.NoError(t, dg.SetupSchema(`
+		type Person @strict {
+			name
+		}`))
+}
@@ -759,3 +759,105 @@ func TestConcurrency2(t *testing.T) {
 		}
 	}
 }
+
+func TestCompositeUniqueSchema(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		email: string @index(exact) @unique(tenant) .
+		tenant: string @index(exact) .`))
+	resp, err := dg.Query(`schema(pred: email) { }`)
+	require.NoError(t, err)
+	require.Contains(t, string(resp.GetJson()), `"unique_with":["tenant"]`)
+
+	require.NoError(t, dg.DropAll())
+	err = dg.SetupSchema(`email: string @unique(tenant) .`)
+	require.ErrorContains(t, err, "index for predicate [email] is missing,"+
+		" add either hash or exact index with @unique")
+
+	err = dg.SetupSchema(`email: [string] @index(exact) @unique(tenant) .`)
+	require.ErrorContains(t, err, "@unique with other predicates not supported on list predicate [email]")
+}
+
+func TestCompositeUniqueMutation(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		email: string @index(exact) @unique(tenant) .
+		tenant: string @index(exact) .`))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:a <email> "bob@dgraph.io" .
+		                   _:a <tenant> "acme" .
+		                   _:b <email> "bob@dgraph.io" .
+		                   _:b <tenant> "globex" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// The same tuple in the data.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:c <email> "bob@dgraph.io" .
+		                   _:c <tenant> "acme" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "could not insert duplicate values [bob@dgraph.io, acme]"+
+		" for predicates [email, tenant], they are already used by")
+
+	// The same tuple twice in a mutation.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:c <email> "alice@dgraph.io" .
+		                   _:c <tenant> "acme" .
+		                   _:d <email> "alice@dgraph.io" .
+		                   _:d <tenant> "acme" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "could not insert duplicate values [alice@dgraph.io, acme]"+
+		" for predicates [email, tenant], they are set for both")
+
+	// A node without a tenant isn't constrained.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:c <email> "bob@dgraph.io" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+}
+
+func TestCompositeUniqueExistingNode(t *testing.T) {
+	dg := setUpDgraph(t)
+	require.NoError(t, dg.SetupSchema(`
+		email: string @index(exact) @unique(tenant) .
+		tenant: string @index(exact) .`))
+
+	_, err := dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x100> <email> "bob@dgraph.io" .
+		                   <0x100> <tenant> "acme" .
+		                   <0x200> <email> "bob@dgraph.io" .
+		                   <0x200> <tenant> "globex" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// The email of 0x200 is read from the data.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x200> <tenant> "acme" .`),
+		CommitNow: true,
+	})
+	require.ErrorContains(t, err, "could not insert duplicate values [bob@dgraph.io, acme]"+
+		" for predicates [email, tenant], they are already used by [0x100]")
+
+	// Swapping the tenants of both nodes.
+	_, err = dg.Mutate(&api.Mutation{
+		SetNquads: []byte(`<0x100> <tenant> "globex" .
+		                   <0x200> <tenant> "acme" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+
+	// Deleting the tenant frees the tuple.
+	_, err = dg.Mutate(&api.Mutation{
+		DelNquads: []byte(`<0x100> <tenant> * .`),
+		SetNquads: []byte(`<0x300> <email> "bob@dgraph.io" .
+		                   <0x300> <tenant> "globex" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tok
+
+import (
+	"encoding/binary"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v24/types"
+)
+
+// IsCompositeType returns true if the values of type typ can be part of a composite index.
+func IsCompositeType(typ types.TypeID) bool {
+	switch typ {
+	case types.IntID, types.FloatID, types.StringID, types.DefaultID, types.DateTimeID,
+		types.BoolID:
+		return true
+	}
+	return false
+}
+
+// EncodeCompositeValue encodes a value of a composite index. The encodings of two values of
+// the same type compare like the values do, and none of them is a prefix of another, so
+// that the tokens of a composite index, made of the encodings of the values of its
+// predicates, are sorted by the first value, then by the second one and so on.
+func EncodeCompositeValue(val types.Val) (string, error) {
+	switch val.Tid {
+	case types.IntID:
+		return encodeCompositeInt(val.Value.(int64)), nil
+	case types.FloatID:
+		bits := math.Float64bits(val.Value.(float64))
+		if bits>>63 == 0 {
+			bits |= 1 << 63
+		} else {
+			bits = ^bits
+		}
+		var buf [8]byte
+		binary.BigEndian.PutUint64(buf[:], bits)
+		return string(buf[:]), nil
+	case types.StringID, types.DefaultID:
+		// 0x00 is escaped as 0x00 0xff, and the value ends with 0x00 0x01.
+		s := strings.ReplaceAll(val.Value.(string), "\x00", "\x00\xff")
+		return s + "\x00\x01", nil
+	case types.DateTimeID:
+		t := val.Value.(time.Time)
+		var buf [4]byte
+		binary.BigEndian.PutUint32(buf[:], uint32(t.Nanosecond()))
+		return encodeCompositeInt(t.Unix()) + string(buf[:]), nil
+	case types.BoolID:
+		if val.Value.(bool) {
+			return "\x01", nil
+		}
+		return "\x00", nil
+	}
+	return "", errors.Errorf("Type %s can't be part of a composite index", val.Tid.Name())
+}
+
+// encodeCompositeInt encodes val in big endian with its sign bit flipped, so that negative
+// values come first.
+func encodeCompositeInt(val int64) string {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uint64(val)^(1<<63))
+	return string(buf[:])
+}
+
+// CompositeToken returns the token of a composite index for the encoded values of its
+// predicates, or of the first ones to get the prefix of the tokens having those values.
+func CompositeToken(encoded ...string) string {
+	return encodeToken(strings.Join(encoded, ""), IdentComposite)
+}
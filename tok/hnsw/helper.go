@@ -19,12 +19,13 @@
 package hnsw
 
 import (
-	"container/heap"
+	"bytes"
 	"context"
 	"encoding/binary"
 	"fmt"
 	"log"
 	"math"
+	"math/bits"
 	"math/rand"
 	"sort"
 	"strconv"
@@ -43,6 +44,7 @@ const (
 	Euclidian            = "euclidian"
 	Cosine               = "cosine"
 	DotProd              = "dotproduct"
+	Hamming              = "hamming"
 	plError              = "\nerror fetching posting list for data key: "
 	dataError            = "\nerror fetching data for data key: "
 	EmptyHNSWTreeError   = "HNSW tree has no elements"
@@ -64,6 +66,16 @@ const (
 	NsSeparator = "-"
 )
 
+const (
+	// VecMulti is the suffix of the attribute holding the vectors of a
+	// predicate with a list of vectors per node. Each vector is stored under
+	// its own id, which is the uid used for it by the vector index.
+	VecMulti = "__vector_multi"
+	// VecOwner is the suffix of the attribute holding the uid of the node
+	// owning each vector of VecMulti.
+	VecOwner = "__vector_owner"
+)
+
 type SearchResult struct {
 	nnUids        []uint64
 	traversalPath []uint64
@@ -125,6 +137,96 @@ func euclidianDistanceSq[T c.Float](a, b []T, floatBits int) (T, error) {
 	return applyDistanceFunction(a, b, floatBits, "euclidian distance", vek32.Distance, vek.Distance)
 }
 
+// This needs to implement signature of SimilarityType[T].distanceScore
+// function, hence it takes in a floatBits parameter,
+// but doesn't actually use it. It counts the dimensions that differ,
+// which is meant for the 0/1 dimensions of binary vectors.
+func hammingDistance[T c.Float](a, b []T, floatBits int) (T, error) {
+	if len(a) != len(b) {
+		return T(0), errors.New("can not compute hamming distance on vectors of different lengths")
+	}
+	var dist T
+	for i := range a {
+		if a[i] != b[i] {
+			dist++
+		}
+	}
+	return dist, nil
+}
+
+// quantizedSums holds the sums the metrics are computed from for two vectors
+// stored with a quantized encoding, scaled like the decoded vectors so that
+// the scores are the ones of the float functions above.
+type quantizedSums struct {
+	dot, normV, normW, distSq float64
+	// differ is the number of dimensions that differ.
+	differ int
+}
+
+// sumQuantized computes the quantizedSums of v and w, which are stored with
+// the given quantized encoding (see index.Int8Encoding and
+// index.BinaryEncoding), without decoding them.
+func sumQuantized(v, w []byte, encoding string) (quantizedSums, error) {
+	var s quantizedSums
+	if len(v) != len(w) {
+		return s, errors.New("can not compare vectors of different lengths")
+	}
+	switch encoding {
+	case index.Int8Encoding:
+		var dot, normV, normW, distSq int64
+		for i := range v {
+			a, b := int64(int8(v[i])), int64(int8(w[i]))
+			dot += a * b
+			normV += a * a
+			normW += b * b
+			distSq += (a - b) * (a - b)
+			if a != b {
+				s.differ++
+			}
+		}
+		const scale = 127 * 127
+		s.dot, s.normV, s.normW = float64(dot)/scale, float64(normV)/scale, float64(normW)/scale
+		s.distSq = float64(distSq) / scale
+	case index.BinaryEncoding:
+		for i := range v {
+			s.dot += float64(bits.OnesCount8(v[i] & w[i]))
+			s.normV += float64(bits.OnesCount8(v[i]))
+			s.normW += float64(bits.OnesCount8(w[i]))
+			s.differ += bits.OnesCount8(v[i] ^ w[i])
+		}
+		s.distSq = float64(s.differ)
+	default:
+		return s, errors.Errorf("%s is not a quantized encoding", encoding)
+	}
+	return s, nil
+}
+
+// The functions below implement SimilarityType[T].quantizedScore for the
+// metrics of the float functions above.
+
+func dotProductQuantized[T c.Float](v, w []byte, encoding string) (T, error) {
+	s, err := sumQuantized(v, w, encoding)
+	return T(s.dot), err
+}
+
+func cosineSimilarityQuantized[T c.Float](v, w []byte, encoding string) (T, error) {
+	s, err := sumQuantized(v, w, encoding)
+	if err != nil || s.normV == 0 || s.normW == 0 {
+		return 0, err
+	}
+	return T(s.dot / math.Sqrt(s.normV*s.normW)), nil
+}
+
+func euclidianDistanceSqQuantized[T c.Float](v, w []byte, encoding string) (T, error) {
+	s, err := sumQuantized(v, w, encoding)
+	return T(math.Sqrt(s.distSq)), err
+}
+
+func hammingDistanceQuantized[T c.Float](v, w []byte, encoding string) (T, error) {
+	s, err := sumQuantized(v, w, encoding)
+	return T(s.differ), err
+}
+
 // Used for distance, since shorter distance is better
 func insortPersistentHeapAscending[T c.Float](
 	slice []minPersistentHeapElement[T],
@@ -219,27 +321,80 @@ func cannotConvertToUintSlice(s string) error {
 type SimilarityType[T c.Float] struct {
 	indexType     string
 	distanceScore func(v, w []T, floatBits int) (T, error)
-	insortHeap    func(slice []minPersistentHeapElement[T], val minPersistentHeapElement[T]) []minPersistentHeapElement[T]
-	isBetterScore func(a, b T) bool
+	// quantizedScore computes the same score as distanceScore on vectors
+	// stored with a quantized encoding, without decoding them.
+	quantizedScore func(v, w []byte, encoding string) (T, error)
+	insortHeap     func(slice []minPersistentHeapElement[T], val minPersistentHeapElement[T]) []minPersistentHeapElement[T]
+	isBetterScore  func(a, b T) bool
+	// toDistance converts a score produced by distanceScore into a
+	// distance, where smaller values always mean closer vectors.
+	toDistance func(score T) float64
 }
 
 func GetSimType[T c.Float](indexType string, floatBits int) SimilarityType[T] {
 	switch {
 	case indexType == Euclidian:
 		return SimilarityType[T]{indexType: Euclidian, distanceScore: euclidianDistanceSq[T],
-			insortHeap: insortPersistentHeapAscending[T], isBetterScore: isBetterScoreForDistance[T]}
+			insortHeap: insortPersistentHeapAscending[T], isBetterScore: isBetterScoreForDistance[T],
+			toDistance: scoreAsDistance[T], quantizedScore: euclidianDistanceSqQuantized[T]}
 	case indexType == Cosine:
 		return SimilarityType[T]{indexType: Cosine, distanceScore: cosineSimilarity[T],
-			insortHeap: insortPersistentHeapDescending[T], isBetterScore: isBetterScoreForSimilarity[T]}
+			insortHeap: insortPersistentHeapDescending[T], isBetterScore: isBetterScoreForSimilarity[T],
+			toDistance: similarityAsDistance[T], quantizedScore: cosineSimilarityQuantized[T]}
 	case indexType == DotProd:
 		return SimilarityType[T]{indexType: DotProd, distanceScore: dotProduct[T],
-			insortHeap: insortPersistentHeapDescending[T], isBetterScore: isBetterScoreForSimilarity[T]}
+			insortHeap: insortPersistentHeapDescending[T], isBetterScore: isBetterScoreForSimilarity[T],
+			toDistance: similarityAsDistance[T], quantizedScore: dotProductQuantized[T]}
+	case indexType == Hamming:
+		return SimilarityType[T]{indexType: Hamming, distanceScore: hammingDistance[T],
+			insortHeap: insortPersistentHeapAscending[T], isBetterScore: isBetterScoreForDistance[T],
+			toDistance: scoreAsDistance[T], quantizedScore: hammingDistanceQuantized[T]}
 	default:
 		return SimilarityType[T]{indexType: Euclidian, distanceScore: euclidianDistanceSq[T],
-			insortHeap: insortPersistentHeapAscending[T], isBetterScore: isBetterScoreForDistance[T]}
+			insortHeap: insortPersistentHeapAscending[T], isBetterScore: isBetterScoreForDistance[T],
+			toDistance: scoreAsDistance[T], quantizedScore: euclidianDistanceSqQuantized[T]}
 	}
 }
 
+// Name returns the name of the metric, as used in the index options.
+func (s SimilarityType[T]) Name() string {
+	return s.indexType
+}
+
+// Score computes the score of v and w according to the metric.
+func (s SimilarityType[T]) Score(v, w []T, floatBits int) (T, error) {
+	return s.distanceScore(v, w, floatBits)
+}
+
+// ScoreQuantized computes the score of v and w according to the metric,
+// where v and w are stored with the given quantized encoding.
+func (s SimilarityType[T]) ScoreQuantized(v, w []byte, encoding string) (T, error) {
+	return s.quantizedScore(v, w, encoding)
+}
+
+// IsBetterScore returns true if score a means closer vectors than score b.
+func (s SimilarityType[T]) IsBetterScore(a, b T) bool {
+	return s.isBetterScore(a, b)
+}
+
+// ToDistance converts a score into a distance, where smaller values always
+// mean closer vectors.
+func (s SimilarityType[T]) ToDistance(score T) float64 {
+	return s.toDistance(score)
+}
+
+// scoreAsDistance is used by metrics whose score already is a distance.
+func scoreAsDistance[T c.Float](score T) float64 {
+	return float64(score)
+}
+
+// similarityAsDistance maps a similarity score in [-1, 1] (cosine, or dot
+// product of normalized vectors) to a distance in [0, 1]. This matches the
+// vector_distance reported by the GraphQL similarity queries.
+func similarityAsDistance[T c.Float](score T) float64 {
+	return (1.0 - float64(score)) / 2.0
+}
+
 // implements CacheType interface
 type TxnCache struct {
 	txn     index.Txn
@@ -258,6 +413,15 @@ func (tc *TxnCache) Find(prefix []byte, filter func([]byte) bool) (uint64, error
 	return tc.txn.Find(prefix, filter)
 }
 
+func (tc *TxnCache) Uids(key []byte) ([]uint64, error) {
+	return tc.txn.Uids(key)
+}
+
+// Txn returns the transaction backing tc.
+func (tc *TxnCache) Txn() index.Txn {
+	return tc.txn
+}
+
 func NewTxnCache(txn index.Txn, startTs uint64) *TxnCache {
 	return &TxnCache{
 		txn:     txn,
@@ -283,6 +447,10 @@ func (qc *QueryCache) Ts() uint64 {
 	return qc.readTs
 }
 
+func (qc *QueryCache) Uids(key []byte) ([]uint64, error) {
+	return qc.cache.Uids(key)
+}
+
 func NewQueryCache(cache index.LocalCache, readTs uint64) *QueryCache {
 	return &QueryCache{
 		cache:  cache,
@@ -378,7 +546,7 @@ func (ph *persistentHNSW[T]) getVecFromUid(uid uint64, c index.CacheType, vec *[
 		return err
 	}
 	if data != nil {
-		index.BytesAsFloatArray(data.([]byte), vec, ph.floatBits)
+		index.DecodeVector(data.([]byte), vec, ph.floatBits, ph.encoding)
 		return nil
 
 	} else {
@@ -387,6 +555,65 @@ func (ph *persistentHNSW[T]) getVecFromUid(uid uint64, c index.CacheType, vec *[
 	}
 }
 
+// vector is a vector as the index compares it. The vectors stored with a
+// quantized encoding are kept as the bytes they are stored as, and scored on
+// those bytes (see SimilarityType.quantizedScore), so that they are never
+// expanded into floats. The other vectors are kept as floats.
+type vector[T c.Float] struct {
+	floats  []T
+	encoded []byte
+}
+
+func (v vector[T]) empty() bool {
+	return len(v.floats) == 0 && len(v.encoded) == 0
+}
+
+// queryVector returns query as a vector to compare with the vectors of the
+// index, quantizing it once if they are quantized. The floats of query are
+// kept either way, for the search filters.
+func (ph *persistentHNSW[T]) queryVector(query []T) vector[T] {
+	v := vector[T]{floats: query}
+	if index.IsQuantized(ph.encoding) && len(query) > 0 {
+		v.encoded = index.EncodeVector(query, ph.encoding)
+	}
+	return v
+}
+
+// loadVector reads the vector of uid into vec, like getVecFromUid, without
+// decoding it if it is quantized.
+func (ph *persistentHNSW[T]) loadVector(uid uint64, c index.CacheType, vec *vector[T]) error {
+	if !index.IsQuantized(ph.encoding) {
+		vec.encoded = nil
+		return ph.getVecFromUid(uid, c, &vec.floats)
+	}
+	vec.floats, vec.encoded = nil, nil
+	data, err := getDataFromKeyWithCacheType(ph.pred, uid, c)
+	if err != nil && !strings.Contains(err.Error(), plError) {
+		return err
+	}
+	if err != nil || data == nil {
+		return errors.New("Nil vector returned")
+	}
+	vec.encoded = data.([]byte)
+	return nil
+}
+
+// score computes the score of v and w according to the metric of the index.
+func (ph *persistentHNSW[T]) score(v, w vector[T]) (T, error) {
+	if index.IsQuantized(ph.encoding) {
+		return ph.simType.quantizedScore(v.encoded, w.encoded, ph.encoding)
+	}
+	return ph.simType.distanceScore(v.floats, w.floats, ph.floatBits)
+}
+
+// sameVector returns true if v and w are the same vector for the index.
+func (ph *persistentHNSW[T]) sameVector(v, w vector[T]) bool {
+	if index.IsQuantized(ph.encoding) {
+		return bytes.Equal(v.encoded, w.encoded)
+	}
+	return isEqual(v.floats, w.floats)
+}
+
 // chooses whether to create the entry and start nodes based on if it already
 // exists, and if it hasnt been created yet, it adds the startNode to all
 // levels.
@@ -394,7 +621,7 @@ func (ph *persistentHNSW[T]) createEntryAndStartNodes(
 	ctx context.Context,
 	c *TxnCache,
 	inUuid uint64,
-	vec *[]T) (uint64, []*index.KeyValue, error) {
+	vec *vector[T]) (uint64, []*index.KeyValue, error) {
 	txn := c.txn
 	edges := []*index.KeyValue{}
 	entryKey := DataKey(ph.vecEntryKey, 1) // 0-profile_vector_entry
@@ -418,8 +645,8 @@ func (ph *persistentHNSW[T]) createEntryAndStartNodes(
 	}
 
 	entry := BytesToUint64(data.([]byte)) // convert entry Uuid returned from Get to uint64
-	err := ph.getVecFromUid(entry, c, vec)
-	if err != nil || len(*vec) == 0 {
+	err := ph.loadVector(entry, c, vec)
+	if err != nil || vec.empty() {
 		// The entry vector has been deleted. We have to create a new entry vector.
 		entry, err := ph.calculateNewEntryVec(ctx, c, vec)
 		if err != nil {
@@ -613,7 +840,7 @@ func (ph *persistentHNSW[T]) addNeighbors(ctx context.Context, tc *TxnCache,
 			}
 		}
 	}
-	var inVec, outVec []T
+	var inVec vector[T]
 	for level := 0; level < ph.maxLevels; level++ {
 		allLayerEdges[level], nnEdgesErr = ph.removeDeadNodes(allLayerEdges[level], tc)
 		if nnEdgesErr != nil {
@@ -622,23 +849,13 @@ func (ph *persistentHNSW[T]) addNeighbors(ctx context.Context, tc *TxnCache,
 		// This adds at most efConstruction number of edges for each layer for this node
 		allLayerEdges[level] = append(allLayerEdges[level], allLayerNeighbors[level]...)
 		if len(allLayerEdges[level]) > ph.efConstruction {
-			err := ph.getVecFromUid(uuid, tc, &inVec)
+			err := ph.loadVector(uuid, tc, &inVec)
 			if err != nil {
 				log.Printf("[ERROR] While getting vector %s", err)
+				allLayerEdges[level] = allLayerEdges[level][:ph.efConstruction]
 			} else {
-				h := &HeapDataHolder{
-					data: allLayerEdges[level],
-					compare: func(i, j uint64) bool {
-						return ph.distance_betw(ctx, tc, uuid, i, &inVec, &outVec) >
-							ph.distance_betw(ctx, tc, uuid, j, &inVec, &outVec)
-					}}
-
-				for _, e := range allLayerNeighbors[level] {
-					heap.Push(h, e)
-					heap.Pop(h)
-				}
+				allLayerEdges[level] = ph.closestNeighbors(tc, inVec, allLayerEdges[level])
 			}
-			allLayerEdges[level] = allLayerEdges[level][:ph.efConstruction]
 		}
 	}
 
@@ -660,25 +877,8 @@ func (ph *persistentHNSW[T]) addNeighbors(ctx context.Context, tc *TxnCache,
 
 // removeDeadNodes(nnEdges, tc) removes dead nodes from nnEdges and returns the new nnEdges
 func (ph *persistentHNSW[T]) removeDeadNodes(nnEdges []uint64, tc *TxnCache) ([]uint64, error) {
-	// TODO add a path to delete deadNodes
-	if ph.deadNodes == nil {
-		data, err := getDataFromKeyWithCacheType(ph.vecDead, 1, tc)
-		if err != nil && err.Error() == plError {
-			return []uint64{}, err
-		}
-
-		var deadNodes []uint64
-		if data != nil { // if dead nodes exist, convert to []uint64
-			deadNodes, err = ParseEdges(string(data.([]byte)))
-			if err != nil {
-				return []uint64{}, err
-			}
-		}
-
-		ph.deadNodes = make(map[uint64]struct{})
-		for _, n := range deadNodes {
-			ph.deadNodes[n] = struct{}{}
-		}
+	if err := ph.loadDeadNodes(tc); err != nil {
+		return []uint64{}, err
 	}
 	if len(ph.deadNodes) == 0 {
 		return nnEdges, nil
@@ -694,6 +894,67 @@ func (ph *persistentHNSW[T]) removeDeadNodes(nnEdges []uint64, tc *TxnCache) ([]
 	return diff, nil
 }
 
+// loadDeadNodes(c) reads the dead nodes into ph.deadNodes, unless they
+// have already been read.
+func (ph *persistentHNSW[T]) loadDeadNodes(c index.CacheType) error {
+	if ph.deadNodes != nil {
+		return nil
+	}
+	data, err := getDataFromKeyWithCacheType(ph.vecDead, 1, c)
+	if err != nil && err.Error() == plError {
+		return err
+	}
+
+	var deadNodes []uint64
+	if data != nil { // if dead nodes exist, convert to []uint64
+		deadNodes, err = ParseEdges(string(data.([]byte)))
+		if err != nil {
+			return err
+		}
+	}
+
+	ph.deadNodes = make(map[uint64]struct{})
+	for _, n := range deadNodes {
+		ph.deadNodes[n] = struct{}{}
+	}
+	return nil
+}
+
+// closestNeighbors(tc, vec, neighbors) returns the (at most) efConstruction
+// uids of neighbors whose vectors are the closest to vec, closest first.
+// Neighbors without a vector and duplicate neighbors are dropped.
+func (ph *persistentHNSW[T]) closestNeighbors(tc *TxnCache, vec vector[T],
+	neighbors []uint64) []uint64 {
+	scored := make([]minPersistentHeapElement[T], 0, len(neighbors))
+	seen := make(map[uint64]struct{}, len(neighbors))
+	var nVec vector[T]
+	for _, n := range neighbors {
+		if _, ok := seen[n]; ok {
+			continue
+		}
+		seen[n] = struct{}{}
+		if err := ph.loadVector(n, tc, &nVec); err != nil || nVec.empty() {
+			continue
+		}
+		d, err := ph.score(vec, nVec)
+		if err != nil {
+			continue
+		}
+		scored = append(scored, minPersistentHeapElement[T]{value: d, index: n})
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		return ph.simType.isBetterScore(scored[i].value, scored[j].value)
+	})
+	if len(scored) > ph.efConstruction {
+		scored = scored[:ph.efConstruction]
+	}
+	closest := make([]uint64, 0, len(scored))
+	for _, e := range scored {
+		closest = append(closest, e.index)
+	}
+	return closest
+}
+
 func Uint64ToBytes(key uint64) []byte {
 	b := make([]byte, 8)
 	binary.BigEndian.PutUint64(b, key)
@@ -21,6 +21,7 @@ package hnsw
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -42,6 +43,8 @@ type persistentHNSW[T c.Float] struct {
 	vecDead        string
 	simType        SimilarityType[T]
 	floatBits      int
+	// encoding tells how the vectors are stored, see index.DecodeVector.
+	encoding string
 	// nodeAllEdges[65443][1][3] indicates the 3rd neighbor in the first
 	// layer for uuid 65443. The result will be a neighboring uuid.
 	nodeAllEdges map[uint64][][]uint64
@@ -112,6 +115,17 @@ func (ph *persistentHNSW[T]) applyOptions(o opt.Options) error {
 	if err != nil {
 		return err
 	}
+	ph.encoding, _, err = opt.GetOpt(o, index.VectorEncodingOpt, index.FloatEncoding)
+	if err != nil {
+		return err
+	}
+	multiVector, _, err := opt.GetOpt(o, index.MultiVectorOpt, false)
+	if err != nil {
+		return err
+	}
+	if multiVector {
+		ph.pred = ConcatStrings(ph.pred, VecMulti)
+	}
 	simType, foundSimType := opt.GetInterfaceOpt(o, MetricOpt)
 	if foundSimType {
 		okSimType, ok := simType.(SimilarityType[T])
@@ -120,8 +134,7 @@ func (ph *persistentHNSW[T]) applyOptions(o opt.Options) error {
 		}
 		ph.simType = okSimType
 	} else {
-		ph.simType = SimilarityType[T]{indexType: Euclidian, distanceScore: euclidianDistanceSq[T],
-			insortHeap: insortPersistentHeapAscending[T], isBetterScore: isBetterScoreForDistance[T]}
+		ph.simType = GetSimType[T](Euclidian, ph.floatBits)
 	}
 	return nil
 }
@@ -167,13 +180,13 @@ func (ph *persistentHNSW[T]) searchPersistentLayer(
 	c index.CacheType,
 	level int,
 	entry uint64,
-	startVec, query []T,
+	startVec, query vector[T],
 	entryIsFilteredOut bool,
 	expectedNeighbors int,
 	filter index.SearchFilter[T]) (*searchLayerResult[T], error) {
 	r := newLayerResult[T](level)
 
-	bestDist, err := ph.simType.distanceScore(startVec, query, ph.floatBits)
+	bestDist, err := ph.score(startVec, query)
 	r.markFirstDistanceComputation()
 	if err != nil {
 		return ph.emptySearchResultWithError(err)
@@ -212,7 +225,7 @@ func (ph *persistentHNSW[T]) searchPersistentLayer(
 		if !found {
 			continue
 		}
-		var eVec []T
+		var eVec vector[T]
 		improved := false
 		for _, currUid := range allLayerEdges[level] {
 			if ph.visitedUids.Test(uint(currUid)) {
@@ -223,17 +236,17 @@ func (ph *persistentHNSW[T]) searchPersistentLayer(
 			}
 			// iterate over candidate's neighbors distances to get
 			// best ones
-			_ = ph.getVecFromUid(currUid, c, &eVec)
+			_ = ph.loadVector(currUid, c, &eVec)
 			// intentionally ignoring error -- we catch it
-			// indirectly via eVec == nil check.
-			if len(eVec) == 0 {
+			// indirectly via eVec.empty() check.
+			if eVec.empty() {
 				continue
 			}
-			currDist, err := ph.simType.distanceScore(eVec, query, ph.floatBits)
+			currDist, err := ph.score(eVec, query)
 			if err != nil {
 				return ph.emptySearchResultWithError(err)
 			}
-			filteredOut := !filter(query, eVec, currUid)
+			filteredOut := !filter(query.floats, eVec.floats, currUid)
 			currElement := initPersistentHeapElement(
 				currDist, currUid, filteredOut)
 			r.addToVisited(*currElement)
@@ -277,34 +290,49 @@ func (ph *persistentHNSW[T]) Search(ctx context.Context, c index.CacheType, quer
 // and returns the traversal path and the nearest neighbors
 func (ph *persistentHNSW[T]) SearchWithUid(ctx context.Context, c index.CacheType, queryUid uint64,
 	maxResults int, filter index.SearchFilter[T]) (nnUids []uint64, err error) {
-	var queryVec []T
-	err = ph.getVecFromUid(queryUid, c, &queryVec)
+	r, err := ph.SearchWithUidAndPath(ctx, c, queryUid, maxResults, filter)
+	return r.Neighbors, err
+}
+
+// SearchWithUidAndPath allows persistentHNSW to implement index.OptionalIndexSupport.
+// See index.OptionalIndexSupport.SearchWithUidAndPath for more info.
+func (ph *persistentHNSW[T]) SearchWithUidAndPath(ctx context.Context, c index.CacheType,
+	queryUid uint64, maxResults int, filter index.SearchFilter[T]) (*index.SearchPathResult, error) {
+	start := time.Now().UnixMilli()
+	r := index.NewSearchPathResult()
+
+	var queryVec vector[T]
+	err := ph.loadVector(queryUid, c, &queryVec)
 	if err != nil {
 		if strings.Contains(err.Error(), plError) {
 			// No vector. return empty result
-			return []uint64{}, nil
+			return r, nil
 		}
-		return []uint64{}, err
+		return ph.emptyFinalResultWithError(err)
 	}
 
-	if len(queryVec) == 0 {
+	if queryVec.empty() {
 		// No vector. return empty result
-		return []uint64{}, nil
+		return r, nil
 	}
 
-	shouldFilterOutQueryVec := !filter(queryVec, queryVec, queryUid)
+	shouldFilterOutQueryVec := !filter(queryVec.floats, queryVec.floats, queryUid)
 
 	// how normal search works is by cotinuously searching higher layers
 	// for the best entry node to the last layer since we already know the
 	// best entry node (since it already exists in the lowest level), we
 	// can just search the last layer and return the results.
-	r, err := ph.searchPersistentLayer(
+	layerResult, err := ph.searchPersistentLayer(
 		c, ph.maxLevels-1, queryUid, queryVec, queryVec,
-		shouldFilterOutQueryVec, maxResults, filter)
-	for _, n := range r.neighbors {
-		nnUids = append(nnUids, n.index)
+		shouldFilterOutQueryVec, max(ph.efSearch, maxResults), filter)
+	if err != nil {
+		return ph.emptyFinalResultWithError(err)
 	}
-	return nnUids, err
+	layerResult.updateFinalMetrics(r)
+	layerResult.updateFinalPath(r)
+	layerResult.addFinalNeighbors(r, ph.simType, maxResults)
+	r.Metrics[searchTime] = uint64(time.Now().UnixMilli() - start)
+	return r, nil
 }
 
 // There will be times when the entry node has been deleted. In that case, we want to make a new node
@@ -312,11 +340,15 @@ func (ph *persistentHNSW[T]) SearchWithUid(ctx context.Context, c index.CacheTyp
 func (ph *persistentHNSW[T]) calculateNewEntryVec(
 	ctx context.Context,
 	c index.CacheType,
-	startVec *[]T) (uint64, error) {
+	startVec *vector[T]) (uint64, error) {
 
 	itr, err := c.Find([]byte(ph.pred), func(value []byte) bool {
-		index.BytesAsFloatArray(value, startVec, ph.floatBits)
-		return len(*startVec) != 0
+		if index.IsQuantized(ph.encoding) {
+			startVec.encoded = append(startVec.encoded[:0], value...)
+		} else {
+			index.DecodeVector(value, &startVec.floats, ph.floatBits, ph.encoding)
+		}
+		return !startVec.empty()
 	})
 
 	if err != nil {
@@ -332,7 +364,7 @@ func (ph *persistentHNSW[T]) calculateNewEntryVec(
 func (ph *persistentHNSW[T]) PickStartNode(
 	ctx context.Context,
 	c index.CacheType,
-	startVec *[]T) (uint64, error) {
+	startVec *vector[T]) (uint64, error) {
 
 	data, err := getDataFromKeyWithCacheType(ph.vecEntryKey, 1, c)
 	if err != nil {
@@ -344,12 +376,12 @@ func (ph *persistentHNSW[T]) PickStartNode(
 	}
 
 	entry := BytesToUint64(data.([]byte))
-	err = ph.getVecFromUid(entry, c, startVec)
+	err = ph.loadVector(entry, c, startVec)
 	if err != nil {
 		fmt.Println(err)
 	}
 
-	if len(*startVec) == 0 {
+	if startVec.empty() {
 		return ph.calculateNewEntryVec(ctx, c, startVec)
 	}
 	return entry, err
@@ -369,21 +401,22 @@ func (ph *persistentHNSW[T]) SearchWithPath(
 	ph.visitedUids.ClearAll()
 
 	// 0-profile_vector_entry
-	var startVec []T
+	var startVec vector[T]
 	entry, err := ph.PickStartNode(ctx, c, &startVec)
 	if err != nil {
 		return ph.emptyFinalResultWithError(err)
 	}
+	queryVec := ph.queryVector(query)
 
 	// Calculates best entry for last level (maxLevels-1) by searching each
 	// layer and using new best entry.
 	for level := 0; level < ph.maxLevels-1; level++ {
-		if isEqual(startVec, query) {
+		if ph.sameVector(startVec, queryVec) {
 			break
 		}
-		filterOut := !filter(query, startVec, entry)
+		filterOut := !filter(query, startVec.floats, entry)
 		layerResult, err := ph.searchPersistentLayer(
-			c, level, entry, startVec, query, filterOut, ph.efSearch, filter)
+			c, level, entry, startVec, queryVec, filterOut, ph.efSearch, filter)
 		if err != nil {
 			return ph.emptyFinalResultWithError(err)
 		}
@@ -391,26 +424,159 @@ func (ph *persistentHNSW[T]) SearchWithPath(
 		entry = layerResult.bestNeighbor().index
 
 		layerResult.updateFinalPath(r)
-		err = ph.getVecFromUid(entry, c, &startVec)
+		err = ph.loadVector(entry, c, &startVec)
 		if err != nil {
 			return ph.emptyFinalResultWithError(err)
 		}
 	}
-	filterOut := !filter(query, startVec, entry)
+	// Nodes visited on the upper layers but not picked as entry must remain
+	// reachable on the last one, or a filter could reject every node left.
+	// The last layer keeps efSearch candidates, so that a larger efSearch
+	// trades latency for recall, and only the best maxResults are returned.
+	ph.visitedUids.ClearAll()
+	filterOut := !filter(query, startVec.floats, entry)
 	layerResult, err := ph.searchPersistentLayer(
-		c, ph.maxLevels-1, entry, startVec, query, filterOut, maxResults, filter)
+		c, ph.maxLevels-1, entry, startVec, queryVec, filterOut, max(ph.efSearch, maxResults), filter)
 	if err != nil {
 		return ph.emptyFinalResultWithError(err)
 	}
 	layerResult.updateFinalMetrics(r)
 	layerResult.updateFinalPath(r)
-	layerResult.addFinalNeighbors(r)
+	layerResult.addFinalNeighbors(r, ph.simType, maxResults)
 	t := time.Now().UnixMilli()
 	elapsed := t - start
 	r.Metrics[searchTime] = uint64(elapsed)
 	return r, nil
 }
 
+// SearchExact allows persistentHNSW to implement index.OptionalIndexSupport.
+// See index.OptionalIndexSupport.SearchExact for more info.
+func (ph *persistentHNSW[T]) SearchExact(
+	ctx context.Context,
+	c index.CacheType,
+	query []T,
+	candidates []uint64,
+	maxResults int) (*index.SearchPathResult, error) {
+	return ph.searchExact(ctx, c, ph.queryVector(query), candidates, maxResults)
+}
+
+// searchExact scores each of the candidates against query, see SearchExact.
+func (ph *persistentHNSW[T]) searchExact(
+	ctx context.Context,
+	c index.CacheType,
+	query vector[T],
+	candidates []uint64,
+	maxResults int) (*index.SearchPathResult, error) {
+	start := time.Now().UnixMilli()
+	r := index.NewSearchPathResult()
+
+	// The candidates are not part of any layer, we reuse the layer result
+	// only to keep the best maxResults of them in order.
+	layerResult := newLayerResult[T](ph.maxLevels - 1)
+	var vec vector[T]
+	for _, uid := range candidates {
+		if err := ctx.Err(); err != nil {
+			return ph.emptyFinalResultWithError(err)
+		}
+		// intentionally ignoring error -- candidates without a vector
+		// are caught via the vec.empty() check.
+		_ = ph.loadVector(uid, c, &vec)
+		if vec.empty() {
+			continue
+		}
+		dist, err := ph.score(vec, query)
+		if err != nil {
+			return ph.emptyFinalResultWithError(err)
+		}
+		elem := *initPersistentHeapElement(dist, uid, false)
+		layerResult.incrementDistanceComputations()
+		layerResult.addToVisited(elem)
+		layerResult.neighbors = ph.simType.insortHeap(layerResult.neighbors, elem)
+		if len(layerResult.neighbors) > maxResults {
+			layerResult.neighbors = layerResult.neighbors[:maxResults]
+		}
+	}
+	layerResult.updateFinalMetrics(r)
+	layerResult.addFinalNeighbors(r, ph.simType, maxResults)
+	r.Metrics[searchTime] = uint64(time.Now().UnixMilli() - start)
+	return r, nil
+}
+
+// SearchExactWithUid allows persistentHNSW to implement index.OptionalIndexSupport.
+// See index.OptionalIndexSupport.SearchExactWithUid for more info.
+func (ph *persistentHNSW[T]) SearchExactWithUid(
+	ctx context.Context,
+	c index.CacheType,
+	queryUid uint64,
+	candidates []uint64,
+	maxResults int) (*index.SearchPathResult, error) {
+	var queryVec vector[T]
+	err := ph.loadVector(queryUid, c, &queryVec)
+	if err != nil {
+		if strings.Contains(err.Error(), plError) {
+			// No vector. return empty result
+			return index.NewSearchPathResult(), nil
+		}
+		return ph.emptyFinalResultWithError(err)
+	}
+	if queryVec.empty() {
+		// No vector. return empty result
+		return index.NewSearchPathResult(), nil
+	}
+	return ph.searchExact(ctx, c, queryVec, candidates, maxResults)
+}
+
+// GraphStats describes the shape of a persistent hnsw graph.
+type GraphStats struct {
+	// Nodes is the number of nodes that have edges stored for them.
+	Nodes uint64
+	// Layers is the number of layers that contain at least one node.
+	Layers int
+	// Edges is the number of edges over all layers.
+	Edges uint64
+	// AvgDegree is the average number of neighbors in the bottom layer.
+	AvgDegree float64
+}
+
+// GraphStats(c, uids) walks the edges of the given nodes and reports the
+// shape of the graph they form. Nodes without stored edges are skipped.
+func (ph *persistentHNSW[T]) GraphStats(c index.CacheType, uids []uint64) (GraphStats, error) {
+	var stats GraphStats
+	var bottomEdges uint64
+	topLevel := ph.maxLevels
+	for _, uid := range uids {
+		var edges [][]uint64
+		ok, err := populateEdgeDataFromKeyWithCacheType(ph.vecKey, uid, c, &edges)
+		if err != nil {
+			if strings.Contains(err.Error(), plError) {
+				// No edges, the node was never inserted.
+				continue
+			}
+			return GraphStats{}, err
+		}
+		if !ok {
+			continue
+		}
+		stats.Nodes++
+		for level, row := range edges {
+			stats.Edges += uint64(len(row))
+			if len(row) > 0 && level < topLevel {
+				topLevel = level
+			}
+		}
+		if len(edges) == ph.maxLevels {
+			bottomEdges += uint64(len(edges[ph.maxLevels-1]))
+		}
+	}
+	if stats.Nodes == 0 {
+		return stats, nil
+	}
+	// A single node has no neighbors but still forms the bottom layer.
+	stats.Layers = max(ph.maxLevels-topLevel, 1)
+	stats.AvgDegree = float64(bottomEdges) / float64(stats.Nodes)
+	return stats, nil
+}
+
 // InsertToPersistentStorage inserts a node into the hnsw graph and returns the
 // traversal path and the edges created
 func (ph *persistentHNSW[T]) Insert(ctx context.Context, c index.CacheType,
@@ -423,13 +589,191 @@ func (ph *persistentHNSW[T]) Insert(ctx context.Context, c index.CacheType,
 	return edges, err
 }
 
+// Update replaces the vector of inUuid in the hnsw graph by inVec. The node
+// is removed from the graph first, as its previous neighbors are unlikely to
+// remain its nearest ones, and then inserted again.
+func (ph *persistentHNSW[T]) Update(ctx context.Context, c index.CacheType,
+	inUuid uint64, inVec []T) ([]*index.KeyValue, error) {
+	tc, ok := c.(*TxnCache)
+	if !ok {
+		return []*index.KeyValue{}, nil
+	}
+	edges, err := ph.deleteHelper(ctx, tc, inUuid)
+	if err != nil {
+		return []*index.KeyValue{}, err
+	}
+	insertEdges, err := ph.Insert(ctx, c, inUuid, inVec)
+	if err != nil {
+		return []*index.KeyValue{}, err
+	}
+	return append(edges, insertEdges...), nil
+}
+
+// Delete removes inUuid from the hnsw graph. The neighbors of inUuid are
+// connected to each other in its place, so that the graph stays navigable.
+// The edges towards inUuid from the nodes that aren't its neighbors are left
+// as is: searches skip nodes without a vector, and closestNeighbors drops
+// them as soon as the edges of such a node overflow. Nothing has to be
+// recorded for the deleted node, so deletes only conflict with the
+// transactions updating the same nodes.
+func (ph *persistentHNSW[T]) Delete(ctx context.Context, c index.CacheType,
+	inUuid uint64) ([]*index.KeyValue, error) {
+	tc, ok := c.(*TxnCache)
+	if !ok {
+		return []*index.KeyValue{}, nil
+	}
+	return ph.deleteHelper(ctx, tc, inUuid)
+}
+
+// deleteHelper removes inUuid from the hnsw graph, as described by Delete.
+func (ph *persistentHNSW[T]) deleteHelper(ctx context.Context, tc *TxnCache,
+	inUuid uint64) ([]*index.KeyValue, error) {
+	txn := tc.txn
+	edges := []*index.KeyValue{}
+
+	// Detach the node from the graph.
+	var allLayerEdges [][]uint64
+	key := DataKey(ph.vecKey, inUuid)
+	txn.LockKey(key)
+	data, _ := txn.GetWithLockHeld(key)
+	if data == nil {
+		txn.UnlockKey(key)
+		return edges, nil
+	}
+	if err := decodeUint64MatrixUnsafe(data.([]byte), &allLayerEdges); err != nil {
+		txn.UnlockKey(key)
+		return []*index.KeyValue{}, err
+	}
+	emptyEdges := make([][]uint64, ph.maxLevels)
+	edge := &index.KeyValue{
+		Entity: inUuid,
+		Attr:   ph.vecKey,
+		Value:  encodeUint64MatrixUnsafe(emptyEdges),
+	}
+	err := txn.AddMutationWithLockHeld(ctx, key, edge)
+	txn.UnlockKey(key)
+	if err != nil {
+		return []*index.KeyValue{}, err
+	}
+	ph.nodeAllEdges[inUuid] = emptyEdges
+	edges = append(edges, edge)
+
+	// Reconnect its neighbors.
+	neighbors := map[uint64]struct{}{}
+	for _, layerEdges := range allLayerEdges {
+		for _, n := range layerEdges {
+			neighbors[n] = struct{}{}
+		}
+	}
+	delete(neighbors, inUuid)
+	for n := range neighbors {
+		edge, err := ph.repairNeighbor(ctx, tc, n, inUuid, allLayerEdges)
+		if err != nil {
+			return []*index.KeyValue{}, err
+		}
+		if edge != nil {
+			edges = append(edges, edge)
+		}
+	}
+
+	// Pick another entry if the node was the entry of the graph.
+	entryKey := DataKey(ph.vecEntryKey, 1)
+	txn.LockKey(entryKey)
+	entryData, _ := txn.GetWithLockHeld(entryKey)
+	if entryData != nil && BytesToUint64(entryData.([]byte)) == inUuid {
+		// The neighbors on the highest layer are the ones that are present on
+		// the most layers. If the node has no neighbor at all, the entry is
+		// left as is, and a new one gets picked when it is next needed.
+		var vec vector[T]
+	pickEntry:
+		for _, layerEdges := range allLayerEdges {
+			for _, n := range layerEdges {
+				// Neighbors without a vector have been deleted as well.
+				if n == inUuid || ph.loadVector(n, tc, &vec) != nil || vec.empty() {
+					continue
+				}
+				edge, err := entryUuidInsert(ctx, entryKey, txn, ph.vecEntryKey, Uint64ToBytes(n))
+				if err != nil {
+					txn.UnlockKey(entryKey)
+					return []*index.KeyValue{}, err
+				}
+				edges = append(edges, edge)
+				break pickEntry
+			}
+		}
+	}
+	txn.UnlockKey(entryKey)
+	return edges, nil
+}
+
+// repairNeighbor removes deleted from the edges of uuid on every layer. On
+// the layers where uuid was a neighbor of deleted, the other neighbors of
+// deleted (given by deletedEdges) become candidate neighbors of uuid, and
+// uuid keeps the closest of its candidates. It returns the edge written, or
+// nil if uuid is not in the graph.
+func (ph *persistentHNSW[T]) repairNeighbor(ctx context.Context, tc *TxnCache,
+	uuid, deleted uint64, deletedEdges [][]uint64) (*index.KeyValue, error) {
+	txn := tc.txn
+	key := DataKey(ph.vecKey, uuid)
+	txn.LockKey(key)
+	defer txn.UnlockKey(key)
+	data, _ := txn.GetWithLockHeld(key)
+	if data == nil {
+		return nil, nil
+	}
+	var allLayerEdges [][]uint64
+	if err := decodeUint64MatrixUnsafe(data.([]byte), &allLayerEdges); err != nil {
+		return nil, err
+	}
+
+	var vec vector[T]
+	for level := range allLayerEdges {
+		layerEdges := slices.DeleteFunc(allLayerEdges[level], func(n uint64) bool { return n == deleted })
+		if level < len(deletedEdges) && slices.Contains(deletedEdges[level], uuid) {
+			for _, n := range deletedEdges[level] {
+				if n != uuid && n != deleted && !slices.Contains(layerEdges, n) {
+					layerEdges = append(layerEdges, n)
+				}
+			}
+			var err error
+			if layerEdges, err = ph.removeDeadNodes(layerEdges, tc); err != nil {
+				return nil, err
+			}
+			if len(layerEdges) > ph.efConstruction {
+				if vec.empty() {
+					// A node without vector is already dead itself, its
+					// edges only need to be trimmed.
+					_ = ph.loadVector(uuid, tc, &vec)
+				}
+				if vec.empty() {
+					layerEdges = layerEdges[:ph.efConstruction]
+				} else {
+					layerEdges = ph.closestNeighbors(tc, vec, layerEdges)
+				}
+			}
+		}
+		allLayerEdges[level] = layerEdges
+	}
+
+	ph.nodeAllEdges[uuid] = allLayerEdges
+	edge := &index.KeyValue{
+		Entity: uuid,
+		Attr:   ph.vecKey,
+		Value:  encodeUint64MatrixUnsafe(allLayerEdges),
+	}
+	if err := txn.AddMutationWithLockHeld(ctx, key, edge); err != nil {
+		return nil, err
+	}
+	return edge, nil
+}
+
 // InsertToPersistentStorage inserts a node into the hnsw graph and returns the
 // traversal path and the edges created
 func (ph *persistentHNSW[T]) insertHelper(ctx context.Context, tc *TxnCache,
 	inUuid uint64, inVec []T) ([]minPersistentHeapElement[T], []*index.KeyValue, error) {
 
 	// return all the new edges created at all HNSW levels
-	var startVec []T
+	var startVec vector[T]
 	entry, edges, err := ph.createEntryAndStartNodes(ctx, tc, inUuid, &startVec)
 	if err != nil || len(edges) > 0 {
 		return []minPersistentHeapElement[T]{}, edges, err
@@ -453,14 +797,15 @@ func (ph *persistentHNSW[T]) insertHelper(ctx context.Context, tc *TxnCache,
 
 	ph.visitedUids.ClearAll()
 
+	queryVec := ph.queryVector(inVec)
 	for level := 0; level < inLevel; level++ {
 		// perform insertion for layers [level, max_level) only, when level < inLevel just find better start
-		err := ph.getVecFromUid(entry, tc, &startVec)
+		err := ph.loadVector(entry, tc, &startVec)
 		if err != nil {
 			return []minPersistentHeapElement[T]{}, []*index.KeyValue{}, err
 		}
 		layerResult, err := ph.searchPersistentLayer(tc, level, entry, startVec,
-			inVec, false, ph.efSearch, index.AcceptAll[T])
+			queryVec, false, ph.efSearch, index.AcceptAll[T])
 		if err != nil {
 			return []minPersistentHeapElement[T]{}, []*index.KeyValue{}, err
 		}
@@ -477,12 +822,12 @@ func (ph *persistentHNSW[T]) insertHelper(ctx context.Context, tc *TxnCache,
 	var inboundEdgesAllLayersMap = make(map[uint64][][]uint64)
 	nnUidArray := []uint64{}
 	for level := inLevel; level < ph.maxLevels; level++ {
-		err := ph.getVecFromUid(entry, tc, &startVec)
+		err := ph.loadVector(entry, tc, &startVec)
 		if err != nil {
 			return []minPersistentHeapElement[T]{}, []*index.KeyValue{}, err
 		}
 		layerResult, err := ph.searchPersistentLayer(tc, level, entry, startVec,
-			inVec, false, ph.efConstruction, index.AcceptAll[T])
+			queryVec, false, ph.efConstruction, index.AcceptAll[T])
 		if err != nil {
 			return []minPersistentHeapElement[T]{}, []*index.KeyValue{}, layerErr
 		}
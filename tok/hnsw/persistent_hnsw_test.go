@@ -21,6 +21,7 @@ package hnsw
 import (
 	"context"
 	"fmt"
+	"math"
 	"sync"
 	"testing"
 
@@ -562,3 +563,462 @@ func TestBasicSearchPersistentFlatStorage(t *testing.T) {
 		}
 	}
 }
+
+func TestSearchWithPathDistances(t *testing.T) {
+	for _, flatPh := range flatPhs {
+		emptyTsDbs()
+		err := flatPopulateInserts(flatPopulateBasicInsertsForSearch, flatPh)
+		if err != nil {
+			t.Errorf("Error populating inserts: %s", err)
+			return
+		}
+		for _, test := range searchPersistentFlatStorageTests {
+			r, err := flatPh.SearchWithPath(context.TODO(), test.qc, test.query,
+				test.maxResults, index.AcceptAll[float64])
+			if err != nil {
+				t.Errorf("Error searching: %s", err)
+				return
+			}
+			if len(r.Distances) != len(r.Neighbors) {
+				t.Errorf("Expected %d distances, Got: %d", len(r.Neighbors), len(r.Distances))
+				return
+			}
+			// Every query vector is present in the index, so its nearest
+			// neighbor is itself.
+			expected, err := flatPh.simType.distanceScore(test.query, test.query, flatPh.floatBits)
+			if err != nil {
+				t.Errorf("Error computing distance: %s", err)
+				return
+			}
+			if d := flatPh.simType.toDistance(expected); r.Distances[0] != d {
+				t.Errorf("Distance expected value: %v, Got: %v", d, r.Distances[0])
+			}
+		}
+	}
+}
+
+func TestSearchWithUidAndPathDistances(t *testing.T) {
+	flatPh := flatPhs[0]
+	emptyTsDbs()
+	err := flatPopulateInserts(flatPopulateBasicInsertsForSearch, flatPh)
+	if err != nil {
+		t.Errorf("Error populating inserts: %s", err)
+		return
+	}
+	qc := NewQueryCache(&inMemLocalCache{readTs: 93}, 93)
+	r, err := flatPh.SearchWithUidAndPath(context.TODO(), qc, 123, 3, index.AcceptAll[float64])
+	if err != nil {
+		t.Errorf("Error searching: %s", err)
+		return
+	}
+	if len(r.Neighbors) == 0 || r.Neighbors[0] != 123 {
+		t.Errorf("Expected 123 to be the nearest neighbor, Got: %v", r.Neighbors)
+		return
+	}
+	if !slices.IsSorted(r.Distances) {
+		t.Errorf("Expected distances to be in increasing order, Got: %v", r.Distances)
+	}
+	if r.Distances[0] != 0 {
+		t.Errorf("Distance expected value: 0, Got: %v", r.Distances[0])
+	}
+}
+
+func TestSearchExact(t *testing.T) {
+	flatPh := flatPhs[0]
+	emptyTsDbs()
+	err := flatPopulateInserts(flatPopulateBasicInsertsForSearch, flatPh)
+	if err != nil {
+		t.Errorf("Error populating inserts: %s", err)
+		return
+	}
+	qc := NewQueryCache(&inMemLocalCache{readTs: 93}, 93)
+	// 123 is the nearest neighbor of the query, but it isn't a candidate.
+	// 999 has no vector and must be skipped.
+	query := []float64{0.824, 0.319, 0.111}
+	r, err := flatPh.SearchExact(context.TODO(), qc, query, []uint64{1, 5, 999}, 3)
+	if err != nil {
+		t.Errorf("Error searching: %s", err)
+		return
+	}
+	if !equalUint64Slice(r.Neighbors, []uint64{5, 1}) {
+		t.Errorf("Nearest neighbors expected value: %v, Got: %v", []uint64{5, 1}, r.Neighbors)
+	}
+	if len(r.Distances) != len(r.Neighbors) || !slices.IsSorted(r.Distances) {
+		t.Errorf("Expected distances in increasing order for %v, Got: %v", r.Neighbors, r.Distances)
+	}
+
+	r, err = flatPh.SearchExactWithUid(context.TODO(), qc, 123, []uint64{1, 5, 123}, 1)
+	if err != nil {
+		t.Errorf("Error searching: %s", err)
+		return
+	}
+	if !equalUint64Slice(r.Neighbors, []uint64{123}) {
+		t.Errorf("Nearest neighbors expected value: %v, Got: %v", []uint64{123}, r.Neighbors)
+	}
+}
+
+func TestSearchWithPathFilter(t *testing.T) {
+	// Use a fresh index, as the edges cached by flatPhs[0] refer to the
+	// graphs of the previous tests.
+	flatPh := *flatPhs[0]
+	flatPh.nodeAllEdges = make(map[uint64][][]uint64)
+	emptyTsDbs()
+	err := flatPopulateInserts(flatPopulateBasicInsertsForSearch, &flatPh)
+	if err != nil {
+		t.Errorf("Error populating inserts: %s", err)
+		return
+	}
+	qc := NewQueryCache(&inMemLocalCache{readTs: 93}, 93)
+	onlyOne := func(_, _ []float64, uid uint64) bool { return uid == 1 }
+	r, err := flatPh.SearchWithPath(context.TODO(), qc, []float64{0.824, 0.319, 0.111}, 1, onlyOne)
+	if err != nil {
+		t.Errorf("Error searching: %s", err)
+		return
+	}
+	if !equalUint64Slice(r.Neighbors, []uint64{1}) {
+		t.Errorf("Nearest neighbors expected value: %v, Got: %v", []uint64{1}, r.Neighbors)
+	}
+}
+
+func newDeleteTestHNSW() *persistentHNSW[float64] {
+	return &persistentHNSW[float64]{
+		maxLevels:      3,
+		efConstruction: 8,
+		efSearch:       12,
+		pred:           "0-d",
+		vecEntryKey:    ConcatStrings("0-d", VecEntry),
+		vecKey:         ConcatStrings("0-d", VecKeyword),
+		vecDead:        ConcatStrings("0-d", VecDead),
+		floatBits:      64,
+		simType:        GetSimType[float64](Euclidian, 64),
+		nodeAllEdges:   make(map[uint64][][]uint64),
+	}
+}
+
+// setTestVector stores vec as the vector of uid, or removes the vector of
+// uid if vec is nil.
+func setTestVector(pred string, uid uint64, vec []float64) {
+	key := string(DataKey(pred, uid))
+	for i := range tsDbs {
+		if vec == nil {
+			delete(tsDbs[i].inMemTestDb, key)
+		} else {
+			tsDbs[i].inMemTestDb[key] = floatArrayAsBytes(vec)
+		}
+	}
+}
+
+func testEdges(t *testing.T, ph *persistentHNSW[float64], uid uint64) [][]uint64 {
+	var edges [][]uint64
+	data, ok := tsDbs[50].inMemTestDb[string(DataKey(ph.vecKey, uid))]
+	if !ok {
+		return edges
+	}
+	if err := decodeUint64MatrixUnsafe(data.([]byte), &edges); err != nil {
+		t.Fatalf("Error decoding edges of %d: %s", uid, err)
+	}
+	return edges
+}
+
+func hasEdgeTo(edges [][]uint64, uid uint64) bool {
+	for _, layerEdges := range edges {
+		if slices.Contains(layerEdges, uid) {
+			return true
+		}
+	}
+	return false
+}
+
+// populateDeleteTest inserts 25 vectors laid out on a 5x5 grid, using uids
+// 1 to 25.
+func populateDeleteTest(t *testing.T, ph *persistentHNSW[float64]) *TxnCache {
+	emptyTsDbs()
+	tc := NewTxnCache(&inMemTxn{startTs: 50, commitTs: 50}, 50)
+	for uid := uint64(1); uid <= 25; uid++ {
+		vec := []float64{float64((uid - 1) % 5), float64((uid - 1) / 5)}
+		setTestVector(ph.pred, uid, vec)
+		if _, err := ph.Insert(context.TODO(), tc, uid, vec); err != nil {
+			t.Fatalf("Error inserting %d: %s", uid, err)
+		}
+	}
+	return tc
+}
+
+// searchDeleteTest returns the 4 nearest neighbors of query in the graph
+// built by populateDeleteTest. The search keeps a candidate for every node of
+// the grid, so that it finds every node reachable from the entry, instead of
+// depending on how well the random levels of the graph suit a greedy search.
+func searchDeleteTest(qc index.CacheType, query []float64) ([]uint64, error) {
+	ph := newDeleteTestHNSW()
+	ph.efSearch = 25
+	return ph.Search(context.TODO(), qc, query, 4, index.AcceptAll[float64])
+}
+
+func TestDeletePersistentHNSW(t *testing.T) {
+	ph := newDeleteTestHNSW()
+	tc := populateDeleteTest(t, ph)
+
+	// Delete the center of the grid, like a mutation deleting its vector.
+	const deleted = uint64(13)
+	before := testEdges(t, ph, deleted)
+	setTestVector(ph.pred, deleted, nil)
+	if _, err := ph.Delete(context.TODO(), tc, deleted); err != nil {
+		t.Fatalf("Error deleting: %s", err)
+	}
+	if hasEdgeTo(testEdges(t, ph, deleted), 0) || len(testEdges(t, ph, deleted)[ph.maxLevels-1]) != 0 {
+		t.Errorf("Expected no edges left for %d, Got: %v", deleted, testEdges(t, ph, deleted))
+	}
+	for _, layerEdges := range before {
+		for _, n := range layerEdges {
+			if hasEdgeTo(testEdges(t, ph, n), deleted) {
+				t.Errorf("Expected the edge from %d to %d to be removed", n, deleted)
+			}
+		}
+	}
+	// Deletes don't write anything shared by all the nodes.
+	if _, ok := tsDbs[50].inMemTestDb[string(DataKey(ph.vecDead, 1))]; ok {
+		t.Errorf("Expected no dead nodes to be recorded")
+	}
+
+	qc := NewQueryCache(&inMemLocalCache{readTs: 50}, 50)
+	nns, err := searchDeleteTest(qc, []float64{2, 2})
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if len(nns) == 0 || slices.Contains(nns, deleted) {
+		t.Errorf("Expected nearest neighbors without %d, Got: %v", deleted, nns)
+	}
+
+	// Inserting the node again brings it back to life.
+	setTestVector(ph.pred, deleted, []float64{2, 2})
+	ph = newDeleteTestHNSW()
+	if _, err := ph.Insert(context.TODO(), tc, deleted, []float64{2, 2}); err != nil {
+		t.Fatalf("Error inserting: %s", err)
+	}
+	nns, err = searchDeleteTest(qc, []float64{2, 2})
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if !slices.Contains(nns, deleted) {
+		t.Errorf("Expected nearest neighbors with %d, Got: %v", deleted, nns)
+	}
+}
+
+func TestDeletedNodeDroppedOnOverflow(t *testing.T) {
+	ph := newDeleteTestHNSW()
+	tc := populateDeleteTest(t, ph)
+
+	// An edge towards a deleted node that wasn't a neighbor of its source
+	// is dropped once the edges of the source overflow.
+	const deleted, source = uint64(25), uint64(1)
+	setTestVector(ph.pred, deleted, nil)
+	if _, err := ph.Delete(context.TODO(), tc, deleted); err != nil {
+		t.Fatalf("Error deleting: %s", err)
+	}
+	overflow := make([][]uint64, ph.maxLevels)
+	overflow[0] = append([]uint64{deleted}, 2, 3, 4, 5, 6, 7, 8, 9, 10)
+	if _, err := ph.addNeighbors(context.TODO(), tc, source, overflow); err != nil {
+		t.Fatalf("Error adding neighbors: %s", err)
+	}
+	if edges := testEdges(t, ph, source); hasEdgeTo(edges, deleted) ||
+		len(edges[0]) != ph.efConstruction {
+		t.Errorf("Expected %d edges without %d, Got: %v", ph.efConstruction, deleted, edges)
+	}
+}
+
+func TestUpdatePersistentHNSW(t *testing.T) {
+	ph := newDeleteTestHNSW()
+	tc := populateDeleteTest(t, ph)
+
+	// Move a node of the grid to the other side of it.
+	const moved = uint64(7)
+	setTestVector(ph.pred, moved, []float64{3.6, 3.6})
+	if _, err := ph.Update(context.TODO(), tc, moved, []float64{3.6, 3.6}); err != nil {
+		t.Fatalf("Error updating: %s", err)
+	}
+	if _, ok := tsDbs[50].inMemTestDb[string(DataKey(ph.vecDead, 1))]; ok {
+		t.Errorf("Expected no dead node after an update")
+	}
+
+	qc := NewQueryCache(&inMemLocalCache{readTs: 50}, 50)
+	nns, err := searchDeleteTest(qc, []float64{3.6, 3.6})
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if !slices.Contains(nns, moved) {
+		t.Errorf("Expected nearest neighbors with %d, Got: %v", moved, nns)
+	}
+	nns, err = searchDeleteTest(qc, []float64{1, 1})
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if slices.Contains(nns, moved) {
+		t.Errorf("Expected nearest neighbors without %d, Got: %v", moved, nns)
+	}
+}
+
+func TestHammingSimType(t *testing.T) {
+	simType := GetSimType[float32](Hamming, 32)
+	if simType.Name() != Hamming {
+		t.Fatalf("Expected metric %s, Got: %s", Hamming, simType.Name())
+	}
+	var a, b []float32
+	index.DecodeVector([]byte{0b10110000}, &a, 32, index.BinaryEncoding)
+	index.DecodeVector([]byte{0b10011001}, &b, 32, index.BinaryEncoding)
+	dist, err := simType.Score(a, b, 32)
+	if err != nil {
+		t.Fatalf("Error computing distance: %s", err)
+	}
+	if dist != 3 {
+		t.Errorf("Distance expected value: 3, Got: %v", dist)
+	}
+	if !simType.IsBetterScore(1, dist) {
+		t.Errorf("Expected a smaller hamming distance to be a better score")
+	}
+	if _, err := simType.Score(a, b[:4], 32); err == nil {
+		t.Errorf("Expected an error for vectors of different lengths")
+	}
+}
+
+func TestQuantizedScores(t *testing.T) {
+	encoded := map[string][2][]byte{
+		index.Int8Encoding:   {{0x7f, 0x81, 0, 0x40}, {0x20, 0x7f, 0xe0, 0}},
+		index.BinaryEncoding: {{0b10110000, 0b1}, {0b10011001, 0b11}},
+	}
+	for _, metric := range []string{Euclidian, Cosine, DotProd, Hamming} {
+		simType := GetSimType[float32](metric, 32)
+		for encoding, vecs := range encoded {
+			var a, b []float32
+			index.DecodeVector(vecs[0], &a, 32, encoding)
+			index.DecodeVector(vecs[1], &b, 32, encoding)
+			expected, err := simType.Score(a, b, 32)
+			if err != nil {
+				t.Fatalf("Error computing %s score: %s", metric, err)
+			}
+			score, err := simType.ScoreQuantized(vecs[0], vecs[1], encoding)
+			if err != nil {
+				t.Fatalf("Error computing quantized %s score: %s", metric, err)
+			}
+			if math.Abs(float64(score-expected)) > 1e-5 {
+				t.Errorf("%s score of %s vectors expected value: %v, Got: %v",
+					metric, encoding, expected, score)
+			}
+		}
+	}
+}
+
+func TestMultiVectorPersistentHNSW(t *testing.T) {
+	emptyTsDbs()
+	f := CreateFactory[float64](64)
+	vIndex, err := f.CreateOrReplace("0-mv", opt.NewOptions().SetOpt(index.MultiVectorOpt, true), 64)
+	if err != nil {
+		t.Fatalf("Error creating index: %s", err)
+	}
+	ph := vIndex.(*persistentHNSW[float64])
+	tc := NewTxnCache(&inMemTxn{startTs: 50, commitTs: 50}, 50)
+	for id := uint64(1); id <= 5; id++ {
+		vec := []float64{float64(id), 0}
+		setTestVector(ConcatStrings("0-mv", VecMulti), id, vec)
+		if _, err := ph.Insert(context.TODO(), tc, id, vec); err != nil {
+			t.Fatalf("Error inserting %d: %s", id, err)
+		}
+	}
+	// The vectors are read from VecMulti, a value of the predicate itself is
+	// not a vector of the index.
+	setTestVector("0-mv", 3, []float64{100, 100})
+
+	qc := NewQueryCache(&inMemLocalCache{readTs: 50}, 50)
+	nns, err := ph.Search(context.TODO(), qc, []float64{3, 0.1}, 1, index.AcceptAll[float64])
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if !equalUint64Slice(nns, []uint64{3}) {
+		t.Errorf("Nearest neighbors expected value: %v, Got: %v", []uint64{3}, nns)
+	}
+}
+
+func TestInt8PersistentHNSW(t *testing.T) {
+	emptyTsDbs()
+	f := CreateFactory[float64](64)
+	vIndex, err := f.CreateOrReplace("0-i8", opt.NewOptions().SetOpt(index.VectorEncodingOpt,
+		index.Int8Encoding), 64)
+	if err != nil {
+		t.Fatalf("Error creating index: %s", err)
+	}
+	ph := vIndex.(*persistentHNSW[float64])
+	tc := NewTxnCache(&inMemTxn{startTs: 50, commitTs: 50}, 50)
+	for id := uint64(1); id <= 5; id++ {
+		vec := []float64{float64(id) / 5, -0.5}
+		encoded := index.EncodeVector(vec, index.Int8Encoding)
+		for i := range tsDbs {
+			tsDbs[i].inMemTestDb[string(DataKey("0-i8", id))] = encoded
+		}
+		if _, err := ph.Insert(context.TODO(), tc, id, vec); err != nil {
+			t.Fatalf("Error inserting %d: %s", id, err)
+		}
+	}
+
+	// The stored vectors are compared as they are, and not decoded for the
+	// filter.
+	qc := NewQueryCache(&inMemLocalCache{readTs: 50}, 50)
+	filter := func(_, resultVal []float64, _ uint64) bool { return resultVal == nil }
+	nns, err := ph.Search(context.TODO(), qc, []float64{0.58, -0.5}, 2, filter)
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if !equalUint64Slice(nns, []uint64{3, 2}) {
+		t.Errorf("Nearest neighbors expected value: %v, Got: %v", []uint64{3, 2}, nns)
+	}
+	r, err := ph.SearchExactWithUid(context.TODO(), qc, 4, []uint64{1, 4, 5}, 2)
+	if err != nil {
+		t.Fatalf("Error searching: %s", err)
+	}
+	if !equalUint64Slice(r.Neighbors, []uint64{4, 5}) || r.Distances[0] != 0 {
+		t.Errorf("Nearest neighbors expected value: %v at distance 0, Got: %v at %v",
+			[]uint64{4, 5}, r.Neighbors, r.Distances)
+	}
+}
+
+func TestGraphStats(t *testing.T) {
+	ph := newDeleteTestHNSW()
+	populateDeleteTest(t, ph)
+
+	qc := NewQueryCache(&inMemLocalCache{readTs: 50}, 50)
+	uids := make([]uint64, 0, 26)
+	var edges, bottomEdges uint64
+	for uid := uint64(1); uid <= 25; uid++ {
+		uids = append(uids, uid)
+		nodeEdges := testEdges(t, ph, uid)
+		for _, row := range nodeEdges {
+			edges += uint64(len(row))
+		}
+		bottomEdges += uint64(len(nodeEdges[ph.maxLevels-1]))
+	}
+	// A uid that was never inserted is not a node of the graph.
+	uids = append(uids, 100)
+
+	stats, err := ph.GraphStats(qc, uids)
+	if err != nil {
+		t.Fatalf("Error computing stats: %s", err)
+	}
+	if stats.Nodes != 25 {
+		t.Errorf("Expected 25 nodes, Got: %d", stats.Nodes)
+	}
+	if stats.Layers < 1 || stats.Layers > ph.maxLevels {
+		t.Errorf("Expected between 1 and %d layers, Got: %d", ph.maxLevels, stats.Layers)
+	}
+	if stats.Edges != edges {
+		t.Errorf("Expected %d edges, Got: %d", edges, stats.Edges)
+	}
+	if want := float64(bottomEdges) / 25; stats.AvgDegree != want || want == 0 {
+		t.Errorf("Expected average degree %f, Got: %f", want, stats.AvgDegree)
+	}
+
+	stats, err = ph.GraphStats(qc, nil)
+	if err != nil {
+		t.Fatalf("Error computing stats: %s", err)
+	}
+	if stats != (GraphStats{}) {
+		t.Errorf("Expected empty stats for no nodes, Got: %+v", stats)
+	}
+}
@@ -59,6 +59,10 @@ func (slr *searchLayerResult[T]) setFirstPathNode(n minPersistentHeapElement[T])
 	slr.visited = make(map[uint64]minPersistentHeapElement[T])
 	slr.visited[n.index] = n
 	slr.path = []uint64{n.index}
+	slr.filtered = 0
+	if n.filteredOut {
+		slr.filtered = 1
+	}
 }
 
 func (slr *searchLayerResult[T]) addPathNode(
@@ -126,10 +130,17 @@ func (slr *searchLayerResult[T]) updateFinalPath(r *index.SearchPathResult) {
 	r.Path = append(r.Path, slr.path...)
 }
 
-func (slr *searchLayerResult[T]) addFinalNeighbors(r *index.SearchPathResult) {
+// slr.addFinalNeighbors(r, simType, maxResults) adds to r the (at most)
+// maxResults best neighbors of slr that were not filtered out.
+func (slr *searchLayerResult[T]) addFinalNeighbors(r *index.SearchPathResult,
+	simType SimilarityType[T], maxResults int) {
 	for _, n := range slr.neighbors {
+		if len(r.Neighbors) >= maxResults {
+			break
+		}
 		if !n.filteredOut {
 			r.Neighbors = append(r.Neighbors, n.index)
+			r.Distances = append(r.Distances, simType.toDistance(n.value))
 		}
 	}
 }
@@ -22,6 +22,8 @@ import (
 	"context"
 	"encoding/binary"
 	"math"
+	"slices"
+	"sort"
 	"strings"
 	"sync"
 
@@ -188,6 +190,50 @@ func (t *inMemTxn) AddMutationWithLockHeld(ctx context.Context, key []byte, t1 *
 	return nil
 }
 
+// adds uid to the sorted uid list stored under key
+func (t *inMemTxn) AddUid(ctx context.Context, key []byte, t1 *index.KeyValue, uid uint64) error {
+	tsDbs[t.startTs].writeMu.Lock()
+	defer tsDbs[t.startTs].writeMu.Unlock()
+	uids, _ := t.uidsWithLockHeld(key)
+	i := sort.Search(len(uids), func(i int) bool { return uids[i] >= uid })
+	if i < len(uids) && uids[i] == uid {
+		return nil
+	}
+	uids = slices.Insert(slices.Clone(uids), i, uid)
+	for i := t.commitTs; i < uint64(len(tsDbs)); i++ {
+		tsDbs[i].inMemTestDb[string(key[:])] = uids
+	}
+	return nil
+}
+
+// removes uid from the sorted uid list stored under key
+func (t *inMemTxn) DeleteUid(ctx context.Context, key []byte, t1 *index.KeyValue, uid uint64) error {
+	tsDbs[t.startTs].writeMu.Lock()
+	defer tsDbs[t.startTs].writeMu.Unlock()
+	uids, _ := t.uidsWithLockHeld(key)
+	i := sort.Search(len(uids), func(i int) bool { return uids[i] >= uid })
+	if i == len(uids) || uids[i] != uid {
+		return nil
+	}
+	uids = slices.Delete(slices.Clone(uids), i, i+1)
+	for i := t.commitTs; i < uint64(len(tsDbs)); i++ {
+		tsDbs[i].inMemTestDb[string(key[:])] = uids
+	}
+	return nil
+}
+
+// reads the uid list stored under key at txn's startTs
+func (t *inMemTxn) Uids(key []byte) ([]uint64, error) {
+	tsDbs[t.startTs].readMu.RLock()
+	defer tsDbs[t.startTs].readMu.RUnlock()
+	return t.uidsWithLockHeld(key)
+}
+
+func (t *inMemTxn) uidsWithLockHeld(key []byte) ([]uint64, error) {
+	uids, _ := tsDbs[t.startTs].inMemTestDb[string(key[:])].([]uint64)
+	return uids, nil
+}
+
 // locks the txn
 func (t *inMemTxn) LockKey(key []byte) {
 	if !strings.Contains(string(key[:]), "entry") {
@@ -245,6 +291,14 @@ func (c *inMemLocalCache) GetWithLockHeld(key []byte) (rval index.Value, rerr er
 	return val, nil
 }
 
+// reads the uid list stored under key at c's readTs
+func (c *inMemLocalCache) Uids(key []byte) ([]uint64, error) {
+	tsDbs[c.readTs].readMu.RLock()
+	defer tsDbs[c.readTs].readMu.RUnlock()
+	uids, _ := tsDbs[c.readTs].inMemTestDb[string(key[:])].([]uint64)
+	return uids, nil
+}
+
 func equalFloat64Slice(a, b []float64) bool {
 	if len(a) != len(b) {
 		return false
@@ -69,3 +69,85 @@ func BytesToFloat[T c.Float](encoded []byte, floatBits int) T {
 	}
 	panic("Invalid floatBits")
 }
+
+// VectorEncodingOpt is the option telling a VectorIndex how the vectors it
+// indexes are stored. Unlike the other options, it is not given in the
+// schema but derived from the type of the predicate. Without it, vectors
+// are expected to use FloatEncoding.
+const VectorEncodingOpt = "vectorEncoding"
+
+const (
+	// FloatEncoding stores each dimension as a float of floatBits bits.
+	FloatEncoding = "float"
+	// Int8Encoding stores each dimension as an int8, which is scaled by
+	// 1/127 when read, so that the distances are those of the floats
+	// the int8 values were quantized from.
+	Int8Encoding = "int8"
+	// BinaryEncoding stores 8 dimensions per byte, the first one being the
+	// most significant bit, each dimension is read as 0 or 1.
+	BinaryEncoding = "binary"
+)
+
+// MultiVectorOpt is the option telling a VectorIndex that its predicate
+// holds a list of vectors per node. Like VectorEncodingOpt, it is derived
+// from the schema. The index then reads its vectors from the VecMulti
+// attribute of the predicate (see hnsw.VecMulti), where each of them has its
+// own id.
+const MultiVectorOpt = "multiVector"
+
+// DecodeVector[T c.Float](encoded, retVal, floatBits, encoding) converts
+// encoded, a vector stored as described by encoding, into a []T. For
+// FloatEncoding, this is BytesAsFloatArray. For the other encodings,
+// retVal is always set to a newly allocated slice, which is why the indexes
+// keep and compare quantized vectors as they are stored (see IsQuantized),
+// and only decode them when they need the floats, e.g. to train clusters.
+func DecodeVector[T c.Float](encoded []byte, retVal *[]T, floatBits int, encoding string) {
+	switch encoding {
+	case Int8Encoding:
+		vec := make([]T, len(encoded))
+		for i, b := range encoded {
+			vec[i] = T(int8(b)) / 127
+		}
+		*retVal = vec
+	case BinaryEncoding:
+		vec := make([]T, 8*len(encoded))
+		for i := range vec {
+			vec[i] = T((encoded[i/8] >> (7 - i%8)) & 1)
+		}
+		*retVal = vec
+	default:
+		BytesAsFloatArray(encoded, retVal, floatBits)
+	}
+}
+
+// IsQuantized returns true if encoding stores vectors in a quantized form,
+// which the indexes compare without decoding them into floats.
+func IsQuantized(encoding string) bool {
+	return encoding == Int8Encoding || encoding == BinaryEncoding
+}
+
+// EncodeVector[T c.Float](vec, encoding) stores vec as described by encoding,
+// one of the quantized encodings. It is the inverse of DecodeVector for the
+// vectors DecodeVector returns, other vectors are rounded to the closest
+// vector the encoding can hold.
+func EncodeVector[T c.Float](vec []T, encoding string) []byte {
+	switch encoding {
+	case Int8Encoding:
+		encoded := make([]byte, len(vec))
+		for i, v := range vec {
+			q := math.Round(float64(v) * 127)
+			encoded[i] = byte(int8(max(-math.MaxInt8, min(math.MaxInt8, q))))
+		}
+		return encoded
+	case BinaryEncoding:
+		encoded := make([]byte, (len(vec)+7)/8)
+		for i, v := range vec {
+			if v >= 0.5 {
+				encoded[i/8] |= 1 << (7 - i%8)
+			}
+		}
+		return encoded
+	default:
+		panic("EncodeVector called with a non quantized encoding " + encoding)
+	}
+}
@@ -423,3 +423,41 @@ func BenchmarkFloatConverstion(b *testing.B) {
 			}
 		})
 }
+
+func TestDecodeVector(t *testing.T) {
+	var vec []float32
+	DecodeVector([]byte{0x7f, 0x81, 0}, &vec, 32, Int8Encoding)
+	if fmt.Sprint(vec) != "[1 -1 0]" {
+		t.Errorf("Int8 vector expected value: [1 -1 0], Got: %v", vec)
+	}
+	DecodeVector([]byte{0b10000001}, &vec, 32, BinaryEncoding)
+	if fmt.Sprint(vec) != "[1 0 0 0 0 0 0 1]" {
+		t.Errorf("Binary vector expected value: [1 0 0 0 0 0 0 1], Got: %v", vec)
+	}
+	DecodeVector(unsafe.Slice((*byte)(unsafe.Pointer(&[]float32{0.5}[0])), 4), &vec, 32, FloatEncoding)
+	if fmt.Sprint(vec) != "[0.5]" {
+		t.Errorf("Float vector expected value: [0.5], Got: %v", vec)
+	}
+}
+
+func TestEncodeVector(t *testing.T) {
+	for _, encoded := range [][]byte{{0x7f, 0x81, 0}, {0x40, 0xc0}} {
+		var vec []float32
+		DecodeVector(encoded, &vec, 32, Int8Encoding)
+		if got := EncodeVector(vec, Int8Encoding); !bytes.Equal(got, encoded) {
+			t.Errorf("Int8 vector expected value: %v, Got: %v", encoded, got)
+		}
+	}
+	if got := EncodeVector([]float32{2, -2, 0.26}, Int8Encoding); !bytes.Equal(got, []byte{0x7f, 0x81, 0x21}) {
+		t.Errorf("Int8 vector expected value: [127 129 33], Got: %v", got)
+	}
+	var vec []float32
+	DecodeVector([]byte{0b10000001}, &vec, 32, BinaryEncoding)
+	if got := EncodeVector(vec, BinaryEncoding); !bytes.Equal(got, []byte{0b10000001}) {
+		t.Errorf("Binary vector expected value: [129], Got: %v", got)
+	}
+	if got := EncodeVector([]float32{1, 0, 0.7, 0, 0, 0, 0, 0, 1}, BinaryEncoding); !bytes.Equal(got,
+		[]byte{0b10100000, 0b10000000}) {
+		t.Errorf("Binary vector expected value: [160 128], Got: %v", got)
+	}
+}
@@ -76,6 +76,8 @@ type IndexFactory[T c.Float] interface {
 // whether or not a given vector is "interesting". When used in the context
 // of VectorIndex.Search, a true result means that we want to keep the result
 // in the returned list, and a false result implies we should skip.
+// Indexes holding quantized vectors (see IsQuantized) don't decode them for
+// the filter, which then gets a nil resultVal.
 type SearchFilter[T c.Float] func(query, resultVal []T, resultUID uint64) bool
 
 // AcceptAll implements SearchFilter by way of accepting all results.
@@ -100,6 +102,39 @@ type OptionalIndexSupport[T c.Float] interface {
 		query []T,
 		maxResults int,
 		filter SearchFilter[T]) (*SearchPathResult, error)
+
+	// SearchWithUidAndPath(ctx, c, queryUid, maxResults, filter) is similar to
+	// SearchWithUid(ctx, c, queryUid, maxResults, filter), but returns the
+	// extended set of content described by SearchPathResult.
+	SearchWithUidAndPath(
+		ctx context.Context,
+		c CacheType,
+		queryUid uint64,
+		maxResults int,
+		filter SearchFilter[T]) (*SearchPathResult, error)
+
+	// SearchExact(ctx, c, query, candidates, maxResults) compares the query
+	// against the vector of every uid in candidates and returns (at most)
+	// the maxResults nearest of them. Unlike the searches above it does not
+	// traverse the index, so it always finds the true nearest neighbors
+	// within candidates. It is intended for small candidate sets, typically
+	// the result of a selective filter.
+	SearchExact(
+		ctx context.Context,
+		c CacheType,
+		query []T,
+		candidates []uint64,
+		maxResults int) (*SearchPathResult, error)
+
+	// SearchExactWithUid(ctx, c, queryUid, candidates, maxResults) is similar
+	// to SearchExact(ctx, c, query, candidates, maxResults), but uses the
+	// vector stored for queryUid as the query.
+	SearchExactWithUid(
+		ctx context.Context,
+		c CacheType,
+		queryUid uint64,
+		candidates []uint64,
+		maxResults int) (*SearchPathResult, error)
 }
 
 // A VectorIndex can be used to Search for vectors and add vectors to an index.
@@ -129,6 +164,30 @@ type VectorIndex[T c.Float] interface {
 	// Insert will add a vector and uuid into the existing VectorIndex. If
 	// uuid already exists, it should throw an error to not insert duplicate uuids
 	Insert(ctx context.Context, c CacheType, uuid uint64, vec []T) ([]*KeyValue, error)
+
+	// Update replaces the vector of uuid in the VectorIndex by vec, so that
+	// the previous vector no longer influences the structure of the index.
+	// If uuid is not in the index yet, Update behaves like Insert.
+	Update(ctx context.Context, c CacheType, uuid uint64, vec []T) ([]*KeyValue, error)
+
+	// Delete removes uuid from the VectorIndex. Searches performed after the
+	// deletion never return uuid. Deleting a uuid that is not in the index
+	// does nothing.
+	Delete(ctx context.Context, c CacheType, uuid uint64) ([]*KeyValue, error)
+}
+
+// A Trainer is a VectorIndex whose structure is learned from the vectors it
+// holds, like the clusters of an IVF index. Training is too expensive to run
+// in the transactions inserting the vectors, so it is run in the background
+// in steps, each one in its own transaction.
+type Trainer[T c.Float] interface {
+	// NeedsTraining returns true if a call to Train would change the index.
+	NeedsTraining(ctx context.Context, c CacheType) (bool, error)
+
+	// Train runs a step of the training of the index. It must be called
+	// until NeedsTraining returns false. The result of a step must only
+	// depend on the data read through c.
+	Train(ctx context.Context, c CacheType) ([]*KeyValue, error)
 }
 
 // A Txn is an interface representation of a persistent storage transaction,
@@ -146,6 +205,14 @@ type Txn interface {
 	AddMutation(ctx context.Context, key []byte, t *KeyValue) error
 	// Same as AddMutation but with a mutex lock held
 	AddMutationWithLockHeld(ctx context.Context, key []byte, t *KeyValue) error
+	// AddUid adds uid to the uid list stored under key. Unlike AddMutation,
+	// it only conflicts with the transactions changing the same uid of the
+	// list, rather than with every transaction writing to the key.
+	AddUid(ctx context.Context, key []byte, t *KeyValue, uid uint64) error
+	// DeleteUid removes uid from the uid list stored under key, see AddUid.
+	DeleteUid(ctx context.Context, key []byte, t *KeyValue, uid uint64) error
+	// Uids returns the uid list stored under key, which is empty if there is none.
+	Uids(key []byte) ([]uint64, error)
 	// mutex lock
 	LockKey(key []byte)
 	// mutex unlock
@@ -159,6 +226,8 @@ type LocalCache interface {
 	// GetWithLockHeld uses a []byte key to return the Value corresponding to the key with a mutex lock held
 	GetWithLockHeld(key []byte) (rval Value, rerr error)
 	Find(prefix []byte, filter func(val []byte) bool) (uint64, error)
+	// Uids returns the uid list stored under key, which is empty if there is none.
+	Uids(key []byte) ([]uint64, error)
 }
 
 // Value is an interface representation of the value of a persistent storage system
@@ -169,4 +238,5 @@ type CacheType interface {
 	Get(key []byte) (rval Value, rerr error)
 	Ts() uint64
 	Find(prefix []byte, filter func(val []byte) bool) (uint64, error)
+	Uids(key []byte) ([]uint64, error)
 }
@@ -25,6 +25,10 @@ type SearchPathResult struct {
 	// The collection of nearest-neighbors in sorted order after filtlering
 	// out neighbors that fail any Filter criteria.
 	Neighbors []uint64
+	// The distance of each of the Neighbors from the query vector, in the
+	// same order as Neighbors. Smaller values always indicate a closer
+	// neighbor, regardless of the similarity metric used by the index.
+	Distances []float64
 	// The path from the start of search to the closest neighbor vector.
 	Path []uint64
 	// A collection of captured named counters that occurred for the
@@ -37,6 +41,7 @@ type SearchPathResult struct {
 func NewSearchPathResult() *SearchPathResult {
 	return &SearchPathResult{
 		Neighbors: []uint64{},
+		Distances: []float64{},
 		Path:      []uint64{},
 		Metrics:   make(map[string]uint64),
 	}
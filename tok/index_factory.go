@@ -19,6 +19,7 @@ package tok
 import (
 	"github.com/pkg/errors"
 
+	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
 	"github.com/dgraph-io/dgraph/v24/tok/index"
 	opts "github.com/dgraph-io/dgraph/v24/tok/options"
 )
@@ -56,6 +57,12 @@ func (fcs *FactoryCreateSpec) Name() string {
 	return fcs.factory.Name() + fcs.factory.GetOptions(fcs.opts)
 }
 
+// IndexType returns the name of the factory of fcs, like hnsw or ivf, without
+// the options.
+func (fcs *FactoryCreateSpec) IndexType() string {
+	return fcs.factory.Name()
+}
+
 func (fcs *FactoryCreateSpec) CreateIndex(name string) (index.VectorIndex[float32], error) {
 	if fcs == nil || fcs.factory == nil {
 		return nil,
@@ -75,6 +82,45 @@ func (fcs *FactoryCreateSpec) CreateIndex(name string) (index.VectorIndex[float3
 	return fcs.factory.CreateOrReplace(name, fcs.opts, 32)
 }
 
+// SimilarityType returns the metric used by the vector indexes created by
+// fcs, which is the euclidian distance unless the schema tells otherwise.
+func (fcs *FactoryCreateSpec) SimilarityType() hnsw.SimilarityType[float32] {
+	if simType, found := opts.GetInterfaceOpt(fcs.opts, hnsw.MetricOpt); found {
+		if s, ok := simType.(hnsw.SimilarityType[float32]); ok {
+			return s
+		}
+	}
+	return hnsw.GetSimType[float32](hnsw.Euclidian, 32)
+}
+
+// IsMultiVector returns true if fcs creates indexes for a predicate holding a
+// list of vectors per node, see index.MultiVectorOpt.
+func (fcs *FactoryCreateSpec) IsMultiVector() bool {
+	multiVector, _, _ := opts.GetOpt(fcs.opts, index.MultiVectorOpt, false)
+	return multiVector
+}
+
+// CreateIndexWithOptions is like CreateIndex, but the options given in pairs
+// override the ones of the schema. The options are parsed with the
+// AllowedOptions of the factory, so an option the index type does not know
+// results in an error. It is meant to tune a single search, e.g. to raise the
+// efSearch of an HNSW index for one query.
+func (fcs *FactoryCreateSpec) CreateIndexWithOptions(name string,
+	pairs []opts.OptionValuePair) (index.VectorIndex[float32], error) {
+	if fcs == nil || fcs.factory == nil {
+		return fcs.CreateIndex(name)
+	}
+	o := opts.NewOptions()
+	for k, v := range fcs.opts {
+		o.SetOpt(k, v)
+	}
+	if err := fcs.factory.AllowedOptions().PopulateOptions(pairs, o); err != nil {
+		return nil, errors.Wrapf(err, "while overriding the options of %s index for '%s'",
+			fcs.factory.Name(), name)
+	}
+	return fcs.factory.CreateOrReplace(name, o, 32)
+}
+
 func createIndexFactory(f index.IndexFactory[float32]) IndexFactory {
 	return &indexFactory{delegate: f}
 }
@@ -0,0 +1,414 @@
+/*
+ * Copyright 2016-2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ivf
+
+import (
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	c "github.com/dgraph-io/dgraph/v24/tok/constraints"
+	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v24/tok/index"
+)
+
+const (
+	// VecCodebook is the suffix of the attribute holding the centroids of the
+	// clusters (uid 1), the product quantizer (uid 2) and the progress of the
+	// reassignment of the vectors to the trained clusters (uid 3).
+	VecCodebook = "__vector_ivf_codebook"
+	// VecList is the suffix of the attribute holding the uid list of the
+	// vectors of each cluster, the uid of the key being the cluster id + 1.
+	VecList = "__vector_ivf_list"
+	// VecAssignment is the suffix of the attribute holding the cluster id of
+	// the vector of each uid.
+	VecAssignment = "__vector_ivf_assignment"
+	// VecPQ is the suffix of the attribute holding the product quantization
+	// code of the vector of each uid.
+	VecPQ = "__vector_ivf_pq"
+
+	distanceComputations   = "vector_distance_computations"
+	pqDistanceComputations = "vector_pq_distance_computations"
+	probedClusters         = "vector_probed_clusters"
+	searchTime             = "vector_search_time"
+	kmeansIterations       = 10
+
+	// pqCentroids is the number of centroids of each subspace of the product
+	// quantizer, so that a code takes a byte per subvector.
+	pqCentroids = 256
+	// pqRerank is the factor by which the number of results is multiplied to
+	// get the number of candidates whose exact distance is computed, when the
+	// candidates are ranked with their product quantization codes.
+	pqRerank = 4
+	// trainBatch is the maximum number of vectors moved to their trained
+	// cluster by a training step.
+	trainBatch = 1000
+)
+
+const (
+	codebookTrained = 1 << iota
+	codebookSettled
+)
+
+// codebook holds the centroids of the clusters of an IVF index. Until the
+// index is trained, the centroids are simply the first vectors that were
+// inserted. Once trained, the codebook is settled when all the vectors have
+// been moved to their trained cluster.
+type codebook[T c.Float] struct {
+	trained   bool
+	settled   bool
+	centroids [][]T
+}
+
+func encodeCodebook[T c.Float](cb *codebook[T], floatBits int) []byte {
+	dim := 0
+	if len(cb.centroids) > 0 {
+		dim = len(cb.centroids[0])
+	}
+	buf := make([]byte, 5, 5+len(cb.centroids)*dim*floatBits/8)
+	if cb.trained {
+		buf[0] |= codebookTrained
+	}
+	if cb.settled {
+		buf[0] |= codebookSettled
+	}
+	binary.LittleEndian.PutUint32(buf[1:], uint32(dim))
+	for _, centroid := range cb.centroids {
+		buf = appendFloats(buf, centroid, floatBits)
+	}
+	return buf
+}
+
+func decodeCodebook[T c.Float](data []byte, floatBits int) (*codebook[T], error) {
+	cb := &codebook[T]{}
+	if len(data) == 0 {
+		return cb, nil
+	}
+	if len(data) < 5 {
+		return nil, errors.Errorf("invalid IVF codebook of %d bytes", len(data))
+	}
+	cb.trained = data[0]&codebookTrained != 0
+	cb.settled = data[0]&codebookSettled != 0
+	dim := int(binary.LittleEndian.Uint32(data[1:]))
+	cb.centroids = decodeFloats[T](data[5:], dim, floatBits)
+	return cb, nil
+}
+
+// decodeFloats splits data into vectors of dim floats.
+func decodeFloats[T c.Float](data []byte, dim, floatBits int) [][]T {
+	size := dim * floatBits / 8
+	if dim == 0 || len(data)%size != 0 {
+		return nil
+	}
+	var vecs [][]T
+	for ; len(data) > 0; data = data[size:] {
+		vec := make([]T, dim)
+		for i := range vec {
+			vec[i] = index.BytesToFloat[T](data[i*floatBits/8:], floatBits)
+		}
+		vecs = append(vecs, vec)
+	}
+	return vecs
+}
+
+// encodeAssignment encodes the cluster of a vector, along with whether it
+// was assigned with the trained codebook.
+func encodeAssignment(cluster int, trained bool) []byte {
+	buf := binary.LittleEndian.AppendUint64(make([]byte, 0, 9), uint64(cluster))
+	if trained {
+		return append(buf, 1)
+	}
+	return append(buf, 0)
+}
+
+// decodeAssignment returns the cluster encoded by encodeAssignment, or false
+// if the vector is in no cluster.
+func decodeAssignment(data []byte) (int, bool, bool) {
+	if len(data) < 8 {
+		return 0, false, false
+	}
+	return int(binary.LittleEndian.Uint64(data)), len(data) > 8 && data[8] == 1, true
+}
+
+// encodeProgress encodes the position of the last vector moved to its
+// trained cluster: the cluster it was found in and its uid.
+func encodeProgress(cluster int, uid uint64) []byte {
+	buf := binary.LittleEndian.AppendUint32(make([]byte, 0, 12), uint32(cluster))
+	return binary.LittleEndian.AppendUint64(buf, uid)
+}
+
+func decodeProgress(data []byte) (int, uint64) {
+	if len(data) != 12 {
+		return 0, 0
+	}
+	return int(binary.LittleEndian.Uint32(data)), binary.LittleEndian.Uint64(data[4:])
+}
+
+// sampleUids returns n uids evenly spread over uids, or all of them if there
+// are less than n.
+func sampleUids(uids []uint64, n int) []uint64 {
+	if len(uids) <= n {
+		return uids
+	}
+	sample := make([]uint64, 0, n)
+	for i := 0; i < n; i++ {
+		sample = append(sample, uids[i*len(uids)/n])
+	}
+	return sample
+}
+
+// quantizer is a product quantizer. Vectors are split into subvectors of
+// dsub dimensions, and each subvector is encoded as the index of the closest
+// of the pqCentroids centroids of its subspace. The distance between a query
+// and a vector is then approximated with a lookup table per subspace.
+type quantizer[T c.Float] struct {
+	dsub      int
+	centroids [][][]T
+}
+
+// trainQuantizer trains a product quantizer splitting vectors in m subvectors.
+func trainQuantizer[T c.Float](vectors [][]T, m, floatBits int) (*quantizer[T], error) {
+	if len(vectors) == 0 {
+		return nil, errors.New("can not train a product quantizer without vectors")
+	}
+	if m <= 0 || len(vectors[0])%m != 0 {
+		return nil, errors.Errorf("can not split vectors of length %d in %d subvectors",
+			len(vectors[0]), m)
+	}
+	q := &quantizer[T]{dsub: len(vectors[0]) / m, centroids: make([][][]T, m)}
+	l2 := hnsw.GetSimType[T](hnsw.Euclidian, floatBits)
+	subs := make([][]T, len(vectors))
+	for j := range q.centroids {
+		var seeds [][]T
+		for i, vec := range vectors {
+			subs[i] = vec[j*q.dsub : (j+1)*q.dsub]
+			if len(seeds) < pqCentroids {
+				seeds = append(seeds, append([]T{}, subs[i]...))
+			}
+		}
+		centroids, err := kmeans(subs, seeds, l2, floatBits)
+		if err != nil {
+			return nil, err
+		}
+		q.centroids[j] = centroids
+	}
+	return q, nil
+}
+
+func encodeQuantizer[T c.Float](q *quantizer[T], floatBits int) []byte {
+	buf := binary.LittleEndian.AppendUint32(nil, uint32(len(q.centroids)))
+	buf = binary.LittleEndian.AppendUint32(buf, uint32(q.dsub))
+	for _, centroids := range q.centroids {
+		buf = binary.LittleEndian.AppendUint32(buf, uint32(len(centroids)))
+		for _, centroid := range centroids {
+			buf = appendFloats(buf, centroid, floatBits)
+		}
+	}
+	return buf
+}
+
+func decodeQuantizer[T c.Float](data []byte, floatBits int) (*quantizer[T], error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	if len(data) < 8 {
+		return nil, errors.Errorf("invalid IVF product quantizer of %d bytes", len(data))
+	}
+	m := int(binary.LittleEndian.Uint32(data))
+	q := &quantizer[T]{dsub: int(binary.LittleEndian.Uint32(data[4:])), centroids: make([][][]T, m)}
+	data = data[8:]
+	for j := range q.centroids {
+		if len(data) < 4 {
+			return nil, errors.Errorf("invalid IVF product quantizer")
+		}
+		size := int(binary.LittleEndian.Uint32(data)) * q.dsub * floatBits / 8
+		if len(data) < 4+size {
+			return nil, errors.Errorf("invalid IVF product quantizer")
+		}
+		q.centroids[j] = decodeFloats[T](data[4:4+size], q.dsub, floatBits)
+		data = data[4+size:]
+	}
+	return q, nil
+}
+
+// encode returns the code of vec, a byte per subvector.
+func (q *quantizer[T]) encode(vec []T, floatBits int) ([]byte, error) {
+	l2 := hnsw.GetSimType[T](hnsw.Euclidian, floatBits)
+	code := make([]byte, len(q.centroids))
+	for j, centroids := range q.centroids {
+		best, err := nearestCentroid(vec[j*q.dsub:(j+1)*q.dsub], centroids, l2, floatBits)
+		if err != nil {
+			return nil, err
+		}
+		code[j] = byte(best)
+	}
+	return code, nil
+}
+
+// table returns the lookup tables approximating the score of query against
+// the codes of q: the squared euclidean distance, or the dot product if dot
+// is true, between each subvector of query and each centroid of its subspace.
+func (q *quantizer[T]) table(query []T, dot bool) [][]float64 {
+	table := make([][]float64, len(q.centroids))
+	for j, centroids := range q.centroids {
+		sub := query[j*q.dsub : (j+1)*q.dsub]
+		table[j] = make([]float64, len(centroids))
+		for k, centroid := range centroids {
+			var score float64
+			for i, v := range sub {
+				if dot {
+					score += float64(v) * float64(centroid[i])
+				} else {
+					d := float64(v) - float64(centroid[i])
+					score += d * d
+				}
+			}
+			table[j][k] = score
+		}
+	}
+	return table
+}
+
+// approxScore returns the approximate score of the vector of code, using the
+// lookup tables of the query.
+func approxScore(table [][]float64, code []byte) float64 {
+	var score float64
+	for j, k := range code {
+		score += table[j][k]
+	}
+	return score
+}
+
+func appendFloats[T c.Float](buf []byte, vec []T, floatBits int) []byte {
+	for _, v := range vec {
+		if floatBits == 32 {
+			buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(float32(v)))
+		} else {
+			buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(float64(v)))
+		}
+	}
+	return buf
+}
+
+// neighbor is a candidate result of a search.
+type neighbor[T c.Float] struct {
+	uid   uint64
+	score T
+}
+
+// topK keeps the k neighbors with the best scores, ordered best first.
+type topK[T c.Float] struct {
+	k         int
+	simType   hnsw.SimilarityType[T]
+	neighbors []neighbor[T]
+}
+
+func (t *topK[T]) add(n neighbor[T]) {
+	if t.k <= 0 {
+		return
+	}
+	if len(t.neighbors) == t.k &&
+		!t.simType.IsBetterScore(n.score, t.neighbors[len(t.neighbors)-1].score) {
+		return
+	}
+	i := sort.Search(len(t.neighbors), func(i int) bool {
+		return t.simType.IsBetterScore(n.score, t.neighbors[i].score)
+	})
+	t.neighbors = append(t.neighbors, neighbor[T]{})
+	copy(t.neighbors[i+1:], t.neighbors[i:])
+	t.neighbors[i] = n
+	if len(t.neighbors) > t.k {
+		t.neighbors = t.neighbors[:t.k]
+	}
+}
+
+func (t *topK[T]) addFinalNeighbors(r *index.SearchPathResult) {
+	for _, n := range t.neighbors {
+		r.Neighbors = append(r.Neighbors, n.uid)
+		r.Distances = append(r.Distances, t.simType.ToDistance(n.score))
+	}
+}
+
+// kmeans refines centroids into the centroids of the clusters formed by
+// vectors, using Lloyd's algorithm. The clusters are formed according to the
+// given metric, and a cluster without vectors keeps its centroid.
+func kmeans[T c.Float](vectors, centroids [][]T, simType hnsw.SimilarityType[T],
+	floatBits int) ([][]T, error) {
+	assignment := make([]int, len(vectors))
+	for i := range assignment {
+		assignment[i] = -1
+	}
+	for iter := 0; iter < kmeansIterations; iter++ {
+		changed := false
+		for i, vec := range vectors {
+			best, err := nearestCentroid(vec, centroids, simType, floatBits)
+			if err != nil {
+				return nil, err
+			}
+			if best != assignment[i] {
+				assignment[i] = best
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+
+		sums := make([][]float64, len(centroids))
+		counts := make([]int, len(centroids))
+		for i, vec := range vectors {
+			cl := assignment[i]
+			if sums[cl] == nil {
+				sums[cl] = make([]float64, len(vec))
+			}
+			for j, v := range vec {
+				sums[cl][j] += float64(v)
+			}
+			counts[cl]++
+		}
+		for cl, sum := range sums {
+			if counts[cl] == 0 {
+				continue
+			}
+			centroid := make([]T, len(sum))
+			for j, s := range sum {
+				centroid[j] = T(s / float64(counts[cl]))
+			}
+			centroids[cl] = centroid
+		}
+	}
+	return centroids, nil
+}
+
+// nearestCentroid returns the index of the centroid closest to vec.
+func nearestCentroid[T c.Float](vec []T, centroids [][]T, simType hnsw.SimilarityType[T],
+	floatBits int) (int, error) {
+	best := -1
+	var bestScore T
+	for i, centroid := range centroids {
+		score, err := simType.Score(vec, centroid, floatBits)
+		if err != nil {
+			return -1, err
+		}
+		if best == -1 || simType.IsBetterScore(score, bestScore) {
+			best, bestScore = i, score
+		}
+	}
+	return best, nil
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright 2016-2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ivf
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	c "github.com/dgraph-io/dgraph/v24/tok/constraints"
+	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v24/tok/index"
+	opt "github.com/dgraph-io/dgraph/v24/tok/options"
+)
+
+const (
+	NListOpt        string = "nlist"
+	NProbeOpt       string = "nprobe"
+	TrainingSizeOpt string = "trainingSize"
+	PQOpt           string = "pq"
+	MetricOpt       string = hnsw.MetricOpt
+	Ivf             string = "ivf"
+)
+
+// persistentIndexFactory is an in memory implementation of the IndexFactory interface.
+// indexMap is an in memory map that corresponds an index name with a corresponding VectorIndex.
+// In persistentIndexFactory, the VectorIndex will be of type IVF.
+type persistentIndexFactory[T c.Float] struct {
+	indexMap  map[string]index.VectorIndex[T]
+	floatBits int
+	mu        sync.RWMutex
+}
+
+// CreateFactory creates an instance of the private struct persistentIndexFactory.
+// NOTE: if T and floatBits do not match in # of bits, there will be consequences.
+func CreateFactory[T c.Float](floatBits int) index.IndexFactory[T] {
+	return &persistentIndexFactory[T]{
+		indexMap:  map[string]index.VectorIndex[T]{},
+		floatBits: floatBits,
+	}
+}
+
+// Implements NamedFactory interface for use as a plugin.
+func (f *persistentIndexFactory[T]) Name() string { return Ivf }
+
+func (f *persistentIndexFactory[T]) GetOptions(o opt.Options) string {
+	sb := strings.Builder{}
+	for _, name := range []string{NListOpt, NProbeOpt, TrainingSizeOpt, PQOpt} {
+		if val, ok, _ := opt.GetOpt(o, name, 0); ok {
+			sb.WriteString(fmt.Sprintf(`"%s":"%d",`, name, val))
+		}
+	}
+	if simType, foundSimType := opt.GetInterfaceOpt(o, MetricOpt); foundSimType {
+		sim, ok := simType.(hnsw.SimilarityType[T])
+		if !ok {
+			glog.Errorf("cannot cast %T to SimilarityType", simType)
+		}
+		sb.WriteString(fmt.Sprintf(`"%s":"%s",`, MetricOpt, sim.Name()))
+	}
+
+	final := sb.String()
+	if len(final) > 0 {
+		// Remove last , and cover with brackets
+		return "(" + final[:len(final)-1] + ")"
+	}
+	return ""
+}
+
+// f.AllowedOptions() allows persistentIndexFactory to implement the
+// IndexFactory interface (see tok/index/index.go for details).
+// We define here options for nlist, nprobe, trainingSize, pq and metric.
+func (f *persistentIndexFactory[T]) AllowedOptions() opt.AllowedOptions {
+	retVal := opt.NewAllowedOptions()
+	retVal.AddIntOption(NListOpt).
+		AddIntOption(NProbeOpt).
+		AddIntOption(TrainingSizeOpt).
+		AddIntOption(PQOpt)
+	getSimFunc := func(optValue string) (any, error) {
+		return hnsw.GetSimType[T](optValue, f.floatBits), nil
+	}
+	retVal.AddCustomOption(MetricOpt, getSimFunc)
+	return retVal
+}
+
+// Create is an implementation of the IndexFactory interface function. It creates
+// an IVF instance named name, configured by o, and adds it to the in memory map.
+func (f *persistentIndexFactory[T]) Create(
+	name string,
+	o opt.Options,
+	floatBits int) (index.VectorIndex[T], error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.createWithLock(name, o, floatBits)
+}
+
+func (f *persistentIndexFactory[T]) createWithLock(
+	name string,
+	o opt.Options,
+	floatBits int) (index.VectorIndex[T], error) {
+	if _, ok := f.indexMap[name]; ok {
+		return nil, errors.New("index with name " + name + " already exists")
+	}
+	retVal := &persistentIVF[T]{
+		pred:          name,
+		codebookKey:   hnsw.ConcatStrings(name, VecCodebook),
+		listKey:       hnsw.ConcatStrings(name, VecList),
+		assignmentKey: hnsw.ConcatStrings(name, VecAssignment),
+		pqKey:         hnsw.ConcatStrings(name, VecPQ),
+		floatBits:     floatBits,
+	}
+	if err := retVal.applyOptions(o); err != nil {
+		return nil, err
+	}
+	f.indexMap[name] = retVal
+	return retVal, nil
+}
+
+// Find is an implementation of the IndexFactory interface function. It returns
+// the VectorIndex corresponding with a string name using the in memory map.
+func (f *persistentIndexFactory[T]) Find(name string) (index.VectorIndex[T], error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.indexMap[name], nil
+}
+
+// Remove is an implementation of the IndexFactory interface function. It
+// removes the VectorIndex corresponding with a string name from the in memory map.
+func (f *persistentIndexFactory[T]) Remove(name string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.indexMap, name)
+	return nil
+}
+
+// CreateOrReplace is an implementation of the IndexFactory interface function.
+// It replaces the VectorIndex corresponding with name if it exists, and creates
+// it otherwise.
+func (f *persistentIndexFactory[T]) CreateOrReplace(
+	name string,
+	o opt.Options,
+	floatBits int) (index.VectorIndex[T], error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	delete(f.indexMap, name)
+	return f.createWithLock(name, o, floatBits)
+}
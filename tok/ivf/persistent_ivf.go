@@ -0,0 +1,686 @@
+/*
+ * Copyright 2016-2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ivf
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+
+	c "github.com/dgraph-io/dgraph/v24/tok/constraints"
+	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v24/tok/index"
+	opt "github.com/dgraph-io/dgraph/v24/tok/options"
+)
+
+// persistentIVF is an inverted file (IVF-flat) vector index. Vectors are
+// partitioned into nlist clusters, each one represented by its centroid, and
+// a search only compares the query against the vectors of the nprobe clusters
+// whose centroids are the closest to it. Unlike HNSW, the index stores a
+// single uid list per cluster rather than a neighbor list per vector, and
+// inserting a vector only adds its uid to the list of its cluster, so that
+// concurrent inserts don't conflict with each other.
+//
+// The centroids (the codebook) are seeded with the first nlist vectors that
+// are inserted. Once trainingSize vectors have been inserted, the codebook is
+// trained with k-means over a sample of them, and the vectors are moved to
+// their trained cluster. Training is run in the background (see
+// index.Trainer), so vectors inserted by transactions that started before
+// the trained codebook was committed may be left in an untrained cluster
+// until they are updated. From then on, the codebook does not change anymore.
+//
+// If pq is set, a product quantizer is trained along with the codebook, and
+// searches rank the vectors of the probed clusters with their codes before
+// computing the exact distance of the best of them.
+type persistentIVF[T c.Float] struct {
+	pred          string
+	codebookKey   string
+	listKey       string
+	assignmentKey string
+	pqKey         string
+	nlist         int
+	nprobe        int
+	trainingSize  int
+	// pq is the number of subvectors of the product quantizer, 0 if the
+	// vectors are not quantized.
+	pq        int
+	simType   hnsw.SimilarityType[T]
+	floatBits int
+	// encoding tells how the vectors are stored, see index.DecodeVector.
+	encoding string
+}
+
+func (ivf *persistentIVF[T]) applyOptions(o opt.Options) error {
+	var err error
+	ivf.nlist, _, err = opt.GetOpt(o, NListOpt, 100)
+	if err != nil {
+		return err
+	}
+	ivf.nprobe, _, err = opt.GetOpt(o, NProbeOpt, 10)
+	if err != nil {
+		return err
+	}
+	ivf.trainingSize, _, err = opt.GetOpt(o, TrainingSizeOpt, 40*ivf.nlist)
+	if err != nil {
+		return err
+	}
+	ivf.pq, _, err = opt.GetOpt(o, PQOpt, 0)
+	if err != nil {
+		return err
+	}
+	ivf.encoding, _, err = opt.GetOpt(o, index.VectorEncodingOpt, index.FloatEncoding)
+	if err != nil {
+		return err
+	}
+	multiVector, _, err := opt.GetOpt(o, index.MultiVectorOpt, false)
+	if err != nil {
+		return err
+	}
+	if multiVector {
+		ivf.pred = hnsw.ConcatStrings(ivf.pred, hnsw.VecMulti)
+	}
+	if ivf.nlist <= 0 || ivf.nprobe <= 0 {
+		return errors.Errorf("%s and %s must be positive for an IVF index", NListOpt, NProbeOpt)
+	}
+	if ivf.pq < 0 {
+		return errors.Errorf("%s can not be negative for an IVF index", PQOpt)
+	}
+	simType, foundSimType := opt.GetInterfaceOpt(o, MetricOpt)
+	if foundSimType {
+		okSimType, ok := simType.(hnsw.SimilarityType[T])
+		if !ok {
+			return fmt.Errorf("cannot cast %T to SimilarityType", simType)
+		}
+		ivf.simType = okSimType
+	} else {
+		ivf.simType = hnsw.GetSimType[T](hnsw.Euclidian, ivf.floatBits)
+	}
+	if name := ivf.simType.Name(); ivf.pq > 0 && name != hnsw.Euclidian && name != hnsw.DotProd {
+		return errors.Errorf("%s is only supported with the %s and %s metrics", PQOpt,
+			hnsw.Euclidian, hnsw.DotProd)
+	}
+	return nil
+}
+
+// getData returns the value stored for attr and uid, or nil if there is none.
+func getData(attr string, uid uint64, c index.CacheType) []byte {
+	// Errors are treated as not having found the data, like a key that was
+	// never written.
+	data, err := c.Get(hnsw.DataKey(attr, uid))
+	if err != nil || data == nil {
+		return nil
+	}
+	return data.([]byte)
+}
+
+func (ivf *persistentIVF[T]) getVecFromUid(uid uint64, c index.CacheType, vec *[]T) {
+	index.DecodeVector(getData(ivf.pred, uid, c), vec, ivf.floatBits, ivf.encoding)
+}
+
+// candidate reads the vector of uid to score it against a query, see
+// scoreCandidate. A vector with a quantized encoding (see index.IsQuantized)
+// is returned as it is stored, without decoding it into vec, which is then
+// emptied. It returns false if uid has no vector.
+func (ivf *persistentIVF[T]) candidate(uid uint64, c index.CacheType, vec *[]T) ([]byte, bool) {
+	if !index.IsQuantized(ivf.encoding) {
+		ivf.getVecFromUid(uid, c, vec)
+		return nil, len(*vec) > 0
+	}
+	*vec = nil
+	data := getData(ivf.pred, uid, c)
+	return data, len(data) > 0
+}
+
+// scoreCandidate scores a vector read by candidate against query. The
+// quantized vectors are compared with encodedQuery, the query encoded once
+// by encodeQuery.
+func (ivf *persistentIVF[T]) scoreCandidate(query []T, encodedQuery []byte, vec []T,
+	encoded []byte) (T, error) {
+	if index.IsQuantized(ivf.encoding) {
+		return ivf.simType.ScoreQuantized(encodedQuery, encoded, ivf.encoding)
+	}
+	return ivf.simType.Score(query, vec, ivf.floatBits)
+}
+
+// encodeQuery returns query in the encoding of the vectors of the index if
+// it is a quantized one, or nil.
+func (ivf *persistentIVF[T]) encodeQuery(query []T) []byte {
+	if !index.IsQuantized(ivf.encoding) {
+		return nil
+	}
+	return index.EncodeVector(query, ivf.encoding)
+}
+
+func (ivf *persistentIVF[T]) getCodebook(c index.CacheType) (*codebook[T], error) {
+	return decodeCodebook[T](getData(ivf.codebookKey, 1, c), ivf.floatBits)
+}
+
+// getQuantizer returns the product quantizer of the index, or nil if it has
+// none.
+func (ivf *persistentIVF[T]) getQuantizer(c index.CacheType) (*quantizer[T], error) {
+	if ivf.pq == 0 {
+		return nil, nil
+	}
+	return decodeQuantizer[T](getData(ivf.codebookKey, 2, c), ivf.floatBits)
+}
+
+func (ivf *persistentIVF[T]) getList(cluster int, c index.CacheType) ([]uint64, error) {
+	return c.Uids(hnsw.DataKey(ivf.listKey, uint64(cluster)+1))
+}
+
+// Search searches the index for the nearest neighbors of the query vector.
+func (ivf *persistentIVF[T]) Search(ctx context.Context, c index.CacheType, query []T,
+	maxResults int, filter index.SearchFilter[T]) ([]uint64, error) {
+	r, err := ivf.SearchWithPath(ctx, c, query, maxResults, filter)
+	return r.Neighbors, err
+}
+
+// SearchWithUid searches the index for the nearest neighbors of the vector of
+// the query uid.
+func (ivf *persistentIVF[T]) SearchWithUid(ctx context.Context, c index.CacheType,
+	queryUid uint64, maxResults int, filter index.SearchFilter[T]) ([]uint64, error) {
+	r, err := ivf.SearchWithUidAndPath(ctx, c, queryUid, maxResults, filter)
+	return r.Neighbors, err
+}
+
+// SearchWithPath allows persistentIVF to implement index.OptionalIndexSupport.
+// See index.OptionalIndexSupport.SearchWithPath for more info. The Path of the
+// result is empty, as there is no graph to traverse.
+func (ivf *persistentIVF[T]) SearchWithPath(
+	ctx context.Context,
+	c index.CacheType,
+	query []T,
+	maxResults int,
+	filter index.SearchFilter[T]) (*index.SearchPathResult, error) {
+	start := time.Now().UnixMilli()
+	r := index.NewSearchPathResult()
+
+	cb, err := ivf.getCodebook(c)
+	if err != nil {
+		return index.NewSearchPathResult(), err
+	}
+	if len(cb.centroids) == 0 {
+		// The index is empty.
+		return r, nil
+	}
+	q, err := ivf.getQuantizer(c)
+	if err != nil {
+		return index.NewSearchPathResult(), err
+	}
+
+	// Pick the nprobe clusters whose centroids are the closest to query.
+	clusters := make([]neighbor[T], 0, len(cb.centroids))
+	for i, centroid := range cb.centroids {
+		score, err := ivf.simType.Score(query, centroid, ivf.floatBits)
+		if err != nil {
+			return index.NewSearchPathResult(), err
+		}
+		clusters = append(clusters, neighbor[T]{uid: uint64(i), score: score})
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return ivf.simType.IsBetterScore(clusters[i].score, clusters[j].score)
+	})
+	if len(clusters) > ivf.nprobe {
+		clusters = clusters[:ivf.nprobe]
+	}
+	r.Metrics[distanceComputations] += uint64(len(cb.centroids))
+	r.Metrics[probedClusters] = uint64(len(clusters))
+
+	best := &topK[T]{k: maxResults, simType: ivf.simType}
+	encodedQuery := ivf.encodeQuery(query)
+	var vec []T
+	// score adds uid to best if it passes filter, and returns false if it
+	// doesn't.
+	score := func(uid uint64) (bool, error) {
+		encoded, ok := ivf.candidate(uid, c, &vec)
+		// Vectors that have been deleted since they were inserted
+		// are skipped.
+		if !ok || !filter(query, vec, uid) {
+			return false, nil
+		}
+		score, err := ivf.scoreCandidate(query, encodedQuery, vec, encoded)
+		if err != nil {
+			return false, err
+		}
+		r.Metrics[distanceComputations]++
+		best.add(neighbor[T]{uid: uid, score: score})
+		return true, nil
+	}
+
+	var table [][]float64
+	if q != nil {
+		table = q.table(query, ivf.simType.Name() == hnsw.DotProd)
+	}
+	var approx []neighbor[T]
+	for _, cluster := range clusters {
+		uids, err := ivf.getList(int(cluster.uid), c)
+		if err != nil {
+			return index.NewSearchPathResult(), err
+		}
+		for _, uid := range uids {
+			if err := ctx.Err(); err != nil {
+				return index.NewSearchPathResult(), err
+			}
+			if code := getData(ivf.pqKey, uid, c); table != nil && len(code) == len(table) {
+				r.Metrics[pqDistanceComputations]++
+				approx = append(approx, neighbor[T]{uid: uid, score: T(approxScore(table, code))})
+				continue
+			}
+			// The vectors inserted before the quantizer was trained have no
+			// code yet.
+			if _, err := score(uid); err != nil {
+				return index.NewSearchPathResult(), err
+			}
+		}
+	}
+
+	// Only the best candidates according to their codes are compared
+	// exactly with the query.
+	sort.SliceStable(approx, func(i, j int) bool {
+		return ivf.simType.IsBetterScore(approx[i].score, approx[j].score)
+	})
+	scored := 0
+	for _, n := range approx {
+		if scored == maxResults*pqRerank {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return index.NewSearchPathResult(), err
+		}
+		ok, err := score(n.uid)
+		if err != nil {
+			return index.NewSearchPathResult(), err
+		}
+		if ok {
+			scored++
+		}
+	}
+	best.addFinalNeighbors(r)
+	r.Metrics[searchTime] = uint64(time.Now().UnixMilli() - start)
+	return r, nil
+}
+
+// SearchWithUidAndPath allows persistentIVF to implement index.OptionalIndexSupport.
+// See index.OptionalIndexSupport.SearchWithUidAndPath for more info.
+func (ivf *persistentIVF[T]) SearchWithUidAndPath(ctx context.Context, c index.CacheType,
+	queryUid uint64, maxResults int, filter index.SearchFilter[T]) (*index.SearchPathResult, error) {
+	var queryVec []T
+	ivf.getVecFromUid(queryUid, c, &queryVec)
+	if len(queryVec) == 0 {
+		// No vector. return empty result
+		return index.NewSearchPathResult(), nil
+	}
+	return ivf.SearchWithPath(ctx, c, queryVec, maxResults, filter)
+}
+
+// SearchExact allows persistentIVF to implement index.OptionalIndexSupport.
+// See index.OptionalIndexSupport.SearchExact for more info.
+func (ivf *persistentIVF[T]) SearchExact(
+	ctx context.Context,
+	c index.CacheType,
+	query []T,
+	candidates []uint64,
+	maxResults int) (*index.SearchPathResult, error) {
+	start := time.Now().UnixMilli()
+	r := index.NewSearchPathResult()
+	best := &topK[T]{k: maxResults, simType: ivf.simType}
+	encodedQuery := ivf.encodeQuery(query)
+	var vec []T
+	for _, uid := range candidates {
+		if err := ctx.Err(); err != nil {
+			return index.NewSearchPathResult(), err
+		}
+		encoded, ok := ivf.candidate(uid, c, &vec)
+		if !ok {
+			continue
+		}
+		score, err := ivf.scoreCandidate(query, encodedQuery, vec, encoded)
+		if err != nil {
+			return index.NewSearchPathResult(), err
+		}
+		r.Metrics[distanceComputations]++
+		best.add(neighbor[T]{uid: uid, score: score})
+	}
+	best.addFinalNeighbors(r)
+	r.Metrics[searchTime] = uint64(time.Now().UnixMilli() - start)
+	return r, nil
+}
+
+// SearchExactWithUid allows persistentIVF to implement index.OptionalIndexSupport.
+// See index.OptionalIndexSupport.SearchExactWithUid for more info.
+func (ivf *persistentIVF[T]) SearchExactWithUid(
+	ctx context.Context,
+	c index.CacheType,
+	queryUid uint64,
+	candidates []uint64,
+	maxResults int) (*index.SearchPathResult, error) {
+	var queryVec []T
+	ivf.getVecFromUid(queryUid, c, &queryVec)
+	if len(queryVec) == 0 {
+		// No vector. return empty result
+		return index.NewSearchPathResult(), nil
+	}
+	return ivf.SearchExact(ctx, c, queryVec, candidates, maxResults)
+}
+
+// ivfTxn wraps the transaction used to update the index, keeping track of
+// the key-values written so that they can be returned by Insert and Train.
+type ivfTxn struct {
+	txn    index.Txn
+	writes []*index.KeyValue
+}
+
+func (t *ivfTxn) set(ctx context.Context, attr string, uid uint64, value []byte) error {
+	kv := &index.KeyValue{Entity: uid, Attr: attr, Value: value}
+	if err := t.txn.AddMutation(ctx, hnsw.DataKey(attr, uid), kv); err != nil {
+		return err
+	}
+	t.writes = append(t.writes, kv)
+	return nil
+}
+
+func (t *ivfTxn) addUid(ctx context.Context, attr string, entity, uid uint64) error {
+	kv := &index.KeyValue{Entity: entity, Attr: attr}
+	return t.txn.AddUid(ctx, hnsw.DataKey(attr, entity), kv, uid)
+}
+
+func (t *ivfTxn) deleteUid(ctx context.Context, attr string, entity, uid uint64) error {
+	kv := &index.KeyValue{Entity: entity, Attr: attr}
+	return t.txn.DeleteUid(ctx, hnsw.DataKey(attr, entity), kv, uid)
+}
+
+// Insert adds the vector inVec of inUuid to the index. If inUuid was already
+// in the index, it is moved to the cluster of its new vector.
+func (ivf *persistentIVF[T]) Insert(ctx context.Context, c index.CacheType,
+	inUuid uint64, inVec []T) ([]*index.KeyValue, error) {
+	tc, ok := c.(*hnsw.TxnCache)
+	if !ok || len(inVec) == 0 {
+		return []*index.KeyValue{}, nil
+	}
+	txn := &ivfTxn{txn: tc.Txn()}
+
+	cb, err := ivf.getCodebook(tc)
+	if err != nil {
+		return nil, err
+	}
+	if ivf.pq > 0 && len(inVec)%ivf.pq != 0 {
+		return nil, errors.Errorf("can not insert a vector of length %d in an IVF index "+
+			"splitting vectors in %d subvectors", len(inVec), ivf.pq)
+	}
+	if !cb.trained && len(cb.centroids) < ivf.nlist {
+		if cb, err = ivf.seed(ctx, txn, inVec); err != nil {
+			return nil, err
+		}
+	}
+	if len(cb.centroids[0]) != len(inVec) {
+		return nil, errors.Errorf("can not insert a vector of length %d in an IVF index of "+
+			"vectors of length %d", len(inVec), len(cb.centroids[0]))
+	}
+
+	var q *quantizer[T]
+	if cb.trained {
+		if q, err = ivf.getQuantizer(tc); err != nil {
+			return nil, err
+		}
+	}
+	cluster, err := nearestCentroid(inVec, cb.centroids, ivf.simType, ivf.floatBits)
+	if err != nil {
+		return nil, err
+	}
+	if err := ivf.assign(ctx, txn, tc, inUuid, inVec, cluster, cb.trained, q); err != nil {
+		return nil, err
+	}
+	return txn.writes, nil
+}
+
+// seed adds vec to the centroids of the untrained codebook, if it still has
+// less than nlist of them, and returns the codebook. As the codebook is
+// written by all the transactions seeding it, only the first nlist inserts
+// may conflict with each other.
+func (ivf *persistentIVF[T]) seed(ctx context.Context, txn *ivfTxn, vec []T) (*codebook[T], error) {
+	cbKey := hnsw.DataKey(ivf.codebookKey, 1)
+	txn.txn.LockKey(cbKey)
+	defer txn.txn.UnlockKey(cbKey)
+	var cb *codebook[T]
+	data, _ := txn.txn.GetWithLockHeld(cbKey)
+	if data == nil {
+		cb = &codebook[T]{}
+	} else {
+		var err error
+		if cb, err = decodeCodebook[T](data.([]byte), ivf.floatBits); err != nil {
+			return nil, err
+		}
+	}
+	if cb.trained || len(cb.centroids) >= ivf.nlist ||
+		(len(cb.centroids) > 0 && len(cb.centroids[0]) != len(vec)) {
+		return cb, nil
+	}
+	cb.centroids = append(cb.centroids, append([]T{}, vec...))
+	kv := &index.KeyValue{Entity: 1, Attr: ivf.codebookKey, Value: encodeCodebook(cb, ivf.floatBits)}
+	if err := txn.txn.AddMutationWithLockHeld(ctx, cbKey, kv); err != nil {
+		return nil, err
+	}
+	txn.writes = append(txn.writes, kv)
+	return cb, nil
+}
+
+// Update replaces the vector of inUuid in the index by inVec. As Insert
+// already moves a uid that is in the index to the cluster of its new vector,
+// Update is the same as Insert.
+func (ivf *persistentIVF[T]) Update(ctx context.Context, c index.CacheType,
+	inUuid uint64, inVec []T) ([]*index.KeyValue, error) {
+	return ivf.Insert(ctx, c, inUuid, inVec)
+}
+
+// Delete removes inUuid from the uid list of its cluster. The codebook is
+// left as is, even if the vector of inUuid was used as a centroid.
+func (ivf *persistentIVF[T]) Delete(ctx context.Context, c index.CacheType,
+	inUuid uint64) ([]*index.KeyValue, error) {
+	tc, ok := c.(*hnsw.TxnCache)
+	if !ok {
+		return []*index.KeyValue{}, nil
+	}
+	txn := &ivfTxn{txn: tc.Txn()}
+	cluster, _, ok := decodeAssignment(getData(ivf.assignmentKey, inUuid, tc))
+	if !ok {
+		return txn.writes, nil
+	}
+	if err := txn.deleteUid(ctx, ivf.listKey, uint64(cluster)+1, inUuid); err != nil {
+		return nil, err
+	}
+	// An empty assignment means that the uid is in no cluster.
+	if err := txn.set(ctx, ivf.assignmentKey, inUuid, []byte{}); err != nil {
+		return nil, err
+	}
+	return txn.writes, nil
+}
+
+// assign moves uid, whose vector is vec, to cluster. trained tells whether
+// cluster was picked with the trained codebook. If q is not nil, the code of
+// vec is stored as well.
+func (ivf *persistentIVF[T]) assign(ctx context.Context, txn *ivfTxn, c index.CacheType,
+	uid uint64, vec []T, cluster int, trained bool, q *quantizer[T]) error {
+	prev, _, ok := decodeAssignment(getData(ivf.assignmentKey, uid, c))
+	if ok && prev != cluster {
+		if err := txn.deleteUid(ctx, ivf.listKey, uint64(prev)+1, uid); err != nil {
+			return err
+		}
+	}
+	if !ok || prev != cluster {
+		if err := txn.addUid(ctx, ivf.listKey, uint64(cluster)+1, uid); err != nil {
+			return err
+		}
+	}
+	if err := txn.set(ctx, ivf.assignmentKey, uid, encodeAssignment(cluster, trained)); err != nil {
+		return err
+	}
+	if q == nil {
+		return nil
+	}
+	code, err := q.encode(vec, ivf.floatBits)
+	if err != nil {
+		return err
+	}
+	return txn.set(ctx, ivf.pqKey, uid, code)
+}
+
+// NeedsTraining allows persistentIVF to implement index.Trainer. The index
+// needs training once it holds trainingSize vectors, until all of them have
+// been moved to their trained cluster.
+func (ivf *persistentIVF[T]) NeedsTraining(ctx context.Context, c index.CacheType) (bool, error) {
+	cb, err := ivf.getCodebook(c)
+	switch {
+	case err != nil:
+		return false, err
+	case cb.settled:
+		return false, nil
+	case cb.trained:
+		return true, nil
+	}
+	count := 0
+	for cluster := range cb.centroids {
+		uids, err := ivf.getList(cluster, c)
+		if err != nil {
+			return false, err
+		}
+		if count += len(uids); count >= ivf.trainingSize {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Train allows persistentIVF to implement index.Trainer. The first step
+// trains the codebook, and the product quantizer if any, then each step
+// moves up to trainBatch vectors to their trained cluster, until the
+// codebook is settled.
+func (ivf *persistentIVF[T]) Train(ctx context.Context, c index.CacheType) ([]*index.KeyValue, error) {
+	tc, ok := c.(*hnsw.TxnCache)
+	if !ok {
+		return []*index.KeyValue{}, nil
+	}
+	txn := &ivfTxn{txn: tc.Txn()}
+	cb, err := ivf.getCodebook(tc)
+	if err != nil || cb.settled || len(cb.centroids) == 0 {
+		return txn.writes, err
+	}
+	var q *quantizer[T]
+	if cb.trained {
+		q, err = ivf.getQuantizer(tc)
+	} else {
+		q, err = ivf.trainCodebook(ctx, txn, tc, cb)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := ivf.reassign(ctx, txn, tc, cb, q); err != nil {
+		return nil, err
+	}
+	return txn.writes, nil
+}
+
+// trainCodebook runs k-means over a sample of trainingSize vectors of the
+// index, seeded with the current centroids, and persists the trained
+// codebook along with the product quantizer trained over the same sample,
+// which is returned.
+func (ivf *persistentIVF[T]) trainCodebook(ctx context.Context, txn *ivfTxn, c index.CacheType,
+	cb *codebook[T]) (*quantizer[T], error) {
+	var uids []uint64
+	for cluster := range cb.centroids {
+		list, err := ivf.getList(cluster, c)
+		if err != nil {
+			return nil, err
+		}
+		uids = append(uids, list...)
+	}
+	slices.Sort(uids)
+	var vectors [][]T
+	for _, uid := range sampleUids(uids, ivf.trainingSize) {
+		var vec []T
+		ivf.getVecFromUid(uid, c, &vec)
+		if len(vec) == len(cb.centroids[0]) {
+			vectors = append(vectors, vec)
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	centroids, err := kmeans(vectors, cb.centroids, ivf.simType, ivf.floatBits)
+	if err != nil {
+		return nil, err
+	}
+	cb.trained, cb.centroids = true, centroids
+	if err := txn.set(ctx, ivf.codebookKey, 1, encodeCodebook(cb, ivf.floatBits)); err != nil {
+		return nil, err
+	}
+	if ivf.pq == 0 || len(vectors) == 0 {
+		return nil, nil
+	}
+	q, err := trainQuantizer(vectors, ivf.pq, ivf.floatBits)
+	if err != nil {
+		return nil, err
+	}
+	return q, txn.set(ctx, ivf.codebookKey, 2, encodeQuantizer(q, ivf.floatBits))
+}
+
+// reassign moves up to trainBatch of the vectors that were assigned with the
+// untrained codebook to their trained cluster. The clusters are scanned in
+// order from the position reached by the previous step, and the codebook is
+// settled once the scan is over.
+func (ivf *persistentIVF[T]) reassign(ctx context.Context, txn *ivfTxn, c index.CacheType,
+	cb *codebook[T], q *quantizer[T]) error {
+	cluster, after := decodeProgress(getData(ivf.codebookKey, 3, c))
+	moved := 0
+	for ; cluster < len(cb.centroids); cluster, after = cluster+1, 0 {
+		uids, err := ivf.getList(cluster, c)
+		if err != nil {
+			return err
+		}
+		for _, uid := range uids {
+			if uid <= after {
+				continue
+			}
+			if moved == trainBatch {
+				return txn.set(ctx, ivf.codebookKey, 3, encodeProgress(cluster, after))
+			}
+			after = uid
+			if _, trained, ok := decodeAssignment(getData(ivf.assignmentKey, uid, c)); !ok || trained {
+				continue
+			}
+			var vec []T
+			ivf.getVecFromUid(uid, c, &vec)
+			if len(vec) != len(cb.centroids[0]) {
+				continue
+			}
+			best, err := nearestCentroid(vec, cb.centroids, ivf.simType, ivf.floatBits)
+			if err != nil {
+				return err
+			}
+			if err := ivf.assign(ctx, txn, c, uid, vec, best, true, q); err != nil {
+				return err
+			}
+			moved++
+		}
+	}
+	cb.settled = true
+	return txn.set(ctx, ivf.codebookKey, 1, encodeCodebook(cb, ivf.floatBits))
+}
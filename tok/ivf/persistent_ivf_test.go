@@ -0,0 +1,423 @@
+/*
+ * Copyright 2016-2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package ivf
+
+import (
+	"context"
+	"encoding/binary"
+	"math"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v24/tok/index"
+	opt "github.com/dgraph-io/dgraph/v24/tok/options"
+)
+
+// memTxn is an index.Txn backed by maps, whose writes are visible right away.
+type memTxn struct {
+	mu    sync.Mutex
+	keyMu sync.Mutex
+	data  map[string][]byte
+	uids  map[string][]uint64
+}
+
+func newMemTxn() *memTxn {
+	return &memTxn{data: map[string][]byte{}, uids: map[string][]uint64{}}
+}
+
+func (t *memTxn) Find(prefix []byte, filter func([]byte) bool) (uint64, error) {
+	return 0, nil
+}
+
+func (t *memTxn) StartTs() uint64 { return 1 }
+
+func (t *memTxn) Get(key []byte) (index.Value, error) {
+	return t.GetWithLockHeld(key)
+}
+
+func (t *memTxn) GetWithLockHeld(key []byte) (index.Value, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	val, ok := t.data[string(key)]
+	if !ok {
+		return nil, errors.New("Could not find data with key " + string(key))
+	}
+	return val, nil
+}
+
+func (t *memTxn) AddMutation(ctx context.Context, key []byte, kv *index.KeyValue) error {
+	return t.AddMutationWithLockHeld(ctx, key, kv)
+}
+
+func (t *memTxn) AddMutationWithLockHeld(ctx context.Context, key []byte, kv *index.KeyValue) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.data[string(key)] = kv.Value
+	return nil
+}
+
+func (t *memTxn) AddUid(ctx context.Context, key []byte, kv *index.KeyValue, uid uint64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	uids := t.uids[string(key)]
+	if i, found := slices.BinarySearch(uids, uid); !found {
+		t.uids[string(key)] = slices.Insert(uids, i, uid)
+	}
+	return nil
+}
+
+func (t *memTxn) DeleteUid(ctx context.Context, key []byte, kv *index.KeyValue, uid uint64) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	uids := t.uids[string(key)]
+	if i, found := slices.BinarySearch(uids, uid); found {
+		t.uids[string(key)] = slices.Delete(uids, i, i+1)
+	}
+	return nil
+}
+
+func (t *memTxn) Uids(key []byte) ([]uint64, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return slices.Clone(t.uids[string(key)]), nil
+}
+
+func (t *memTxn) LockKey(key []byte)   { t.keyMu.Lock() }
+func (t *memTxn) UnlockKey(key []byte) { t.keyMu.Unlock() }
+
+func floatsAsBytes(vec []float64) []byte {
+	buf := make([]byte, 0, 8*len(vec))
+	for _, v := range vec {
+		buf = binary.LittleEndian.AppendUint64(buf, math.Float64bits(v))
+	}
+	return buf
+}
+
+func newTestIVF(t *testing.T, nlist, nprobe, trainingSize int) *persistentIVF[float64] {
+	return newTestIVFWithPQ(t, nlist, nprobe, trainingSize, 0)
+}
+
+func newTestIVFWithPQ(t *testing.T, nlist, nprobe, trainingSize, pq int) *persistentIVF[float64] {
+	o := opt.NewOptions()
+	o.SetOpt(NListOpt, nlist)
+	o.SetOpt(NProbeOpt, nprobe)
+	o.SetOpt(TrainingSizeOpt, trainingSize)
+	o.SetOpt(PQOpt, pq)
+	o.SetOpt(MetricOpt, hnsw.GetSimType[float64](hnsw.Euclidian, 64))
+	vi, err := CreateFactory[float64](64).Create("0-vec", o, 64)
+	require.NoError(t, err)
+	return vi.(*persistentIVF[float64])
+}
+
+// list returns the uids of cluster.
+func list(t *testing.T, ivf *persistentIVF[float64], c index.CacheType, cluster int) []uint64 {
+	uids, err := ivf.getList(cluster, c)
+	require.NoError(t, err)
+	return uids
+}
+
+// train runs training steps until the index doesn't need them anymore, and
+// returns the number of steps.
+func train(t *testing.T, ivf *persistentIVF[float64], txn *memTxn) int {
+	tc := hnsw.NewTxnCache(txn, 1)
+	steps := 0
+	for {
+		needed, err := ivf.NeedsTraining(context.Background(), tc)
+		require.NoError(t, err)
+		if !needed {
+			return steps
+		}
+		_, err = ivf.Train(context.Background(), tc)
+		require.NoError(t, err)
+		steps++
+		require.Less(t, steps, 100)
+	}
+}
+
+// insert stores vec as the value of uid and adds it to the index.
+func insert(t *testing.T, ivf *persistentIVF[float64], txn *memTxn,
+	uid uint64, vec []float64) []*index.KeyValue {
+	kv := &index.KeyValue{Entity: uid, Attr: ivf.pred, Value: floatsAsBytes(vec)}
+	require.NoError(t, txn.AddMutation(context.Background(), hnsw.DataKey(ivf.pred, uid), kv))
+	kvs, err := ivf.Insert(context.Background(), hnsw.NewTxnCache(txn, 1), uid, vec)
+	require.NoError(t, err)
+	return kvs
+}
+
+func TestIVFCreateOptions(t *testing.T) {
+	ivf := newTestIVF(t, 4, 2, 10)
+	require.Equal(t, 4, ivf.nlist)
+	require.Equal(t, 2, ivf.nprobe)
+	require.Equal(t, 10, ivf.trainingSize)
+	require.Equal(t, "0-vec"+VecCodebook, ivf.codebookKey)
+
+	vi, err := CreateFactory[float64](64).Create("0-vec", opt.NewOptions(), 64)
+	require.NoError(t, err)
+	require.Equal(t, 100, vi.(*persistentIVF[float64]).nlist)
+	require.Equal(t, 4000, vi.(*persistentIVF[float64]).trainingSize)
+	require.Equal(t, 0, vi.(*persistentIVF[float64]).pq)
+
+	o := opt.NewOptions()
+	o.SetOpt(NListOpt, 0)
+	_, err = CreateFactory[float64](64).Create("0-vec", o, 64)
+	require.Error(t, err)
+
+	// Product quantization approximates euclidean distances and dot products.
+	o = opt.NewOptions()
+	o.SetOpt(PQOpt, 2)
+	o.SetOpt(MetricOpt, hnsw.GetSimType[float64](hnsw.Cosine, 64))
+	_, err = CreateFactory[float64](64).Create("0-vec", o, 64)
+	require.Error(t, err)
+}
+
+func TestIVFSearchEmpty(t *testing.T) {
+	ivf := newTestIVF(t, 4, 2, 10)
+	tc := hnsw.NewTxnCache(newMemTxn(), 1)
+	nns, err := ivf.Search(context.Background(), tc, []float64{1, 2}, 3, index.AcceptAll[float64])
+	require.NoError(t, err)
+	require.Empty(t, nns)
+}
+
+func TestIVFInsertAndSearch(t *testing.T) {
+	ivf := newTestIVF(t, 4, 4, 100)
+	txn := newMemTxn()
+	vecs := map[uint64][]float64{
+		1: {0, 0},
+		2: {10, 10},
+		3: {0, 1},
+		4: {10, 11},
+		5: {5, 5},
+		6: {1, 0},
+	}
+	for uid := uint64(1); uid <= 6; uid++ {
+		kvs := insert(t, ivf, txn, uid, vecs[uid])
+		require.NotEmpty(t, kvs)
+	}
+
+	tc := hnsw.NewTxnCache(txn, 1)
+	r, err := ivf.SearchWithPath(context.Background(), tc, []float64{0, 0.1}, 3, index.AcceptAll[float64])
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 3, 6}, r.Neighbors)
+	require.True(t, slices.IsSorted(r.Distances))
+	require.Equal(t, uint64(4), r.Metrics[probedClusters])
+
+	nns, err := ivf.SearchWithUid(context.Background(), tc, 2, 2, index.AcceptAll[float64])
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 4}, nns)
+
+	notOne := func(_, _ []float64, uid uint64) bool { return uid != 1 }
+	nns, err = ivf.Search(context.Background(), tc, []float64{0, 0.1}, 1, notOne)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, nns)
+
+	r, err = ivf.SearchExact(context.Background(), tc, []float64{0, 0}, []uint64{2, 5, 999}, 5)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{5, 2}, r.Neighbors)
+}
+
+func TestIVFUpdate(t *testing.T) {
+	ivf := newTestIVF(t, 2, 1, 100)
+	txn := newMemTxn()
+	insert(t, ivf, txn, 1, []float64{0, 0})
+	insert(t, ivf, txn, 2, []float64{10, 10})
+	insert(t, ivf, txn, 3, []float64{1, 1})
+	tc := hnsw.NewTxnCache(txn, 1)
+	require.Equal(t, []uint64{1, 3}, list(t, ivf, tc, 0))
+
+	// Moving 3 next to 2 must move it to the cluster of 2.
+	insert(t, ivf, txn, 3, []float64{9, 9})
+	require.Equal(t, []uint64{1}, list(t, ivf, tc, 0))
+	require.Equal(t, []uint64{2, 3}, list(t, ivf, tc, 1))
+	nns, err := ivf.Search(context.Background(), tc, []float64{10, 10}, 2, index.AcceptAll[float64])
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, nns)
+}
+
+func TestIVFInsertWritesPerUid(t *testing.T) {
+	ivf := newTestIVF(t, 2, 1, 100)
+	txn := newMemTxn()
+	attrs := func(kvs []*index.KeyValue) map[string]int {
+		m := map[string]int{}
+		for _, kv := range kvs {
+			m[kv.Attr]++
+		}
+		return m
+	}
+	// Only the inserts seeding the codebook write it. The others only
+	// write the assignment of their uid, and add it to the list of its
+	// cluster, so that concurrent inserts don't conflict.
+	require.Equal(t, map[string]int{ivf.codebookKey: 1, ivf.assignmentKey: 1},
+		attrs(insert(t, ivf, txn, 1, []float64{0, 0})))
+	insert(t, ivf, txn, 2, []float64{10, 10})
+	require.Equal(t, map[string]int{ivf.assignmentKey: 1},
+		attrs(insert(t, ivf, txn, 3, []float64{1, 1})))
+
+	_, err := txn.Get(hnsw.DataKey(ivf.codebookKey, 2))
+	require.Error(t, err)
+}
+
+func TestIVFTraining(t *testing.T) {
+	ivf := newTestIVF(t, 2, 1, 20)
+	txn := newMemTxn()
+	// The first two vectors seed the codebook in the same group, so the
+	// untrained clusters are poor. Training must separate both groups.
+	for uid := uint64(1); uid <= 20; uid++ {
+		base := 0.0
+		if uid > 10 || uid == 1 {
+			base = 100
+		}
+		insert(t, ivf, txn, uid, []float64{base + float64(uid%5), base})
+		if uid == 19 {
+			// Inserts never train the index.
+			needed, err := ivf.NeedsTraining(context.Background(), hnsw.NewTxnCache(txn, 1))
+			require.NoError(t, err)
+			require.False(t, needed)
+		}
+	}
+	tc := hnsw.NewTxnCache(txn, 1)
+	cb, err := ivf.getCodebook(tc)
+	require.NoError(t, err)
+	require.False(t, cb.trained)
+
+	// The codebook is trained and all the vectors are moved in one step.
+	require.Equal(t, 1, train(t, ivf, txn))
+	cb, err = ivf.getCodebook(tc)
+	require.NoError(t, err)
+	require.True(t, cb.trained)
+	require.True(t, cb.settled)
+	require.Len(t, cb.centroids, 2)
+
+	sizes := []int{len(list(t, ivf, tc, 0)), len(list(t, ivf, tc, 1))}
+	slices.Sort(sizes)
+	require.Equal(t, []int{9, 11}, sizes)
+
+	nns, err := ivf.Search(context.Background(), tc, []float64{0, 0}, 20, index.AcceptAll[float64])
+	require.NoError(t, err)
+	require.Len(t, nns, 9)
+	for _, uid := range nns {
+		require.True(t, uid >= 2 && uid <= 10)
+	}
+
+	// Once trained, the codebook does not change with new vectors.
+	insert(t, ivf, txn, 21, []float64{50, 50})
+	cb2, err := ivf.getCodebook(tc)
+	require.NoError(t, err)
+	require.Equal(t, cb.centroids, cb2.centroids)
+	require.Equal(t, 0, train(t, ivf, txn))
+}
+
+func TestIVFTrainingResumesReassignment(t *testing.T) {
+	ivf := newTestIVF(t, 2, 2, 4)
+	txn := newMemTxn()
+	for uid := uint64(1); uid <= 4; uid++ {
+		insert(t, ivf, txn, uid, []float64{float64(uid), 0})
+	}
+	tc := hnsw.NewTxnCache(txn, 1)
+	// Pretend that a previous step stopped after the first vector of the
+	// first cluster, which must be left alone.
+	cb, err := ivf.getCodebook(tc)
+	require.NoError(t, err)
+	q, err := ivf.trainCodebook(context.Background(), &ivfTxn{txn: txn}, tc, cb)
+	require.NoError(t, err)
+	require.Nil(t, q)
+	first := list(t, ivf, tc, 0)[0]
+	require.NoError(t, (&ivfTxn{txn: txn}).set(context.Background(), ivf.codebookKey, 3,
+		encodeProgress(0, first)))
+
+	require.Equal(t, 1, train(t, ivf, txn))
+	_, trained, ok := decodeAssignment(getData(ivf.assignmentKey, first, tc))
+	require.True(t, ok)
+	require.False(t, trained)
+	for uid := uint64(1); uid <= 4; uid++ {
+		if uid == first {
+			continue
+		}
+		_, trained, ok := decodeAssignment(getData(ivf.assignmentKey, uid, tc))
+		require.True(t, ok)
+		require.True(t, trained)
+	}
+}
+
+func TestIVFProductQuantization(t *testing.T) {
+	ivf := newTestIVFWithPQ(t, 2, 2, 40, 2)
+	txn := newMemTxn()
+	vecs := map[uint64][]float64{}
+	for uid := uint64(1); uid <= 40; uid++ {
+		vecs[uid] = []float64{float64(uid), float64(uid % 7), float64(uid % 3), float64(uid / 10)}
+		insert(t, ivf, txn, uid, vecs[uid])
+	}
+	_, err := ivf.Insert(context.Background(), hnsw.NewTxnCache(txn, 1), 41, []float64{1, 2, 3})
+	require.Error(t, err)
+
+	require.Equal(t, 1, train(t, ivf, txn))
+	tc := hnsw.NewTxnCache(txn, 1)
+	q, err := ivf.getQuantizer(tc)
+	require.NoError(t, err)
+	require.Len(t, q.centroids, 2)
+	require.Equal(t, 2, q.dsub)
+	for uid := range vecs {
+		require.Len(t, getData(ivf.pqKey, uid, tc), 2)
+	}
+
+	// The codes only rank the candidates, the results are exact.
+	r, err := ivf.SearchWithPath(context.Background(), tc, vecs[17], 3, index.AcceptAll[float64])
+	require.NoError(t, err)
+	require.Equal(t, uint64(17), r.Neighbors[0])
+	require.Equal(t, 0.0, r.Distances[0])
+	require.Equal(t, uint64(40), r.Metrics[pqDistanceComputations])
+	require.LessOrEqual(t, r.Metrics[distanceComputations], uint64(2+3*pqRerank))
+
+	// Vectors inserted once the quantizer is trained get a code as well.
+	insert(t, ivf, txn, 42, []float64{17, 3, 2, 1})
+	require.Len(t, getData(ivf.pqKey, 42, tc), 2)
+}
+
+func TestIVFDelete(t *testing.T) {
+	ivf := newTestIVF(t, 2, 2, 100)
+	txn := newMemTxn()
+	insert(t, ivf, txn, 1, []float64{0, 0})
+	insert(t, ivf, txn, 2, []float64{10, 10})
+	insert(t, ivf, txn, 3, []float64{1, 1})
+	tc := hnsw.NewTxnCache(txn, 1)
+
+	kvs, err := ivf.Delete(context.Background(), tc, 3)
+	require.NoError(t, err)
+	require.Len(t, kvs, 1)
+	require.Equal(t, []uint64{1}, list(t, ivf, tc, 0))
+	nns, err := ivf.Search(context.Background(), tc, []float64{1, 1}, 3, index.AcceptAll[float64])
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, nns)
+
+	// Deleting it again, or deleting a uid that was never inserted, does nothing.
+	kvs, err = ivf.Delete(context.Background(), tc, 3)
+	require.NoError(t, err)
+	require.Empty(t, kvs)
+	kvs, err = ivf.Delete(context.Background(), tc, 42)
+	require.NoError(t, err)
+	require.Empty(t, kvs)
+
+	// Once inserted again, it is found again.
+	insert(t, ivf, txn, 3, []float64{1, 1})
+	nns, err = ivf.Search(context.Background(), tc, []float64{1, 1}, 1, index.AcceptAll[float64])
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3}, nns)
+}
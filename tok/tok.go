@@ -23,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/blevesearch/bleve/v2/analysis"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	"github.com/twpayne/go-geom"
@@ -31,6 +32,8 @@ import (
 
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
 	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v24/tok/index"
+	"github.com/dgraph-io/dgraph/v24/tok/ivf"
 	opts "github.com/dgraph-io/dgraph/v24/tok/options"
 	"github.com/dgraph-io/dgraph/v24/types"
 	"github.com/dgraph-io/dgraph/v24/x"
@@ -59,6 +62,7 @@ const (
 	IdentSha       = 0xC
 	IdentBigFloat  = 0xD
 	IdentVFloat    = 0xE
+	IdentComposite = 0x10
 	IdentCustom    = 0x80
 	IdentDelimiter = 0x1f // ASCII 31 - Unit separator
 )
@@ -96,6 +100,7 @@ var indexFactories = make(map[string]IndexFactory)
 func init() {
 	registerTokenizer(BigFloatTokenizer{})
 	registerIndexFactory(createIndexFactory(hnsw.CreateFactory[float32](32)))
+	registerIndexFactory(createIndexFactory(ivf.CreateFactory[float32](32)))
 	registerTokenizer(GeoTokenizer{})
 	registerTokenizer(IntTokenizer{})
 	registerTokenizer(FloatTokenizer{})
@@ -182,7 +187,24 @@ func getOptsFromFactorySpec(f IndexFactory, spec *pb.VectorIndexSpec) (opts.Opti
 	return retVal, nil
 }
 
-func GetFactoryCreateSpecFromSpec(spec *pb.VectorIndexSpec) (*FactoryCreateSpec, error) {
+// VectorEncoding returns the index.VectorEncodingOpt value describing how
+// vectors of type typ are stored.
+func VectorEncoding(typ types.TypeID) string {
+	switch typ {
+	case types.VInt8ID:
+		return index.Int8Encoding
+	case types.VBinaryID:
+		return index.BinaryEncoding
+	default:
+		return index.FloatEncoding
+	}
+}
+
+// GetFactoryCreateSpecFromSpec returns the FactoryCreateSpec of the vector
+// index described by spec, for a predicate of type typ. list is true if the
+// predicate holds a list of vectors per node.
+func GetFactoryCreateSpecFromSpec(spec *pb.VectorIndexSpec,
+	typ types.TypeID, list bool) (*FactoryCreateSpec, error) {
 	factory, found := GetIndexFactoryFromSpec(spec)
 	if !found {
 		return &FactoryCreateSpec{}, errors.Errorf(
@@ -192,6 +214,10 @@ func GetFactoryCreateSpecFromSpec(spec *pb.VectorIndexSpec) (*FactoryCreateSpec,
 	if err != nil {
 		return &FactoryCreateSpec{}, err
 	}
+	opts.SetOpt(index.VectorEncodingOpt, VectorEncoding(typ))
+	if list {
+		opts.SetOpt(index.MultiVectorOpt, true)
+	}
 	return &FactoryCreateSpec{factory: factory, opts: opts}, nil
 }
 
@@ -445,16 +471,20 @@ func (t FullTextTokenizer) Tokens(v interface{}) ([]string, error) {
 	if !ok || str == "" {
 		return []string{}, nil
 	}
+	return uniqueTerms(t.analyze(str)), nil
+}
+
+// analyze returns every full-text term of str, in order and with repetitions.
+func (t FullTextTokenizer) analyze(str string) analysis.TokenStream {
 	lang := LangBase(t.lang)
 	// pass 1 - lowercase and normalize input
 	tokens := fulltextAnalyzer.Analyze([]byte(str))
 	// pass 2 - filter stop words
 	tokens = filterStopwords(lang, tokens)
 	// pass 3 - filter stems
-	tokens = filterStemmers(lang, tokens)
-	// finally, return the terms.
-	return uniqueTerms(tokens), nil
+	return filterStemmers(lang, tokens)
 }
+
 func (t FullTextTokenizer) Identifier() byte { return IdentFullText }
 func (t FullTextTokenizer) IsSortable() bool { return false }
 func (t FullTextTokenizer) IsLossy() bool    { return true }
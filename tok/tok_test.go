@@ -23,6 +23,12 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v24/tok/index"
+	opts "github.com/dgraph-io/dgraph/v24/tok/options"
+	"github.com/dgraph-io/dgraph/v24/types"
 )
 
 type encL struct {
@@ -333,3 +339,131 @@ func checkSortedAndUnique(t *testing.T, tokens []string) {
 func BenchmarkTermTokenizer(b *testing.B) {
 	b.Skip() // tmp
 }
+
+func TestGetFullTextTermCounts(t *testing.T) {
+	counts, total := GetFullTextTermCounts("Surprise and fear, fear and surprise... and a weapon", "en")
+	require.Equal(t, 5, total)
+	tokens, err := GetFullTextTokens([]string{"surprising fears"}, "en")
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	for _, token := range tokens {
+		require.Equal(t, 2, counts[token])
+	}
+
+	counts, total = GetFullTextTermCounts("", "en")
+	require.Empty(t, counts)
+	require.Zero(t, total)
+}
+
+func TestCreateIndexWithOptions(t *testing.T) {
+	fcs, err := GetFactoryCreateSpecFromSpec(&pb.VectorIndexSpec{
+		Name:    "hnsw",
+		Options: []*pb.OptionPair{{Key: hnsw.EfSearchOpt, Value: "10"}},
+	}, types.VFloatID, false)
+	require.NoError(t, err)
+	_, err = fcs.CreateIndexWithOptions("0-vec",
+		[]opts.OptionValuePair{{Option: hnsw.EfSearchOpt, Value: "200"}})
+	require.NoError(t, err)
+	// The override only applies to the index created for it.
+	ef, _, err := opts.GetOpt(fcs.opts, hnsw.EfSearchOpt, 0)
+	require.NoError(t, err)
+	require.Equal(t, 10, ef)
+
+	_, err = fcs.CreateIndexWithOptions("0-vec",
+		[]opts.OptionValuePair{{Option: hnsw.EfSearchOpt, Value: "many"}})
+	require.Error(t, err)
+
+	fcs, err = GetFactoryCreateSpecFromSpec(&pb.VectorIndexSpec{Name: "ivf"}, types.VFloatID, false)
+	require.NoError(t, err)
+	_, err = fcs.CreateIndexWithOptions("0-vec",
+		[]opts.OptionValuePair{{Option: hnsw.EfSearchOpt, Value: "200"}})
+	require.Error(t, err)
+}
+
+func TestFactoryCreateSpecVectorEncoding(t *testing.T) {
+	for typ, encoding := range map[types.TypeID]string{
+		types.VFloatID:  index.FloatEncoding,
+		types.VInt8ID:   index.Int8Encoding,
+		types.VBinaryID: index.BinaryEncoding,
+	} {
+		fcs, err := GetFactoryCreateSpecFromSpec(&pb.VectorIndexSpec{Name: "hnsw"}, typ, false)
+		require.NoError(t, err)
+		got, _, err := opts.GetOpt(fcs.opts, index.VectorEncodingOpt, "")
+		require.NoError(t, err)
+		require.Equal(t, encoding, got, typ.Name())
+	}
+}
+
+func TestFactoryCreateSpecMultiVector(t *testing.T) {
+	fcs, err := GetFactoryCreateSpecFromSpec(&pb.VectorIndexSpec{Name: "hnsw"}, types.VFloatID, true)
+	require.NoError(t, err)
+	require.True(t, fcs.IsMultiVector())
+	require.Equal(t, hnsw.Euclidian, fcs.SimilarityType().Name())
+
+	fcs, err = GetFactoryCreateSpecFromSpec(&pb.VectorIndexSpec{
+		Name:    "hnsw",
+		Options: []*pb.OptionPair{{Key: hnsw.MetricOpt, Value: hnsw.Cosine}},
+	}, types.VFloatID, false)
+	require.NoError(t, err)
+	require.False(t, fcs.IsMultiVector())
+	require.Equal(t, hnsw.Cosine, fcs.SimilarityType().Name())
+}
+
+func TestCompositeValueEncoding(t *testing.T) {
+	ordered := [][]types.Val{
+		{
+			{Tid: types.IntID, Value: int64(math.MinInt64)},
+			{Tid: types.IntID, Value: int64(-300)},
+			{Tid: types.IntID, Value: int64(-1)},
+			{Tid: types.IntID, Value: int64(0)},
+			{Tid: types.IntID, Value: int64(2)},
+			{Tid: types.IntID, Value: int64(math.MaxInt64)},
+		},
+		{
+			{Tid: types.FloatID, Value: math.Inf(-1)},
+			{Tid: types.FloatID, Value: -2.5},
+			{Tid: types.FloatID, Value: 0.0},
+			{Tid: types.FloatID, Value: 0.75},
+			{Tid: types.FloatID, Value: 12.0},
+		},
+		{
+			{Tid: types.StringID, Value: ""},
+			{Tid: types.StringID, Value: "a"},
+			{Tid: types.StringID, Value: "a\x00"},
+			{Tid: types.StringID, Value: "a\x00b"},
+			{Tid: types.StringID, Value: "ab"},
+			{Tid: types.StringID, Value: "b"},
+		},
+		{
+			{Tid: types.DateTimeID, Value: time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{Tid: types.DateTimeID, Value: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{Tid: types.DateTimeID, Value: time.Date(2020, 1, 1, 0, 0, 0, 5, time.UTC)},
+		},
+		{
+			{Tid: types.BoolID, Value: false},
+			{Tid: types.BoolID, Value: true},
+		},
+	}
+	for _, vals := range ordered {
+		var prev string
+		for i, val := range vals {
+			enc, err := EncodeCompositeValue(val)
+			require.NoError(t, err)
+			if i > 0 {
+				require.Less(t, prev, enc, "%v should sort after %v", val.Value, vals[i-1].Value)
+			}
+			prev = enc
+		}
+	}
+
+	// A string which is a prefix of another one must not mix with the following value.
+	a, err := EncodeCompositeValue(types.Val{Tid: types.StringID, Value: "a"})
+	require.NoError(t, err)
+	ab, err := EncodeCompositeValue(types.Val{Tid: types.StringID, Value: "ab"})
+	require.NoError(t, err)
+	require.Less(t, CompositeToken(a, "\xff"), CompositeToken(ab, "\x00"))
+	require.Equal(t, byte(IdentComposite), CompositeToken(a)[0])
+
+	_, err = EncodeCompositeValue(types.Val{Tid: types.GeoID})
+	require.Error(t, err)
+}
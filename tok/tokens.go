@@ -17,6 +17,8 @@
 package tok
 
 import (
+	"encoding/binary"
+
 	"github.com/pkg/errors"
 	"golang.org/x/text/collate"
 	"golang.org/x/text/language"
@@ -77,3 +79,29 @@ func GetFullTextTokens(funcArgs []string, lang string) ([]string, error) {
 	}
 	return BuildTokens(funcArgs[0], FullTextTokenizer{lang: lang})
 }
+
+// GetFullTextTermCounts returns how many times each full-text token occurs in
+// the given value, along with the total number of tokens of the value. The
+// tokens are encoded like the ones returned by GetFullTextTokens.
+func GetFullTextTermCounts(val, lang string) (map[string]int, int) {
+	counts := make(map[string]int)
+	tokens := FullTextTokenizer{lang: lang}.analyze(val)
+	for i := range tokens {
+		counts[encodeToken(string(tokens[i].Term), IdentFullText)]++
+	}
+	return counts, len(tokens)
+}
+
+// FullTextStatsPrefix is the prefix of the full-text index tokens holding the
+// term counts of each node, see FullTextStatsToken. No full-text term starts
+// with a zero byte, so these tokens never collide with the terms.
+var FullTextStatsPrefix = string([]byte{IdentFullText, 0})
+
+// FullTextStatsToken returns the full-text index token under which the term
+// counts of the values of uid are kept, for scoring them without tokenizing
+// the values again.
+func FullTextStatsToken(uid uint64) string {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uid)
+	return FullTextStatsPrefix + string(buf[:])
+}
@@ -99,10 +99,94 @@ func ParseVFloat(s string) ([]float32, error) {
 	return result, nil
 }
 
+// ParseVFloatList(s) parses s, a list of vectors, into a [][]float32. s is
+// formatted according to the following ebnf, a floatArray being parsed by
+// ParseVFloat:
+//
+//	floatArrayList ::= "[" [whitespace] floatArray
+//	                   ([whitespace] [","] [whitespace] floatArray)* [whitespace] "]"
+func ParseVFloatList(s string) ([][]float32, error) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+		return nil, errors.Errorf("cannot convert %s to a list of vectors", s)
+	}
+	rest := strings.TrimSpace(s[1 : len(s)-1])
+	var result [][]float32
+	for len(rest) > 0 {
+		end := strings.Index(rest, "]")
+		if !strings.HasPrefix(rest, "[") || end < 0 {
+			return nil, errors.Errorf("cannot convert %s to a list of vectors", s)
+		}
+		vf, err := ParseVFloat(rest[:end+1])
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, vf)
+		rest = strings.TrimSpace(rest[end+1:])
+		rest = strings.TrimSpace(strings.TrimPrefix(rest, ","))
+	}
+	if len(result) == 0 {
+		return nil, errors.Errorf("cannot convert %s to a list of vectors", s)
+	}
+	return result, nil
+}
+
 func cannotConvertToVFloat(s string) error {
 	return errors.Errorf("cannot convert %s to vfloat", s)
 }
 
+// ParseVInt8(s) parses s, formatted as described by ParseVFloat, into a
+// vector of int8 values. Every entry must be an integer in [-128, 127].
+func ParseVInt8(s string) ([]int8, error) {
+	vf, err := ParseVFloat(s)
+	if err != nil {
+		return nil, errors.Errorf("cannot convert %s to int8 vector", s)
+	}
+	return vfloatAsVInt8(vf)
+}
+
+func vfloatAsVInt8(vf []float32) ([]int8, error) {
+	result := make([]int8, len(vf))
+	for i, f := range vf {
+		if f != float32(math.Trunc(float64(f))) || f < math.MinInt8 || f > math.MaxInt8 {
+			return nil, errors.Errorf("invalid value %v for int8 vector, "+
+				"expected an integer between %d and %d", f, math.MinInt8, math.MaxInt8)
+		}
+		result[i] = int8(f)
+	}
+	return result, nil
+}
+
+// ParseVBinary(s) parses s, formatted as described by ParseVFloat, into a
+// binary vector packed 8 dimensions per byte, the first dimension being the
+// most significant bit of the first byte. Every entry must be 0 or 1, and
+// the number of entries must be a multiple of 8.
+func ParseVBinary(s string) ([]byte, error) {
+	vf, err := ParseVFloat(s)
+	if err != nil {
+		return nil, errors.Errorf("cannot convert %s to binary vector", s)
+	}
+	return vfloatAsVBinary(vf)
+}
+
+func vfloatAsVBinary(vf []float32) ([]byte, error) {
+	if len(vf)%8 != 0 {
+		return nil, errors.Errorf("invalid length %d for binary vector, "+
+			"expected a multiple of 8", len(vf))
+	}
+	result := make([]byte, len(vf)/8)
+	for i, f := range vf {
+		switch f {
+		case 0:
+		case 1:
+			result[i/8] |= 1 << (7 - i%8)
+		default:
+			return nil, errors.Errorf("invalid value %v for binary vector, expected 0 or 1", f)
+		}
+	}
+	return result, nil
+}
+
 // Convert converts the value to given scalar type.
 func Convert(from Val, toID TypeID) (Val, error) {
 	to := Val{Tid: toID}
@@ -173,6 +257,10 @@ func Convert(from Val, toID TypeID) (Val, error) {
 					return to, errors.Errorf("invalid data for vector of floats: %v", data)
 				}
 				*res = BytesAsFloatArray(data)
+			case VInt8ID:
+				*res = BytesAsInt8Array(data)
+			case VBinaryID:
+				*res = data
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -239,6 +327,18 @@ func Convert(from Val, toID TypeID) (Val, error) {
 					return to, err
 				}
 				*res = vf
+			case VInt8ID:
+				vi, err := ParseVInt8(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = vi
+			case VBinaryID:
+				vb, err := ParseVBinary(vc)
+				if err != nil {
+					return to, err
+				}
+				*res = vb
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -479,6 +579,42 @@ func Convert(from Val, toID TypeID) (Val, error) {
 				vc := BytesAsFloatArray(data)
 				sa := FloatArrayAsString(vc)
 				*res = sa
+			case VInt8ID:
+				vi, err := vfloatAsVInt8(BytesAsFloatArray(data))
+				if err != nil {
+					return to, err
+				}
+				*res = vi
+			case VBinaryID:
+				vb, err := vfloatAsVBinary(BytesAsFloatArray(data))
+				if err != nil {
+					return to, err
+				}
+				*res = vb
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case VInt8ID:
+		{
+			switch toID {
+			case BinaryID:
+				*res = data
+			case VInt8ID:
+				*res = BytesAsInt8Array(data)
+			case StringID:
+				*res = Int8ArrayAsString(BytesAsInt8Array(data))
+			default:
+				return to, cantConvert(fromID, toID)
+			}
+		}
+	case VBinaryID:
+		{
+			switch toID {
+			case BinaryID, VBinaryID:
+				*res = data
+			case StringID:
+				*res = BinaryVectorAsString(data)
 			default:
 				return to, cantConvert(fromID, toID)
 			}
@@ -638,6 +774,26 @@ func Marshal(from Val, to *Val) error {
 		default:
 			return cantConvert(fromID, toID)
 		}
+	case VInt8ID:
+		vc := val.([]int8)
+		switch toID {
+		case BinaryID:
+			*res = Int8ArrayAsBytes(vc)
+		case StringID:
+			*res = Int8ArrayAsString(vc)
+		default:
+			return cantConvert(fromID, toID)
+		}
+	case VBinaryID:
+		vc := val.([]byte)
+		switch toID {
+		case BinaryID:
+			*res = vc
+		case StringID:
+			*res = BinaryVectorAsString(vc)
+		default:
+			return cantConvert(fromID, toID)
+		}
 	default:
 		return cantConvert(fromID, toID)
 	}
@@ -718,6 +874,15 @@ func ObjectValue(id TypeID, value interface{}) (*api.Value, error) {
 			return def, err
 		}
 		return &api.Value{Val: &api.Value_Vfloat32Val{Vfloat32Val: vf}}, nil
+	case VInt8ID, VBinaryID:
+		// The api has no value for these vectors, they are sent as strings
+		// and converted back by the schema type of the predicate.
+		var v Val
+		v.Tid = StringID
+		if err := Marshal(Val{id, value}, &v); err != nil {
+			return def, err
+		}
+		return &api.Value{Val: &api.Value_StrVal{StrVal: v.Value.(string)}}, nil
 	default:
 		return def, errors.Errorf("ObjectValue not available for: %v", id)
 	}
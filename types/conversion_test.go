@@ -855,3 +855,55 @@ func TestConvertToString(t *testing.T) {
 		require.EqualValues(t, Val{Tid: StringID, Value: tc.out}, out)
 	}
 }
+
+func TestConvertVectors(t *testing.T) {
+	out, err := Convert(Val{Tid: StringID, Value: []byte("[1, -128, 127, 0]")}, VInt8ID)
+	require.NoError(t, err)
+	require.EqualValues(t, Val{Tid: VInt8ID, Value: []int8{1, -128, 127, 0}}, out)
+
+	out, err = Convert(Val{Tid: StringID, Value: []byte("[1, 0, 0, 0, 0, 0, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1]")},
+		VBinaryID)
+	require.NoError(t, err)
+	require.EqualValues(t, Val{Tid: VBinaryID, Value: []byte{0x81, 0x7f}}, out)
+
+	// Vectors sent as float32 vectors are converted by the schema type.
+	out, err = Convert(Val{Tid: VFloatID, Value: FloatArrayAsBytes([]float32{3, -4})}, VInt8ID)
+	require.NoError(t, err)
+	require.EqualValues(t, Val{Tid: VInt8ID, Value: []int8{3, -4}}, out)
+	out, err = Convert(Val{Tid: VFloatID, Value: FloatArrayAsBytes([]float32{0, 1, 1, 0, 0, 0, 0, 0})},
+		VBinaryID)
+	require.NoError(t, err)
+	require.EqualValues(t, Val{Tid: VBinaryID, Value: []byte{0x60}}, out)
+
+	for _, bad := range []string{"[1.5]", "[128]", "[-129]", "[a]"} {
+		_, err := Convert(Val{Tid: StringID, Value: []byte(bad)}, VInt8ID)
+		require.Error(t, err, bad)
+	}
+	for _, bad := range []string{"[1, 0]", "[2, 0, 0, 0, 0, 0, 0, 0]"} {
+		_, err := Convert(Val{Tid: StringID, Value: []byte(bad)}, VBinaryID)
+		require.Error(t, err, bad)
+	}
+
+	// Both types go back and forth through their binary encoding.
+	var b Val
+	b.Tid = BinaryID
+	require.NoError(t, Marshal(Val{Tid: VInt8ID, Value: []int8{-1, 2}}, &b))
+	require.Equal(t, []byte{0xff, 0x02}, b.Value)
+	out, err = Convert(Val{Tid: VInt8ID, Value: b.Value.([]byte)}, StringID)
+	require.NoError(t, err)
+	require.Equal(t, "[-1,2]", out.Value)
+	out, err = Convert(Val{Tid: VBinaryID, Value: []byte{0x81}}, StringID)
+	require.NoError(t, err)
+	require.Equal(t, "[1,0,0,0,0,0,0,1]", out.Value)
+}
+
+func TestParseVFloatList(t *testing.T) {
+	vfs, err := ParseVFloatList("[[0.1, 0.2], [0.3 0.4] [1]]")
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{0.1, 0.2}, {0.3, 0.4}, {1}}, vfs)
+
+	for _, bad := range []string{"[0.1, 0.2]", "[]", "[[0.1, 0.2]", "[[0.1], x]", "[[a]]"} {
+		_, err := ParseVFloatList(bad)
+		require.Error(t, err, bad)
+	}
+}
@@ -70,6 +70,10 @@ const (
 	//       why it does not belong here.
 	// VFloatID represents a vector of IEEE754 64-bit floats.
 	VFloatID = TypeID(pb.Posting_VFLOAT)
+	// VInt8ID represents a vector of 8-bit integers.
+	VInt8ID = TypeID(pb.Posting_VINT8)
+	// VBinaryID represents a vector of bits, packed 8 dimensions per byte.
+	VBinaryID = TypeID(pb.Posting_VBINARY)
 	// UndefinedID represents the undefined type.
 	UndefinedID = TypeID(100)
 	// BigFloatID represents the arbitrary precision type.
@@ -89,6 +93,8 @@ var typeNameMap = map[string]TypeID{
 	"password":      PasswordID,
 	"bigfloat":      BigFloatID,
 	"float32vector": VFloatID,
+	"int8vector":    VInt8ID,
+	"binaryvector":  VBinaryID,
 }
 
 // TypeID represents the type of the data.
@@ -126,6 +132,10 @@ func (t TypeID) Name() string {
 		return "bigfloat"
 	case VFloatID:
 		return "float32vector"
+	case VInt8ID:
+		return "int8vector"
+	case VBinaryID:
+		return "binaryvector"
 	}
 	return ""
 }
@@ -162,6 +172,11 @@ func (t TypeID) IsScalar() bool {
 	return t != UidID
 }
 
+// IsVector returns whether the type is a vector type.
+func (t TypeID) IsVector() bool {
+	return t == VFloatID || t == VInt8ID || t == VBinaryID
+}
+
 // IsNumber returns whether the type is a number type.
 func (t TypeID) IsNumber() bool {
 	return t == IntID || t == FloatID
@@ -217,6 +232,12 @@ func ValueForType(id TypeID) Val {
 	case VFloatID:
 		var v []float32
 		return Val{VFloatID, &v}
+	case VInt8ID:
+		var v []int8
+		return Val{VInt8ID, &v}
+	case VBinaryID:
+		var v []byte
+		return Val{VBinaryID, &v}
 	default:
 		return Val{}
 	}
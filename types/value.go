@@ -71,6 +71,62 @@ func FloatArrayAsString(v []float32) string {
 	return sb.String()
 }
 
+// Int8ArrayAsBytes(v) encodes v using one byte per entry.
+func Int8ArrayAsBytes(v []int8) []byte {
+	retVal := make([]byte, len(v))
+	for i := range v {
+		retVal[i] = byte(v[i])
+	}
+	return retVal
+}
+
+// BytesAsInt8Array(encoded) is the inverse of Int8ArrayAsBytes.
+func BytesAsInt8Array(encoded []byte) []int8 {
+	retVal := make([]int8, len(encoded))
+	for i := range encoded {
+		retVal[i] = int8(encoded[i])
+	}
+	return retVal
+}
+
+func Int8ArrayAsString(v []int8) string {
+	var sb strings.Builder
+
+	sb.WriteRune('[')
+	for i := range v {
+		sb.WriteString(strconv.Itoa(int(v[i])))
+		if i != len(v)-1 {
+			sb.WriteRune(',')
+		}
+	}
+	sb.WriteRune(']')
+	return sb.String()
+}
+
+// BinaryVectorAsBits(v) unpacks the binary vector v into one 0 or 1 entry
+// per dimension, the most significant bit of each byte coming first.
+func BinaryVectorAsBits(v []byte) []uint8 {
+	retVal := make([]uint8, 8*len(v))
+	for i := range retVal {
+		retVal[i] = (v[i/8] >> (7 - i%8)) & 1
+	}
+	return retVal
+}
+
+func BinaryVectorAsString(v []byte) string {
+	var sb strings.Builder
+
+	sb.WriteRune('[')
+	for i, bit := range BinaryVectorAsBits(v) {
+		sb.WriteRune(rune('0' + bit))
+		if i != 8*len(v)-1 {
+			sb.WriteRune(',')
+		}
+	}
+	sb.WriteRune(']')
+	return sb.String()
+}
+
 // TypeForValue tries to determine the most likely type based on a value. We only want to use this
 // function when there's no schema type and no suggested storage type.
 // Returns the guessed type or DefaultID if it couldn't be determined.
@@ -38,6 +38,8 @@ import (
 	"github.com/dgraph-io/dgraph/v24/posting"
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
 	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v24/tok/ivf"
+	"github.com/dgraph-io/dgraph/v24/types"
 	"github.com/dgraph-io/dgraph/v24/x"
 	"github.com/dgraph-io/ristretto/z"
 )
@@ -208,9 +210,11 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 		}
 
 		for _, pred := range schema {
-			if pred.Type == "float32vector" && len(pred.IndexSpecs) != 0 {
+			typ, _ := types.TypeForName(pred.Type)
+			if typ.IsVector() && len(pred.IndexSpecs) != 0 {
 				vecPredMap[gid] = append(predMap[gid], pred.Predicate+hnsw.VecEntry, pred.Predicate+hnsw.VecKeyword,
-					pred.Predicate+hnsw.VecDead)
+					pred.Predicate+hnsw.VecDead, pred.Predicate+hnsw.VecMulti, pred.Predicate+hnsw.VecOwner,
+					pred.Predicate+ivf.VecCodebook, pred.Predicate+ivf.VecList, pred.Predicate+ivf.VecAssignment)
 			}
 		}
 	}
@@ -385,6 +385,8 @@ type MutationEvent struct {
 	Attr      string      `json:"attr"`
 	Value     interface{} `json:"value"`
 	ValueType string      `json:"value_type"`
+	// Expired is set when the value was deleted because its @ttl expired.
+	Expired bool `json:"expired,omitempty"`
 }
 
 type DropEvent struct {
@@ -491,6 +493,7 @@ func toCDCEvent(index uint64, mutation *pb.Mutations) []CDCEvent {
 				Attr:      attr,
 				Value:     val,
 				ValueType: posting.TypeID(edge).Name(),
+				Expired:   mutation.Expired,
 			},
 		})
 	}
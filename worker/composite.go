@@ -0,0 +1,188 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/schema"
+	"github.com/dgraph-io/dgraph/v24/tok"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+// getCompositeTokens returns the tokens of the composite index kept under attr that match
+// the arguments of the composite function. The function isn't part of DQL, the query
+// planner uses it in place of the comparisons of an and filter that match the composite
+// index. Its arguments are the name of the comparison applied to the last predicate
+// matched, followed by the values of the predicates of the index, in order: the values of
+// all the predicates but the last one matched are compared with eq. For example,
+// eq(country, "DE") and ge(age, 30) match the index @composite(age) of country as:
+//
+//	composite(country, "ge", "DE", "30")
+func getCompositeTokens(ctx context.Context, q *pb.Query) ([]string, error) {
+	attr := q.Attr
+	preds := append([]string{attr}, schema.State().CompositeWith(ctx, attr)...)
+	if len(preds) == 1 {
+		return nil, errors.Errorf("Predicate %s has no composite index", x.ParseAttr(attr))
+	}
+	args := q.SrcFunc.Args
+	if len(args) < 2 {
+		return nil, errors.Errorf("composite expects at least 2 arguments, got %d", len(args))
+	}
+	fname, args := args[0], args[1:]
+	numValues := len(args)
+	if fname == between {
+		numValues--
+	}
+	if numValues < 1 || numValues > len(preds) {
+		return nil, errors.Errorf("composite got %d values for an index over %d predicates",
+			numValues, len(preds))
+	}
+
+	encode := func(pred, arg string) (string, error) {
+		val, err := convertValue(pred, arg)
+		if err != nil {
+			return "", errors.Wrapf(err, "while converting %q for %s", arg, x.ParseAttr(pred))
+		}
+		return tok.EncodeCompositeValue(val)
+	}
+	encoded := make([]string, len(args))
+	for i, arg := range args {
+		// The upper bound of between is a value of the last predicate matched.
+		pred := preds[numValues-1]
+		if i < numValues {
+			pred = preds[i]
+		}
+		var err error
+		if encoded[i], err = encode(pred, arg); err != nil {
+			return nil, err
+		}
+	}
+	prefix := tok.CompositeToken(encoded[:numValues-1]...)
+	last := []byte(encoded[numValues-1])
+	if fname == eq && numValues == len(preds) {
+		return []string{prefix + string(last)}, nil
+	}
+
+	// matches tells whether the encoding of the last value matched, and what follows it in the
+	// token, passes the comparison. As the encodings of the values aren't prefixes of each
+	// other, a token has the value v if it starts with the encoding of v.
+	var matches func(rest []byte) (ok, done bool)
+	switch fname {
+	case eq:
+		matches = func(rest []byte) (bool, bool) {
+			ok := bytes.HasPrefix(rest, last)
+			return ok, !ok
+		}
+	case "ge":
+		matches = func(rest []byte) (bool, bool) { return true, false }
+	case "gt":
+		matches = func(rest []byte) (bool, bool) { return !bytes.HasPrefix(rest, last), false }
+	case "le":
+		matches = func(rest []byte) (bool, bool) {
+			ok := bytes.Compare(rest, last) < 0 || bytes.HasPrefix(rest, last)
+			return ok, !ok
+		}
+	case "lt":
+		matches = func(rest []byte) (bool, bool) {
+			ok := bytes.Compare(rest, last) < 0 && !bytes.HasPrefix(rest, last)
+			return ok, !ok
+		}
+	case between:
+		upper := []byte(encoded[numValues])
+		matches = func(rest []byte) (bool, bool) {
+			ok := bytes.Compare(rest, upper) < 0 || bytes.HasPrefix(rest, upper)
+			return ok, !ok
+		}
+	default:
+		return nil, errors.Errorf("composite doesn't support the comparison %s", fname)
+	}
+	seek := prefix
+	if fname != "le" && fname != "lt" {
+		seek += string(last)
+	}
+
+	// If some new index key was written as part of same transaction it won't be on disk
+	// until the txn is committed. This is OK, same as for getInequalityTokens.
+	txn := pstore.NewTransactionAt(q.ReadTs, false)
+	defer txn.Discard()
+
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Prefix = x.IndexKey(attr, prefix)
+	itr := txn.NewIterator(itOpt)
+	defer itr.Close()
+
+	var out []string
+	for itr.Seek(x.IndexKey(attr, seek)); itr.Valid(); itr.Next() {
+		k, err := x.Parse(itr.Item().Key())
+		if err != nil {
+			return nil, err
+		}
+		ok, done := matches([]byte(strings.TrimPrefix(k.Term, prefix)))
+		if done {
+			break
+		}
+		if ok {
+			out = append(out, k.Term)
+		}
+	}
+	return out, nil
+}
+
+// withCompositeRebuilds returns updates followed by the current schema of the predicates
+// whose composite index must be rebuilt because updates change the type of another predicate
+// of the index, which changes the encoding of its values. The returned map holds all the
+// predicates whose composite index must be rebuilt for that reason.
+func withCompositeRebuilds(ctx context.Context, updates []*pb.SchemaUpdate) (
+	[]*pb.SchemaUpdate, map[string]bool) {
+	updated := make(map[string]bool, len(updates))
+	for _, su := range updates {
+		updated[su.Predicate] = true
+	}
+	changed := make(map[string]bool)
+	var added []string
+	for _, su := range updates {
+		if old, ok := schema.State().Get(ctx, su.Predicate); !ok || old.ValueType == su.ValueType {
+			continue
+		}
+		for _, attr := range schema.State().CompositeIndexesOf(su.Predicate) {
+			if attr == su.Predicate || changed[attr] {
+				continue
+			}
+			changed[attr] = true
+			if !updated[attr] {
+				added = append(added, attr)
+			}
+		}
+	}
+	sort.Strings(added)
+	for _, attr := range added {
+		su, ok := schema.State().Get(ctx, attr)
+		if ok && len(su.CompositeWith) > 0 {
+			updates = append(updates, &su)
+		}
+	}
+	return updates, changed
+}
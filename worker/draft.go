@@ -285,7 +285,7 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 		// to maintain quorum health.
 		applyCh:    make(chan []raftpb.Entry, 1000),
 		elog:       trace.NewEventLog("Dgraph", "ApplyCh"),
-		closer:     z.NewCloser(4), // Matches CLOSER:1
+		closer:     z.NewCloser(6), // Matches CLOSER:1
 		ops:        make(map[op]operation),
 		cdcTracker: newCDC(),
 	}
@@ -403,6 +403,18 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 		return errors.New("StartTs must be provided")
 	}
 
+	if attr := proposal.Mutations.TrainVectorIndex; attr != "" {
+		// The training step is run by every replica, and only depends on the
+		// data read at StartTs, so all of them write the same index.
+		txn := posting.Oracle().RegisterStartTs(proposal.Mutations.StartTs)
+		if txn.ShouldAbort() {
+			return x.ErrConflict
+		}
+		defer txn.Update()
+		span.Annotatef(nil, "Training vector index of %s", attr)
+		return posting.TrainVectorIndex(ctx, txn, attr)
+	}
+
 	if len(proposal.Mutations.Schema) > 0 || len(proposal.Mutations.Types) > 0 {
 		// MaxAssigned would ensure that everything that's committed up until this point
 		// would be picked up in building indexes. Any uncommitted txns would be cancelled
@@ -532,21 +544,33 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 		}
 		return nil
 	}
-	numGo, width := x.DivideAndRule(len(m.Edges))
-	span.Annotatef(nil, "To apply: %d edges. NumGo: %d. Width: %d", len(m.Edges), numGo, width)
+
+	// The composite indexes of a node are updated by reading the values it has for all their
+	// predicates, so the edges of those predicates are applied by a single goroutine.
+	edges := m.Edges
+	if compositeEdges, others := splitCompositeEdges(m.Edges); len(compositeEdges) > 0 {
+		span.Annotatef(nil, "To apply: %d edges of composite indexes", len(compositeEdges))
+		if err := process(compositeEdges); err != nil {
+			return err
+		}
+		edges = others
+	}
+
+	numGo, width := x.DivideAndRule(len(edges))
+	span.Annotatef(nil, "To apply: %d edges. NumGo: %d. Width: %d", len(edges), numGo, width)
 
 	if numGo == 1 {
-		return process(m.Edges)
+		return process(edges)
 	}
 	errCh := make(chan error, numGo)
 	for i := 0; i < numGo; i++ {
 		start := i * width
 		end := start + width
-		if end > len(m.Edges) {
-			end = len(m.Edges)
+		if end > len(edges) {
+			end = len(edges)
 		}
 		go func(start, end int) {
-			errCh <- process(m.Edges[start:end])
+			errCh <- process(edges[start:end])
 		}(start, end)
 	}
 	// Earlier we were returning after even if one thread had an error. We should wait for
@@ -564,6 +588,29 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	return errs
 }
 
+// splitCompositeEdges splits edges into the edges of the predicates of composite indexes and
+// the others, keeping their order.
+func splitCompositeEdges(edges []*pb.DirectedEdge) ([]*pb.DirectedEdge, []*pb.DirectedEdge) {
+	var compositeEdges []*pb.DirectedEdge
+	others := edges[:0:0]
+	for i, edge := range edges {
+		if len(schema.State().CompositeIndexesOf(edge.Attr)) == 0 {
+			if len(compositeEdges) > 0 {
+				others = append(others, edge)
+			}
+			continue
+		}
+		if len(compositeEdges) == 0 {
+			others = append(others, edges[:i]...)
+		}
+		compositeEdges = append(compositeEdges, edge)
+	}
+	if len(compositeEdges) == 0 {
+		return nil, edges
+	}
+	return compositeEdges, others
+}
+
 func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 	start := time.Now()
 	defer func() {
@@ -659,8 +706,9 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 			}
 			glog.Warningf("Error while calling CreateSnapshot: %v. Retrying...", err)
 		}
-		// We can now discard all invalid versions of keys below this ts.
-		pstore.SetDiscardTs(snap.ReadTs)
+		// We can now discard all invalid versions of keys below this ts, unless they are
+		// still needed within the history retention window.
+		pstore.SetDiscardTs(discardTs(snap.ReadTs))
 		return nil
 	case proposal.Restore != nil:
 		// Enable draining mode for the duration of the restore processing.
@@ -1881,6 +1929,8 @@ func (n *node) InitAndStartNode() {
 		}
 	}
 	go n.processTabletSizes()
+	go n.processTTL()
+	go n.processVectorTraining()
 	go n.processApplyCh()
 	go n.BatchAndSendMessages()
 	go n.monitorRaftMetrics()
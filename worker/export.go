@@ -41,6 +41,7 @@ import (
 	"github.com/dgraph-io/dgraph/v24/ee/enc"
 	"github.com/dgraph-io/dgraph/v24/posting"
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/schema"
 	"github.com/dgraph-io/dgraph/v24/types"
 	"github.com/dgraph-io/dgraph/v24/types/facets"
 	"github.com/dgraph-io/dgraph/v24/x"
@@ -91,6 +92,8 @@ var rdfTypeMap = map[types.TypeID]string{
 	types.PasswordID: "xs:password",
 	types.BigFloatID: "xs:decimal",
 	types.VFloatID:   "xs:[]float32",
+	types.VInt8ID:    "xs:[]int8",
+	types.VBinaryID:  "xs:[]binary",
 }
 
 // UIDs like 0x1 look weird but 64-bit ones like 0x0000000000000001 are too long.
@@ -333,6 +336,36 @@ func toSchema(attr string, update *pb.SchemaUpdate) *bpb.KV {
 	if update.GetUnique() {
 		x.Check2(buf.WriteString(" @unique"))
 	}
+	if len(update.GetUniqueWith()) > 0 {
+		with := make([]string, 0, len(update.GetUniqueWith()))
+		for _, pred := range update.GetUniqueWith() {
+			with = append(with, x.ParseAttr(pred))
+		}
+		x.Check2(buf.WriteString(" @unique(" + strings.Join(with, ",") + ")"))
+	}
+	if len(update.GetCompositeWith()) > 0 {
+		with := make([]string, 0, len(update.GetCompositeWith()))
+		for _, pred := range update.GetCompositeWith() {
+			with = append(with, x.ParseAttr(pred))
+		}
+		x.Check2(buf.WriteString(" @composite(" + strings.Join(with, ",") + ")"))
+	}
+	if update.GetMin() != "" {
+		x.Check2(buf.WriteString(" @min(\"" + update.GetMin() + "\")"))
+	}
+	if update.GetMax() != "" {
+		x.Check2(buf.WriteString(" @max(\"" + update.GetMax() + "\")"))
+	}
+	if update.GetRegex() != "" {
+		x.Check2(buf.WriteString(" @regex(\"" + update.GetRegex() + "\")"))
+	}
+	if len(update.GetEnumValues()) > 0 {
+		x.Check2(buf.WriteString(" @enum(\"" + strings.Join(update.GetEnumValues(), "\",\"") +
+			"\")"))
+	}
+	if update.GetTtl() > 0 {
+		x.Check2(buf.WriteString(" @ttl(\"" + schema.FormatTTL(update.GetTtl()) + "\")"))
+	}
 	x.Check2(buf.WriteString(" . \n"))
 	//TODO(Naman): We don't need the version anymore.
 	return &bpb.KV{
@@ -372,7 +405,11 @@ func formatVectorSchema(schema *pb.SchemaUpdate) string {
 func toType(attr string, update pb.TypeUpdate) *bpb.KV {
 	var buf bytes.Buffer
 	ns, attr := x.ParseNamespaceAttr(attr)
-	x.Check2(buf.WriteString(fmt.Sprintf("[%#x] type <%s> {\n", ns, attr)))
+	x.Check2(buf.WriteString(fmt.Sprintf("[%#x] type <%s>", ns, attr)))
+	if update.Strict {
+		x.Check2(buf.WriteString(" @strict"))
+	}
+	x.Check2(buf.WriteString(" {\n"))
 	for _, field := range update.Fields {
 		x.Check2(buf.WriteString(fieldToString(field)))
 	}
@@ -399,6 +436,9 @@ func fieldToString(update *pb.SchemaUpdate) string {
 	} else {
 		x.Check2(builder.WriteString(predicate))
 	}
+	if update.GetNonNullable() {
+		x.Check2(builder.WriteString("!"))
+	}
 	x.Check2(builder.WriteString("\n"))
 	return builder.String()
 }
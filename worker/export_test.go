@@ -558,9 +558,91 @@ func TestToSchema(t *testing.T) {
 			},
 			expected: "[0x0] <data.base>:string @lang . \n",
 		},
+		{
+			skv: &skv{
+				attr: x.GalaxyAttr("email"),
+				schema: pb.SchemaUpdate{
+					Predicate:  x.GalaxyAttr(""),
+					ValueType:  pb.Posting_STRING,
+					Directive:  pb.SchemaUpdate_INDEX,
+					Tokenizer:  []string{"exact"},
+					Upsert:     true,
+					UniqueWith: []string{x.GalaxyAttr("tenant"), x.GalaxyAttr("org")},
+				},
+			},
+			expected: "[0x0] <email>:string @index(exact) @upsert @unique(tenant,org) . \n",
+		},
+		{
+			skv: &skv{
+				attr: x.GalaxyAttr("country"),
+				schema: pb.SchemaUpdate{
+					Predicate:     x.GalaxyAttr(""),
+					ValueType:     pb.Posting_STRING,
+					CompositeWith: []string{x.GalaxyAttr("age"), x.GalaxyAttr("city")},
+				},
+			},
+			expected: "[0x0] <country>:string @composite(age,city) . \n",
+		},
+		{
+			skv: &skv{
+				attr: x.GalaxyAttr("age"),
+				schema: pb.SchemaUpdate{
+					Predicate: x.GalaxyAttr(""),
+					ValueType: pb.Posting_INT,
+					Min:       "0",
+					Max:       "150",
+				},
+			},
+			expected: "[0x0] <age>:int @min(\"0\") @max(\"150\") . \n",
+		},
+		{
+			skv: &skv{
+				attr: x.GalaxyAttr("status"),
+				schema: pb.SchemaUpdate{
+					Predicate:  x.GalaxyAttr(""),
+					ValueType:  pb.Posting_STRING,
+					Regex:      "^[a-z]+$",
+					EnumValues: []string{"active", "inactive"},
+				},
+			},
+			expected: "[0x0] <status>:string @regex(\"^[a-z]+$\") @enum(\"active\",\"inactive\") . \n",
+		},
+		{
+			skv: &skv{
+				attr: x.GalaxyAttr("session"),
+				schema: pb.SchemaUpdate{
+					Predicate: x.GalaxyAttr(""),
+					ValueType: pb.Posting_STRING,
+					Directive: pb.SchemaUpdate_INDEX,
+					Tokenizer: []string{"exact"},
+					Ttl:       90 * 60,
+				},
+			},
+			expected: "[0x0] <session>:string @index(exact) @ttl(\"1h30m0s\") . \n",
+		},
 	}
 	for _, testCase := range testCases {
 		kv := toSchema(testCase.skv.attr, &testCase.skv.schema)
 		require.Equal(t, testCase.expected, string(kv.Value))
 	}
 }
+
+func TestToTypeRequiredFields(t *testing.T) {
+	kv := toType(x.GalaxyAttr("Person"), pb.TypeUpdate{
+		TypeName: x.GalaxyAttr("Person"),
+		Fields: []*pb.SchemaUpdate{
+			{Predicate: x.GalaxyAttr("name"), NonNullable: true},
+			{Predicate: x.GalaxyAttr("~friend")},
+		},
+	})
+	require.Equal(t, "[0x0] type <Person> {\n\tname!\n\t<~friend>\n}\n", string(kv.Value))
+}
+
+func TestToTypeStrict(t *testing.T) {
+	kv := toType(x.NamespaceAttr(0x2, "Person"), pb.TypeUpdate{
+		TypeName: x.NamespaceAttr(0x2, "Person"),
+		Fields:   []*pb.SchemaUpdate{{Predicate: x.NamespaceAttr(0x2, "name")}},
+		Strict:   true,
+	})
+	require.Equal(t, "[0x2] type <Person> @strict {\n\tname\n}\n", string(kv.Value))
+}
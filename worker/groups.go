@@ -59,7 +59,7 @@ type groupi struct {
 var gr = &groupi{
 	blockDeletes: new(sync.Mutex),
 	tablets:      make(map[string]*pb.Tablet),
-	closer:       z.NewCloser(3), // Match CLOSER:1 in this file.
+	closer:       z.NewCloser(5), // Match CLOSER:1 in this file.
 }
 
 func groups() *groupi {
@@ -160,6 +160,8 @@ func StartRaftNodes(walStore *raftwal.DiskStorage, bindall bool) {
 	go gr.sendMembershipUpdates()
 	go gr.receiveMembershipUpdates()
 	go gr.processOracleDeltaStream()
+	go gr.trackHistory()
+	go gr.trackTTL()
 
 	gr.informZeroAboutTablets()
 	glog.Infof("Informed Zero about tablets I have: OK")
@@ -561,6 +563,21 @@ func (g *groupi) Tablet(key string) (*pb.Tablet, error) {
 	return g.sendTablet(tablet)
 }
 
+// namespaceTablets returns the predicates of the namespace ns served by any group, as known
+// locally.
+func (g *groupi) namespaceTablets(ns uint64) []string {
+	g.RLock()
+	defer g.RUnlock()
+	var preds []string
+	for pred := range g.tablets {
+		if x.ParseNamespace(pred) == ns {
+			preds = append(preds, pred)
+		}
+	}
+	sort.Strings(preds)
+	return preds
+}
+
 func (g *groupi) ForceTablet(key string) (*pb.Tablet, error) {
 	return g.sendTablet(&pb.Tablet{GroupId: g.groupId(), Predicate: key, Force: true})
 }
@@ -0,0 +1,125 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+// historyInterval is how often the start of the history retention window is updated.
+const historyInterval = time.Minute
+
+// historyTs is the oldest timestamp that can be read, the start of the history retention
+// window. It's zero until it's known, or if there is no retention window.
+var historyTs atomic.Uint64
+
+// HistoryTs returns the oldest timestamp that can be read as of, or zero if it isn't known
+// yet. It only moves forward.
+func HistoryTs() uint64 {
+	return historyTs.Load()
+}
+
+// setHistoryTs moves the start of the history retention window forward to ts.
+func setHistoryTs(ts uint64) {
+	for {
+		cur := historyTs.Load()
+		if ts <= cur || historyTs.CompareAndSwap(cur, ts) {
+			return
+		}
+	}
+}
+
+// discardTs returns the timestamp below which the versions of the keys not needed to read
+// at readTs can be discarded. With a history retention window, the versions needed to read
+// as of its start are kept too.
+func discardTs(readTs uint64) uint64 {
+	if x.Config.HistoryRetention == 0 {
+		return readTs
+	}
+	return x.Min(readTs, HistoryTs())
+}
+
+// TimestampAt asks the Zero leader for the max timestamp assigned at t.
+func TimestampAt(ctx context.Context, t time.Time) (uint64, error) {
+	res, err := timestampAt(ctx, t)
+	if err != nil {
+		return 0, err
+	}
+	if res.UnixNano > t.UnixNano() {
+		return 0, errors.Errorf("No timestamp known at %s, the oldest one known is at %s",
+			t.Format(time.RFC3339Nano), time.Unix(0, res.UnixNano).Format(time.RFC3339Nano))
+	}
+	return res.Ts, nil
+}
+
+// timestampAt asks the Zero leader for the max timestamp assigned at t, or at the oldest
+// time it knows about if that's after t.
+func timestampAt(ctx context.Context, t time.Time) (*pb.TimeTs, error) {
+	pl := groups().connToZeroLeader()
+	if pl == nil {
+		return nil, errors.Errorf("Unable to reach the Zero leader")
+	}
+	zc := pb.NewZeroClient(pl.Get())
+	res, err := zc.TimestampAt(ctx, &pb.TimeTs{UnixNano: t.UnixNano()})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while getting the timestamp at %s", t.Format(time.RFC3339))
+	}
+	return res, nil
+}
+
+// trackHistory periodically moves the start of the history retention window to the
+// timestamp assigned a retention period ago. If Zero doesn't know the timestamps that far
+// back, like right after the retention was enabled or the cluster was reset, the window
+// starts at the oldest one it knows, which is now after a reset, and stays there.
+func (g *groupi) trackHistory() {
+	defer func() {
+		glog.Infoln("Closing trackHistory")
+		g.closer.Done() // CLOSER:1
+	}()
+	if x.Config.HistoryRetention == 0 {
+		return
+	}
+
+	ticker := time.NewTicker(historyInterval)
+	defer ticker.Stop()
+	for {
+		ctx, cancel := context.WithTimeout(g.Ctx(), 10*time.Second)
+		since := time.Now().Add(-x.Config.HistoryRetention)
+		res, err := timestampAt(ctx, since)
+		cancel()
+		switch {
+		case err != nil:
+			glog.V(2).Infof("Unable to update the history retention window: %v", err)
+		case res.UnixNano <= since.UnixNano() || HistoryTs() == 0:
+			setHistoryTs(res.Ts)
+		}
+
+		select {
+		case <-g.closer.HasBeenClosed():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,48 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+func TestDiscardTs(t *testing.T) {
+	defer func(retention time.Duration) {
+		x.Config.HistoryRetention = retention
+		historyTs.Store(0)
+	}(x.Config.HistoryRetention)
+
+	x.Config.HistoryRetention = 0
+	require.Equal(t, uint64(100), discardTs(100))
+
+	// Nothing is discarded until the start of the retention window is known.
+	x.Config.HistoryRetention = time.Hour
+	require.Equal(t, uint64(0), discardTs(100))
+
+	setHistoryTs(50)
+	require.Equal(t, uint64(50), discardTs(100))
+	require.Equal(t, uint64(40), discardTs(40))
+
+	// The window never moves back.
+	setHistoryTs(30)
+	require.Equal(t, uint64(50), HistoryTs())
+}
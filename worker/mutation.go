@@ -19,11 +19,17 @@ package worker
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
 
+	"github.com/dgryski/go-farm"
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	ostats "go.opencensus.io/stats"
@@ -38,6 +44,8 @@ import (
 	"github.com/dgraph-io/dgraph/v24/posting"
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
 	"github.com/dgraph-io/dgraph/v24/schema"
+	"github.com/dgraph-io/dgraph/v24/tok"
+	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
 	"github.com/dgraph-io/dgraph/v24/types"
 	"github.com/dgraph-io/dgraph/v24/x"
 	"github.com/dgraph-io/ristretto/z"
@@ -224,6 +232,7 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		throttle.Done(nil)
 	}
 
+	updates, compositeChanged := withCompositeRebuilds(ctx, updates)
 	var closer *z.Closer
 	for _, su := range updates {
 		if tablet, err := groups().Tablet(su.Predicate); err != nil {
@@ -235,6 +244,14 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 		if err := checkSchema(su); err != nil {
 			return err
 		}
+		for _, pred := range su.CompositeWith {
+			if tablet, err := groups().Tablet(pred); err != nil {
+				return err
+			} else if tablet.GetGroupId() != groups().groupId() {
+				return errors.Errorf("Predicate [%v] of the composite index of [%v] isn't"+
+					" served by the same group", x.ParseAttr(pred), x.ParseAttr(su.Predicate))
+			}
+		}
 
 		old, ok := schema.State().Get(ctx, su.Predicate)
 		rebuild := posting.IndexRebuild{
@@ -242,6 +259,8 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 			StartTs:       startTs,
 			OldSchema:     &old,
 			CurrentSchema: su,
+
+			CompositeChanged: compositeChanged[su.Predicate],
 		}
 		shouldRebuild := ok && rebuild.NeedIndexRebuild()
 
@@ -399,6 +418,11 @@ func checkSchema(s *pb.SchemaUpdate) error {
 			x.ParseAttr(s.Predicate))
 	}
 
+	if s.Ttl > 0 && x.IsReservedPredicate(s.Predicate) {
+		return errors.Errorf("@ttl not supported on reserved predicate [%v]",
+			x.ParseAttr(s.Predicate))
+	}
+
 	if s.Directive == pb.SchemaUpdate_INDEX && !schema.HasTokenizerOrVectorIndexSpec(s) {
 		return errors.Errorf("Tokenizer must be specified while indexing a predicate: %+v", s)
 	}
@@ -424,7 +448,11 @@ func checkSchema(s *pb.SchemaUpdate) error {
 			x.ParseAttr(s.Predicate))
 	}
 
-	if s.Unique {
+	if len(s.UniqueWith) > 0 && s.List {
+		return errors.Errorf("@unique with other predicates not supported on list predicate [%v]",
+			x.ParseAttr(s.Predicate))
+	}
+	if s.Unique || len(s.UniqueWith) > 0 {
 		ctx := context.WithValue(context.Background(), schema.IsWrite, false)
 		prevSchema, _ := schema.State().Get(ctx, s.Predicate)
 		if err := validateSchemaForUnique(prevSchema, s); err != nil {
@@ -432,6 +460,10 @@ func checkSchema(s *pb.SchemaUpdate) error {
 		}
 	}
 
+	if err := checkCompositeSchema(s); err != nil {
+		return err
+	}
+
 	t, err := schema.State().TypeOf(s.Predicate)
 	if err != nil {
 		// No schema previously defined, so no need to do checks about schema conversions.
@@ -465,6 +497,45 @@ func checkSchema(s *pb.SchemaUpdate) error {
 	return nil
 }
 
+// checkCompositeSchema checks that the predicates of the composite index of s, if any, and
+// of the composite indexes s is part of are single values of a type that can be indexed.
+func checkCompositeSchema(s *pb.SchemaUpdate) error {
+	valid := func(su *pb.SchemaUpdate) bool {
+		return !su.List && tok.IsCompositeType(types.TypeID(su.ValueType))
+	}
+	if len(s.CompositeWith) > 0 && !valid(s) {
+		return errors.Errorf("@composite not supported on predicate [%v] of type %v",
+			x.ParseAttr(s.Predicate), typeName(s))
+	}
+
+	ctx := schema.GetWriteContext(context.Background())
+	for _, pred := range s.CompositeWith {
+		if su, ok := schema.State().Get(ctx, pred); ok && !valid(&su) {
+			return errors.Errorf("predicate [%v] of type %v can't be part of the composite"+
+				" index of [%v]", x.ParseAttr(pred), typeName(&su), x.ParseAttr(s.Predicate))
+		}
+	}
+	if valid(s) {
+		return nil
+	}
+	for _, attr := range schema.State().CompositeIndexesOf(s.Predicate) {
+		if attr != s.Predicate && len(schema.State().CompositeWith(ctx, attr)) > 0 {
+			return errors.Errorf("predicate [%v] of type %v can't be part of the composite"+
+				" index of [%v]", x.ParseAttr(s.Predicate), typeName(s), x.ParseAttr(attr))
+		}
+	}
+	return nil
+}
+
+// typeName returns the type of s as written in the schema.
+func typeName(s *pb.SchemaUpdate) string {
+	name := types.TypeID(s.ValueType).Name()
+	if s.List {
+		return "[" + name + "]"
+	}
+	return name
+}
+
 func validateSchemaForUnique(prevSchema pb.SchemaUpdate, currentSchema *pb.SchemaUpdate) error {
 	validTokenizer := func(tokenizers []string) bool {
 		for _, value := range tokenizers {
@@ -480,7 +551,8 @@ func validateSchemaForUnique(prevSchema pb.SchemaUpdate, currentSchema *pb.Schem
 				x.ParseAttr(currentSchema.Predicate))
 		}
 
-		if prevSchema.Unique && !validTokenizer(currentSchema.Tokenizer) {
+		if (prevSchema.Unique || len(prevSchema.UniqueWith) > 0) &&
+			!validTokenizer(currentSchema.Tokenizer) {
 			return errors.Errorf("could not drop index %v from [%v] predicate when @unique directive specified",
 				prevSchema.Tokenizer, x.ParseAttr(currentSchema.Predicate))
 		}
@@ -549,9 +621,25 @@ func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 				" Did you forget to add quotes before []?. Edge: %v", x.ParseAttr(edge.Attr), edge)
 		}
 
-	// The suggested storage type matches the schema, OK! (Nothing to do ...)
+	case schemaType == types.VInt8ID || schemaType == types.VBinaryID:
+		if !(storageType == schemaType || storageType == types.VFloatID ||
+			storageType == types.StringID || storageType == types.DefaultID) {
+			return errors.Errorf("Input for predicate %q of type %s is not vector."+
+				" Did you forget to add quotes before []?. Edge: %v", x.ParseAttr(edge.Attr),
+				schemaType.Name(), edge)
+		}
+
+	// The suggested storage type matches the schema, OK! (Nothing to do but checking the
+	// constraints on the value ...)
 	case storageType == schemaType && schemaType != types.DefaultID:
-		return nil
+		if !hasValueConstraints(edge, su) {
+			return nil
+		}
+		val, err := types.Convert(types.Val{Tid: storageType, Value: edge.Value}, schemaType)
+		if err != nil {
+			return err
+		}
+		return checkValueConstraints(edge.Attr, su, val)
 
 	// We accept the storage type iff we don't have a schema type and a storage type is specified.
 	case schemaType == types.DefaultID:
@@ -569,6 +657,11 @@ func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 	if dst, err = types.Convert(src, schemaType); err != nil {
 		return err
 	}
+	if hasValueConstraints(edge, su) {
+		if err := checkValueConstraints(edge.Attr, su, dst); err != nil {
+			return err
+		}
+	}
 
 	// convert to schema type
 	b := types.ValueForType(types.BinaryID)
@@ -600,6 +693,79 @@ func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 	return nil
 }
 
+// hasValueConstraints returns true if the value of edge must be checked against constraints
+// on the values of its predicate. Deleted values aren't checked.
+func hasValueConstraints(edge *pb.DirectedEdge, su *pb.SchemaUpdate) bool {
+	return edge.Op != pb.DirectedEdge_DEL && (su.GetMin() != "" || su.GetMax() != "" ||
+		su.GetRegex() != "" || len(su.GetEnumValues()) > 0)
+}
+
+// regexCache holds the compiled patterns of @regex, by pattern.
+var regexCache sync.Map
+
+// checkValueConstraints returns an error if val, a value of the predicate attr converted to
+// its schema type, doesn't satisfy the @min, @max, @regex and @enum constraints of su.
+func checkValueConstraints(attr string, su *pb.SchemaUpdate, val types.Val) error {
+	// The values in the schema were checked to convert to the type when it was parsed.
+	bound := func(s string) (types.Val, error) {
+		return types.Convert(types.Val{Tid: types.StringID, Value: []byte(s)}, val.Tid)
+	}
+	if su.GetMin() != "" {
+		minVal, err := bound(su.GetMin())
+		if err != nil {
+			return err
+		}
+		if types.CompareVals("lt", val, minVal) {
+			return errors.Errorf("Value %v for predicate [%v] is less than its @min(%v)",
+				val.Value, x.ParseAttr(attr), su.GetMin())
+		}
+	}
+	if su.GetMax() != "" {
+		maxVal, err := bound(su.GetMax())
+		if err != nil {
+			return err
+		}
+		if types.CompareVals("gt", val, maxVal) {
+			return errors.Errorf("Value %v for predicate [%v] is greater than its @max(%v)",
+				val.Value, x.ParseAttr(attr), su.GetMax())
+		}
+	}
+	if pattern := su.GetRegex(); pattern != "" {
+		re, ok := regexCache.Load(pattern)
+		if !ok {
+			compiled, err := regexp.Compile(pattern)
+			if err != nil {
+				return errors.Wrapf(err, "while compiling @regex of predicate [%v]",
+					x.ParseAttr(attr))
+			}
+			re, _ = regexCache.LoadOrStore(pattern, compiled)
+		}
+		str, ok := val.Value.(string)
+		if !ok || !re.(*regexp.Regexp).MatchString(str) {
+			return errors.Errorf("Value %q for predicate [%v] doesn't match its @regex(%q)",
+				val.Value, x.ParseAttr(attr), pattern)
+		}
+	}
+	if len(su.GetEnumValues()) > 0 {
+		found := false
+		for _, allowed := range su.GetEnumValues() {
+			allowedVal, err := bound(allowed)
+			if err != nil {
+				return err
+			}
+			if types.CompareVals("eq", val, allowedVal) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.Errorf("Value %v for predicate [%v] isn't one of its @enum(%v)",
+				val.Value, x.ParseAttr(attr), strings.Join(su.GetEnumValues(), ", "))
+		}
+	}
+	return nil
+}
+
 // AssignNsIdsOverNetwork sends a request to assign Namespace IDs to the current zero leader.
 func AssignNsIdsOverNetwork(ctx context.Context, num *pb.Num) (*pb.AssignedIds, error) {
 	pl := groups().Leader(0)
@@ -764,6 +930,17 @@ func MutateOverNetwork(ctx context.Context, m *pb.Mutations) (*api.TxnContext, e
 	if err := verifyTypes(ctx, m); err != nil {
 		return tctx, err
 	}
+	requiredKeys, err := verifyRequiredFields(ctx, m)
+	if err != nil {
+		return tctx, err
+	}
+	strictKeys, err := verifyStrictTypes(ctx, m)
+	if err != nil {
+		return tctx, err
+	}
+	if err := verifyStrictTypeUpdates(ctx, m); err != nil {
+		return tctx, err
+	}
 	mutationMap, err := populateMutationMap(m)
 	if err != nil {
 		return tctx, err
@@ -794,6 +971,8 @@ func MutateOverNetwork(ctx context.Context, m *pb.Mutations) (*api.TxnContext, e
 		}
 	}
 	close(resCh)
+	tctx.Keys = append(tctx.Keys, requiredKeys...)
+	tctx.Keys = append(tctx.Keys, strictKeys...)
 	return tctx, e
 }
 
@@ -861,6 +1040,454 @@ func verifyTypes(ctx context.Context, m *pb.Mutations) error {
 	return nil
 }
 
+// valuesOfNodes reads the values of attr for uids, as of readTs. It sorts uids. A predicate
+// that was never stored has no values.
+func valuesOfNodes(ctx context.Context, attr string, uids []uint64,
+	readTs uint64) (*pb.Result, error) {
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+		Attr:    attr,
+		UidList: &pb.List{Uids: uids},
+		ReadTs:  readTs,
+	})
+	if err == errNonExistentTablet {
+		return &pb.Result{}, nil
+	}
+	return res, err
+}
+
+// nodeTypesAfter returns the types, with their namespace, that the nodes will have after the
+// mutation m: the types they have at the start of the transaction, without the ones m
+// deletes and with the ones m sets. nodes maps every node to its namespace.
+func nodeTypesAfter(ctx context.Context, m *pb.Mutations,
+	nodes map[uint64]uint64) (map[uint64][]string, error) {
+	byNs := make(map[uint64][]uint64)
+	for uid, ns := range nodes {
+		byNs[ns] = append(byNs[ns], uid)
+	}
+	nodeTypes := make(map[uint64][]string)
+	for ns, uids := range byNs {
+		res, err := valuesOfNodes(ctx, x.NamespaceAttr(ns, "dgraph.type"), uids, m.StartTs)
+		if err != nil {
+			return nil, err
+		}
+		for i, uid := range uids {
+			if i >= len(res.ValueMatrix) {
+				break
+			}
+			for _, val := range res.ValueMatrix[i].Values {
+				nodeTypes[uid] = append(nodeTypes[uid], x.NamespaceAttr(ns, string(val.Val)))
+			}
+		}
+	}
+
+	// Deletes come before sets in the edges of a mutation.
+	for _, edge := range m.Edges {
+		ns, ok := nodes[edge.Entity]
+		if !ok || x.ParseAttr(edge.Attr) != "dgraph.type" {
+			continue
+		}
+		typeName := x.NamespaceAttr(ns, string(edge.Value))
+		switch {
+		case edge.Op == pb.DirectedEdge_SET:
+			nodeTypes[edge.Entity] = append(nodeTypes[edge.Entity], typeName)
+		case isStarAll(edge.Value):
+			delete(nodeTypes, edge.Entity)
+		default:
+			kept := nodeTypes[edge.Entity][:0]
+			for _, t := range nodeTypes[edge.Entity] {
+				if t != typeName {
+					kept = append(kept, t)
+				}
+			}
+			nodeTypes[edge.Entity] = kept
+		}
+	}
+	return nodeTypes, nil
+}
+
+// requiredChange is what a mutation changes of a node that matters to the fields required
+// by the types of the node: the predicates set for the node, and the required ones whose
+// value is removed.
+type requiredChange struct {
+	set map[string]bool
+	del map[string]bool
+}
+
+// verifyRequiredFields checks that the nodes whose types are set by the mutation m, or whose
+// value of a field required by a type is removed by m, keep a value for every field required
+// by their types, declared as name! in the type. Removing a value is deleting all the values
+// of the predicate, or deleting a value of a predicate that isn't a list. It returns a
+// conflict key for the types of every node it checks, and one for every required field of
+// a node that it checks or that m removes, so that a transaction setting the type of a node
+// conflicts with one removing a field required by that type.
+func verifyRequiredFields(ctx context.Context, m *pb.Mutations) ([]string, error) {
+	requiredBy := schema.State().TypesRequiring()
+	if len(requiredBy) == 0 {
+		return nil, nil
+	}
+
+	// Find the nodes to check first, as most mutations touch none.
+	nodes := make(map[uint64]uint64)
+	for _, edge := range m.Edges {
+		if isDeletePredicateEdge(edge) {
+			continue
+		}
+		isType := x.ParseAttr(edge.Attr) == "dgraph.type"
+		if (edge.Op == pb.DirectedEdge_SET && isType) ||
+			(edge.Op == pb.DirectedEdge_DEL && len(requiredBy[edge.Attr]) > 0 &&
+				(isStarAll(edge.Value) || !schema.State().IsList(edge.Attr))) {
+			nodes[edge.Entity] = x.ParseNamespace(edge.Attr)
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	changes := make(map[uint64]*requiredChange, len(nodes))
+	for uid := range nodes {
+		changes[uid] = &requiredChange{set: make(map[string]bool), del: make(map[string]bool)}
+	}
+	for _, edge := range m.Edges {
+		c, ok := changes[edge.Entity]
+		switch {
+		case !ok:
+		case edge.Op == pb.DirectedEdge_SET:
+			c.set[edge.Attr] = true
+		case isStarAll(edge.Value) || !schema.State().IsList(edge.Attr):
+			c.del[edge.Attr] = true
+		}
+	}
+	nodeTypes, err := nodeTypesAfter(ctx, m, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	// The nodes that must already have a value for a required field, by field.
+	missing := make(map[string]map[uint64]string)
+	keys := make(map[string]struct{})
+	for uid, c := range changes {
+		keys[typeConflictKey(uid)] = struct{}{}
+		for attr := range c.del {
+			if len(requiredBy[attr]) > 0 {
+				keys[requiredConflictKey(uid, attr)] = struct{}{}
+			}
+		}
+		for _, typeName := range nodeTypes[uid] {
+			typ, ok := schema.State().GetType(typeName)
+			if !ok {
+				continue
+			}
+			for _, field := range typ.Fields {
+				if field.NonNullable {
+					keys[requiredConflictKey(uid, field.Predicate)] = struct{}{}
+				}
+				switch {
+				case !field.NonNullable || c.set[field.Predicate]:
+				case c.del[field.Predicate]:
+					return nil, errors.Errorf("Can't remove the value of required field %s of type %s"+
+						" from node %#x", x.ParseAttr(field.Predicate), x.ParseAttr(typeName), uid)
+				default:
+					if missing[field.Predicate] == nil {
+						missing[field.Predicate] = make(map[uint64]string)
+					}
+					missing[field.Predicate][uid] = typeName
+				}
+			}
+		}
+	}
+	for attr, typeOf := range missing {
+		uids := make([]uint64, 0, len(typeOf))
+		for uid := range typeOf {
+			uids = append(uids, uid)
+		}
+		res, err := valuesOfNodes(ctx, attr, uids, m.StartTs)
+		if err != nil {
+			return nil, err
+		}
+		for i, uid := range uids {
+			if (i >= len(res.UidMatrix) || len(res.UidMatrix[i].Uids) == 0) &&
+				(i >= len(res.ValueMatrix) || len(res.ValueMatrix[i].Values) == 0) {
+				return nil, errors.Errorf("Node %#x of type %s is missing a value for required field %s",
+					uid, x.ParseAttr(typeOf[uid]), x.ParseAttr(attr))
+			}
+		}
+	}
+
+	out := make([]string, 0, len(keys))
+	for key := range keys {
+		out = append(out, key)
+	}
+	return out, nil
+}
+
+// typeConflictKey returns the conflict key of the types of the node uid, see
+// verifyRequiredFields.
+func typeConflictKey(uid uint64) string {
+	return strconv.FormatUint(farm.Fingerprint64([]byte(fmt.Sprintf("type|%#x", uid))), 36)
+}
+
+// requiredConflictKey returns the conflict key of the required field attr of the node uid,
+// see verifyRequiredFields.
+func requiredConflictKey(uid uint64, attr string) string {
+	return strconv.FormatUint(
+		farm.Fingerprint64([]byte(fmt.Sprintf("required|%#x|%s", uid, attr))), 36)
+}
+
+// strictCheckBatch is the number of nodes of a type checked at once when a type is declared
+// with @strict or loses fields, so that the nodes of large types aren't all read in memory.
+const strictCheckBatch = 10000
+
+// verifyStrictTypes checks that the predicates set by the mutation m for a node with a type
+// declared with @strict are fields of the types of the node. Nodes whose types are all
+// advisory can have any predicate. The nodes whose types m changes to include a strict type
+// must not have a value for another predicate already. It returns a conflict key for every
+// node whose predicates or types m changes in a namespace with a strict type, so that a
+// transaction setting a predicate of a node conflicts with one changing its types.
+func verifyStrictTypes(ctx context.Context, m *pb.Mutations) ([]string, error) {
+	strictTypes := schema.State().StrictTypes()
+	if len(strictTypes) == 0 {
+		return nil, nil
+	}
+	strictNs := make(map[uint64]bool)
+	for typeName := range strictTypes {
+		strictNs[x.ParseNamespace(typeName)] = true
+	}
+
+	nodes := make(map[uint64]uint64)
+	retyped := make(map[uint64]bool)
+	for _, edge := range m.Edges {
+		ns := x.ParseNamespace(edge.Attr)
+		if !strictNs[ns] || isDeletePredicateEdge(edge) {
+			continue
+		}
+		switch {
+		case x.ParseAttr(edge.Attr) == "dgraph.type":
+			nodes[edge.Entity] = ns
+			retyped[edge.Entity] = true
+		case edge.Op == pb.DirectedEdge_SET && !x.IsReservedPredicate(edge.Attr):
+			nodes[edge.Entity] = ns
+		}
+	}
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+	nodeTypes, err := nodeTypesAfter(ctx, m, nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	// The predicates every node can have, for the nodes with a strict type.
+	allowed := make(map[uint64]map[string]bool)
+	strictType := make(map[uint64]string)
+	for uid, typeNames := range nodeTypes {
+		fields := make(map[string]bool)
+		for _, typeName := range typeNames {
+			if strictTypes[typeName] {
+				strictType[uid] = typeName
+			}
+			typ, _ := schema.State().GetType(typeName)
+			for _, field := range typ.Fields {
+				fields[field.Predicate] = true
+			}
+		}
+		if _, ok := strictType[uid]; ok {
+			allowed[uid] = fields
+		}
+	}
+	// The values m removes don't matter to the nodes whose types change.
+	removed := make(map[uint64]map[string]bool)
+	for _, edge := range m.Edges {
+		fields, ok := allowed[edge.Entity]
+		switch {
+		case !ok || x.IsReservedPredicate(edge.Attr) || fields[edge.Attr]:
+		case edge.Op == pb.DirectedEdge_SET:
+			return nil, errors.Errorf("Can't set predicate %s for node %#x of strict type %s:"+
+				" it isn't a field of the types of the node", x.ParseAttr(edge.Attr),
+				edge.Entity, x.ParseAttr(strictType[edge.Entity]))
+		case isStarAll(edge.Value) || !schema.State().IsList(edge.Attr):
+			if removed[edge.Entity] == nil {
+				removed[edge.Entity] = make(map[string]bool)
+			}
+			removed[edge.Entity][edge.Attr] = true
+		}
+	}
+
+	byNs := make(map[uint64][]uint64)
+	for uid := range retyped {
+		if _, ok := allowed[uid]; ok {
+			byNs[nodes[uid]] = append(byNs[nodes[uid]], uid)
+		}
+	}
+	for ns, uids := range byNs {
+		uid, attr, err := strictViolation(ctx, ns, uids, m.StartTs,
+			func(uid uint64, attr string) bool {
+				return allowed[uid][attr] || removed[uid][attr]
+			})
+		if err != nil {
+			return nil, err
+		}
+		if attr != "" {
+			return nil, errors.Errorf("Can't set strict type %s for node %#x: it has a value"+
+				" for predicate %s, which isn't a field of the types of the node",
+				x.ParseAttr(strictType[uid]), uid, x.ParseAttr(attr))
+		}
+	}
+
+	keys := make([]string, 0, len(nodes))
+	for uid := range nodes {
+		keys = append(keys, strictConflictKey(uid))
+	}
+	return keys, nil
+}
+
+// strictConflictKey returns the conflict key of the predicates and types of the node uid, see
+// verifyStrictTypes.
+func strictConflictKey(uid uint64) string {
+	return strconv.FormatUint(farm.Fingerprint64([]byte(fmt.Sprintf("strict|%#x", uid))), 36)
+}
+
+// strictViolation returns a node of uids, which are in the namespace ns, with a value at
+// readTs for a predicate that allowed says it can't have, and that predicate. It returns an
+// empty predicate if there's none.
+func strictViolation(ctx context.Context, ns uint64, uids []uint64, readTs uint64,
+	allowed func(uid uint64, attr string) bool) (uint64, string, error) {
+	for _, attr := range groups().namespaceTablets(ns) {
+		if x.IsReservedPredicate(attr) || strings.Contains(attr, hnsw.VecKeyword) {
+			continue
+		}
+		var check []uint64
+		for _, uid := range uids {
+			if !allowed(uid, attr) {
+				check = append(check, uid)
+			}
+		}
+		if len(check) == 0 {
+			continue
+		}
+		vals, err := valuesOfNodes(ctx, attr, check, readTs)
+		if err != nil {
+			return 0, "", err
+		}
+		for i, uid := range check {
+			if (i < len(vals.UidMatrix) && len(vals.UidMatrix[i].Uids) > 0) ||
+				(i < len(vals.ValueMatrix) && len(vals.ValueMatrix[i].Values) > 0) {
+				return uid, attr, nil
+			}
+		}
+	}
+	return 0, "", nil
+}
+
+// forNodesOfType calls fn with the nodes of the type typeName at readTs, in increasing order
+// and strictCheckBatch at a time.
+func forNodesOfType(ctx context.Context, typeName string, readTs uint64,
+	fn func(uids []uint64) error) error {
+	ns, name := x.ParseNamespaceAttr(typeName)
+	var afterUid uint64
+	for {
+		res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+			Attr:     x.NamespaceAttr(ns, "dgraph.type"),
+			SrcFunc:  &pb.SrcFunction{Name: "eq", Args: []string{name}},
+			ReadTs:   readTs,
+			AfterUid: afterUid,
+			First:    strictCheckBatch,
+		})
+		if err == errNonExistentTablet {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(res.UidMatrix) == 0 || len(res.UidMatrix[0].Uids) == 0 {
+			return nil
+		}
+		uids := res.UidMatrix[0].Uids
+		if err := fn(uids); err != nil {
+			return err
+		}
+		if len(uids) < strictCheckBatch {
+			return nil
+		}
+		afterUid = uids[len(uids)-1]
+	}
+}
+
+// verifyStrictTypeUpdates checks that the nodes of the types the type updates of the mutation
+// m declare with @strict don't have a value for a predicate outside the fields of their
+// types. Only the fields removed from a type that was strict already are checked, otherwise
+// all the predicates of the namespace are.
+func verifyStrictTypeUpdates(ctx context.Context, m *pb.Mutations) error {
+	// The fields of the types after the mutation.
+	newTypes := make(map[string]*pb.TypeUpdate, len(m.Types))
+	for _, t := range m.Types {
+		newTypes[t.TypeName] = t
+	}
+	hasField := func(typeName, field string) bool {
+		typ, ok := newTypes[typeName]
+		if !ok {
+			current, _ := schema.State().GetType(typeName)
+			typ = &current
+		}
+		for _, f := range typ.Fields {
+			if f.Predicate == field {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, t := range m.Types {
+		if !t.Strict {
+			continue
+		}
+		// The predicates the nodes of the type may have a value for.
+		var check map[string]bool
+		if old, ok := schema.State().GetType(t.TypeName); ok && old.Strict {
+			check = make(map[string]bool)
+			for _, field := range old.Fields {
+				check[field.Predicate] = !hasField(t.TypeName, field.Predicate)
+			}
+		}
+
+		ns, typeName := x.ParseNamespaceAttr(t.TypeName)
+		err := forNodesOfType(ctx, t.TypeName, m.StartTs, func(uids []uint64) error {
+			nodes := make(map[uint64]uint64, len(uids))
+			for _, uid := range uids {
+				nodes[uid] = ns
+			}
+			nodeTypes, err := nodeTypesAfter(ctx, &pb.Mutations{StartTs: m.StartTs}, nodes)
+			if err != nil {
+				return err
+			}
+			uid, attr, err := strictViolation(ctx, ns, uids, m.StartTs,
+				func(uid uint64, attr string) bool {
+					if check != nil && !check[attr] {
+						return true
+					}
+					for _, typeName := range nodeTypes[uid] {
+						if hasField(typeName, attr) {
+							return true
+						}
+					}
+					return false
+				})
+			switch {
+			case err != nil || attr == "":
+				return err
+			case check != nil:
+				return errors.Errorf("Can't remove field %s from strict type %s: node %#x"+
+					" of the type has a value for it", x.ParseAttr(attr), typeName, uid)
+			}
+			return errors.Errorf("Can't make type %s strict without field %s: node %#x of the"+
+				" type has a value for it", typeName, x.ParseAttr(attr), uid)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // typeSanityCheck performs basic sanity checks on the given type update.
 func typeSanityCheck(t *pb.TypeUpdate) error {
 	for _, field := range t.Fields {
@@ -17,12 +17,14 @@
 package worker
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/schema"
 	"github.com/dgraph-io/dgraph/v24/types"
 	"github.com/dgraph-io/dgraph/v24/x"
 )
@@ -108,6 +110,94 @@ func TestValidateEdgeTypeError(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestValidateValueConstraints(t *testing.T) {
+	tests := []struct {
+		schema *pb.SchemaUpdate
+		value  string
+		op     pb.DirectedEdge_Op
+		err    string
+	}{
+		{
+			schema: &pb.SchemaUpdate{ValueType: pb.Posting_INT, Min: "0", Max: "150"},
+			value:  "30",
+		},
+		{
+			schema: &pb.SchemaUpdate{ValueType: pb.Posting_INT, Min: "0", Max: "150"},
+			value:  "-1",
+			err:    "Value -1 for predicate [age] is less than its @min(0)",
+		},
+		{
+			schema: &pb.SchemaUpdate{ValueType: pb.Posting_INT, Min: "0", Max: "150"},
+			value:  "151",
+			err:    "Value 151 for predicate [age] is greater than its @max(150)",
+		},
+		{
+			// Deleted values aren't checked.
+			schema: &pb.SchemaUpdate{ValueType: pb.Posting_INT, Max: "150"},
+			value:  "151",
+			op:     pb.DirectedEdge_DEL,
+		},
+		{
+			schema: &pb.SchemaUpdate{ValueType: pb.Posting_FLOAT, Min: "-1.5"},
+			value:  "-1.25",
+		},
+		{
+			schema: &pb.SchemaUpdate{ValueType: pb.Posting_DATETIME, Min: "2000-01-01"},
+			value:  "1999-12-31T23:59:59Z",
+			err:    "is less than its @min(2000-01-01)",
+		},
+		{
+			schema: &pb.SchemaUpdate{ValueType: pb.Posting_STRING, Regex: "^[a-z]+@[a-z.]+$"},
+			value:  "alice@dgraph.io",
+		},
+		{
+			schema: &pb.SchemaUpdate{ValueType: pb.Posting_STRING, Regex: "^[a-z]+@[a-z.]+$"},
+			value:  "alice",
+			err:    `Value "alice" for predicate [age] doesn't match its @regex("^[a-z]+@[a-z.]+$")`,
+		},
+		{
+			schema: &pb.SchemaUpdate{ValueType: pb.Posting_STRING,
+				EnumValues: []string{"active", "inactive"}},
+			value: "inactive",
+		},
+		{
+			schema: &pb.SchemaUpdate{ValueType: pb.Posting_STRING,
+				EnumValues: []string{"active", "inactive"}},
+			value: "deleted",
+			err:   "Value deleted for predicate [age] isn't one of its @enum(active, inactive)",
+		},
+		{
+			schema: &pb.SchemaUpdate{ValueType: pb.Posting_INT, EnumValues: []string{"1", "2"}},
+			value:  "2",
+		},
+	}
+
+	for _, tc := range tests {
+		edge := &pb.DirectedEdge{
+			Value: []byte(tc.value),
+			Attr:  x.GalaxyAttr("age"),
+			Op:    tc.op,
+		}
+		err := ValidateAndConvert(edge, tc.schema)
+		if tc.err == "" {
+			require.NoError(t, err)
+			continue
+		}
+		require.ErrorContains(t, err, tc.err)
+	}
+
+	// Values already of the type of the predicate are checked too.
+	b := types.ValueForType(types.BinaryID)
+	require.NoError(t, types.Marshal(types.Val{Tid: types.IntID, Value: int64(151)}, &b))
+	edge := &pb.DirectedEdge{
+		Value:     b.Value.([]byte),
+		ValueType: pb.Posting_INT,
+		Attr:      x.GalaxyAttr("age"),
+	}
+	err := ValidateAndConvert(edge, &pb.SchemaUpdate{ValueType: pb.Posting_INT, Max: "150"})
+	require.ErrorContains(t, err, "is greater than its @max(150)")
+}
+
 func TestTypeSanityCheck(t *testing.T) {
 	// Empty field name check.
 	typeDef := &pb.TypeUpdate{
@@ -160,3 +250,36 @@ func TestTypeSanityCheck(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Field in type definition cannot have tokenizers")
 }
+
+func TestWithCompositeRebuilds(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte(`
+		country: string @composite(age) .
+		age: string .
+		name: string .
+	`), 1))
+	ctx := context.Background()
+	country, age, name := x.GalaxyAttr("country"), x.GalaxyAttr("age"), x.GalaxyAttr("name")
+
+	// The composite index of country is rebuilt when the type of age changes.
+	updates := []*pb.SchemaUpdate{
+		{Predicate: age, ValueType: pb.Posting_INT},
+		{Predicate: name, ValueType: pb.Posting_INT},
+	}
+	all, changed := withCompositeRebuilds(ctx, updates)
+	require.Len(t, all, 3)
+	require.Equal(t, country, all[2].Predicate)
+	require.Equal(t, []string{age}, all[2].CompositeWith)
+	require.Equal(t, map[string]bool{country: true}, changed)
+
+	// The schema of country isn't added again when it's updated too.
+	updates = append(updates, &pb.SchemaUpdate{Predicate: country, ValueType: pb.Posting_STRING})
+	all, changed = withCompositeRebuilds(ctx, updates)
+	require.Len(t, all, 3)
+	require.Equal(t, map[string]bool{country: true}, changed)
+
+	all, changed = withCompositeRebuilds(ctx, []*pb.SchemaUpdate{
+		{Predicate: age, ValueType: pb.Posting_STRING},
+	})
+	require.Len(t, all, 1)
+	require.Empty(t, changed)
+}
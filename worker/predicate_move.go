@@ -238,6 +238,13 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 		return &emptyPayload, nil
 	}
 
+	// The predicates of a composite index are read together to update it, see runSchemaMutation.
+	if attrs := schema.State().CompositeIndexesOf(in.Predicate); len(attrs) > 0 {
+		return &emptyPayload, errors.Errorf("Predicate [%v] can't be moved, it's part of the"+
+			" composite index of [%v], whose predicates must be served by the same group",
+			x.ParseAttr(in.Predicate), x.ParseAttr(attrs[0]))
+	}
+
 	if err := posting.Oracle().WaitForTs(ctx, in.TxnTs); err != nil {
 		return &emptyPayload, errors.Errorf("While waiting for txn ts: %d. Error: %v", in.TxnTs, err)
 	}
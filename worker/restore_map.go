@@ -47,6 +47,7 @@ import (
 	"github.com/dgraph-io/dgraph/v24/protos/pb"
 	"github.com/dgraph-io/dgraph/v24/schema"
 	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v24/tok/ivf"
 	"github.com/dgraph-io/dgraph/v24/x"
 	"github.com/dgraph-io/ristretto/z"
 )
@@ -496,7 +497,9 @@ func (m *mapper) processReqCh(ctx context.Context) error {
 			// If the predicate is a vector indexing predicate, skip further processing.
 			// currently we don't store vector supporting predicates in the schema.
 			if strings.HasSuffix(parsedKey.Attr, hnsw.VecEntry) || strings.HasSuffix(parsedKey.Attr, hnsw.VecKeyword) ||
-				strings.HasSuffix(parsedKey.Attr, hnsw.VecDead) {
+				strings.HasSuffix(parsedKey.Attr, hnsw.VecDead) || strings.HasSuffix(parsedKey.Attr, hnsw.VecMulti) ||
+				strings.HasSuffix(parsedKey.Attr, hnsw.VecOwner) || strings.HasSuffix(parsedKey.Attr, ivf.VecCodebook) ||
+				strings.HasSuffix(parsedKey.Attr, ivf.VecList) || strings.HasSuffix(parsedKey.Attr, ivf.VecAssignment) {
 				return nil
 			}
 			// Reset the StreamId to prevent ordering issues while writing to stream writer.
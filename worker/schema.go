@@ -57,6 +57,7 @@ func getSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, erro
 		fields = s.Fields
 	} else {
 		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert", "unique",
+			"unique_with", "composite_with", "min", "max", "regex", "enum_values", "ttl",
 			"lang", "noconflict", "vector_specs"}
 	}
 
@@ -115,6 +116,22 @@ func populateSchema(attr string, fields []string) *pb.SchemaNode {
 			schemaNode.Upsert = pred.GetUpsert()
 		case "unique":
 			schemaNode.Unique = pred.GetUnique()
+		case "unique_with":
+			schemaNode.UniqueWith = append([]string(nil), pred.GetUniqueWith()...)
+		case "composite_with":
+			schemaNode.CompositeWith = append([]string(nil), pred.GetCompositeWith()...)
+		case "min":
+			schemaNode.Min = pred.GetMin()
+		case "max":
+			schemaNode.Max = pred.GetMax()
+		case "regex":
+			schemaNode.Regex = pred.GetRegex()
+		case "enum_values":
+			schemaNode.EnumValues = append([]string(nil), pred.GetEnumValues()...)
+		case "ttl":
+			if pred.GetTtl() > 0 {
+				schemaNode.Ttl = schema.FormatTTL(pred.GetTtl())
+			}
 		case "lang":
 			schemaNode.Lang = pred.GetLang()
 		case "noconflict":
@@ -48,10 +48,12 @@ const (
 		`client_key=; sasl-mechanism=PLAIN; tls=false;`
 	LimitDefaults = `mutations=allow; query-edge=1000000; normalize-node=10000; ` +
 		`mutations-nquad=1000000; disallow-drop=false; query-timeout=0ms; txn-abort-after=5m; ` +
+		`history-retention=0s; ` +
 		` max-retries=10;max-pending-queries=10000;shared-instance=false;type-filter-uid-limit=10`
 	ZeroLimitsDefaults = `uid-lease=0; refill-interval=30s; disable-admin-http=false;`
 	GraphQLDefaults    = `introspection=true; debug=false; extensions=true; poll-interval=1s; ` +
-		`lambda-url=;`
+		`lambda-url=; embedding-endpoints=; embedding-timeout=30s; embedding-batch-size=64; ` +
+		`embedding-max-reply-mb=32;`
 	CacheDefaults        = `size-mb=1024; percentage=0,80,20;`
 	FeatureFlagsDefaults = `normalize-compatibility-mode=`
 )
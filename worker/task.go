@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -44,6 +45,8 @@ import (
 	"github.com/dgraph-io/dgraph/v24/tok"
 	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
 	"github.com/dgraph-io/dgraph/v24/tok/index"
+	"github.com/dgraph-io/dgraph/v24/tok/ivf"
+	opt "github.com/dgraph-io/dgraph/v24/tok/options"
 	"github.com/dgraph-io/dgraph/v24/types"
 	"github.com/dgraph-io/dgraph/v24/types/facets"
 	"github.com/dgraph-io/dgraph/v24/x"
@@ -224,6 +227,7 @@ const (
 	customIndexFn
 	matchFn
 	similarToFn
+	compositeFn
 	standardFn = 100
 )
 
@@ -268,6 +272,8 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return customIndexFn, f
 	case "match":
 		return matchFn, f
+	case "composite":
+		return compositeFn, f
 	default:
 		if types.IsGeoFunc(f) {
 			return geoFn, f
@@ -294,6 +300,73 @@ func needsIndex(fnType FuncType, uidList *pb.List) bool {
 	return false
 }
 
+// indexUsed returns the name of the index the function of q is evaluated with, or an
+// empty string if it's evaluated by reading the values of the predicate.
+func indexUsed(ctx context.Context, q *pb.Query, srcFn *functionContext) string {
+	switch srcFn.fnType {
+	case compareAttrFn:
+		// The tokens are only looked up if the index is used, see parseSrcFn.
+		if len(srcFn.tokens) == 0 {
+			return ""
+		}
+		tokenizer, err := pickTokenizer(ctx, q.Attr, srcFn.fname)
+		if err != nil {
+			return ""
+		}
+		return tokenizer.Name()
+	case compareScalarFn:
+		if srcFn.isFuncAtRoot {
+			return "count"
+		}
+	case geoFn:
+		return tok.GeoTokenizer{}.Name()
+	case standardFn, fullTextSearchFn, matchFn:
+		name, _ := verifyStringIndex(ctx, q.Attr, srcFn.fnType)
+		return name
+	case regexFn:
+		if schema.State().HasTokenizer(ctx, tok.IdentTrigram, q.Attr) {
+			return tok.TrigramTokenizer{}.Name()
+		}
+	case customIndexFn:
+		if len(q.SrcFunc.Args) > 0 {
+			return q.SrcFunc.Args[0]
+		}
+	case similarToFn:
+		if cspec, err := pickFactoryCreateSpec(ctx, q.Attr); err == nil {
+			return cspec.Name()
+		}
+	case compositeFn:
+		return "composite"
+	}
+	return ""
+}
+
+// PlanTask returns the group that would serve q, and the index its function would be
+// evaluated with, without running it. Unlike the index of the result of q, the index
+// doesn't account for the choices made once the tokens of the arguments of the function
+// and the uids it filters are known, like reading the values of a few uids instead of
+// using an index with many tokens.
+func PlanTask(ctx context.Context, q *pb.Query) (uint32, string, error) {
+	gid, err := groups().BelongsToReadOnly(q.Attr, q.ReadTs)
+	if err != nil || gid == 0 {
+		return 0, "", err
+	}
+
+	fnType, fname := parseFuncType(q.SrcFunc)
+	srcFn := &functionContext{fnType: fnType, fname: fname, isFuncAtRoot: q.UidList == nil}
+	if fnType == compareAttrFn {
+		if !schema.State().IsIndexed(ctx, q.Attr) {
+			return gid, "", nil
+		}
+		tokenizer, err := pickTokenizer(ctx, q.Attr, fname)
+		if err != nil {
+			return gid, "", nil
+		}
+		return gid, tokenizer.Name(), nil
+	}
+	return gid, indexUsed(ctx, q, srcFn), nil
+}
+
 // needsIntersect checks if the function type needs algo.IntersectSorted() after the results
 // are collected. This is needed for functions that require all values to  match, like
 // "allofterms", "alloftext", and custom functions with "allof".
@@ -319,7 +392,8 @@ func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error)
 			return false, nil
 		}
 		return true, nil
-	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn:
+	case geoFn, regexFn, fullTextSearchFn, standardFn, hasFn, customIndexFn, matchFn,
+		compositeFn:
 		// All of these require an index, hence would require fetching uid postings.
 		return false, nil
 	case uidInFn, compareScalarFn:
@@ -368,24 +442,48 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 			posting.NewViLocalCache(qs.cache),
 			args.q.ReadTs,
 		)
-		indexer, err := cspec.CreateIndex(args.q.Attr)
+		var indexer index.VectorIndex[float32]
+		if ef := srcFn.vectorOptions.ef; ef > 0 {
+			// The search width of an IVF index is the number of clusters it probes.
+			option := hnsw.EfSearchOpt
+			if cspec.IndexType() == ivf.Ivf {
+				option = ivf.NProbeOpt
+			}
+			indexer, err = cspec.CreateIndexWithOptions(args.q.Attr, []opt.OptionValuePair{
+				{Option: option, Value: strconv.Itoa(ef)}})
+		} else {
+			indexer, err = cspec.CreateIndex(args.q.Attr)
+		}
 		if err != nil {
 			return err
 		}
-		var nnUids []uint64
-		if srcFn.vectorInfo != nil {
-			nnUids, err = indexer.Search(ctx, qc, srcFn.vectorInfo,
-				int(numNeighbors), index.AcceptAll[float32])
+		candidates := q.UidList
+		if srcFn.vectorOptions.exact && candidates == nil {
+			// An exact search at root compares the query against every
+			// vector of the predicate.
+			all := &pb.Result{}
+			hasQuery := &pb.Query{Attr: q.Attr, ReadTs: q.ReadTs, First: math.MaxInt32}
+			if err := qs.handleHasFunction(ctx, hasQuery, all, &functionContext{fnType: hasFn}); err != nil {
+				return err
+			}
+			candidates = all.UidMatrix[0]
+		}
+		vf := newVectorFilter(ctx, q.VectorFilter)
+		var res *index.SearchPathResult
+		if cspec.IsMultiVector() {
+			res, err = qs.searchMultiVector(ctx, indexer, qc, cspec, q, srcFn, candidates,
+				vf, int(numNeighbors))
 		} else {
-			nnUids, err = indexer.SearchWithUid(ctx, qc, srcFn.vectorUid,
-				int(numNeighbors), index.AcceptAll[float32])
+			res, err = searchVectorIndex(ctx, indexer, qc, srcFn, candidates, vf,
+				int(numNeighbors))
 		}
-
 		if err != nil && !strings.Contains(err.Error(), hnsw.EmptyHNSWTreeError+": "+badger.ErrKeyNotFound.Error()) {
 			return err
 		}
-		sort.Slice(nnUids, func(i, j int) bool { return nnUids[i] < nnUids[j] })
+		nnUids, distances := sortByUid(res.Neighbors, res.Distances)
 		args.out.UidMatrix = append(args.out.UidMatrix, &pb.List{Uids: nnUids})
+		args.out.VectorDistances = distances
+		args.out.VectorMetrics = res.Metrics
 		return nil
 	}
 
@@ -426,7 +524,11 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 	// Similarly with DoCount and ExpandAll and Facets. List types are also not supported
 	// because list is stored by time, and we combine all the list items at various timestamps.
 	hasLang := schema.State().HasLang(q.Attr)
-	getMultiplePosting := q.DoCount || q.ExpandAll || listType || hasLang || q.FacetParam != nil
+	// The values of a predicate with @ttl that have expired are hidden until they're deleted,
+	// which needs the commit timestamp of the list.
+	expiredTs := ttlExpiredTs(ctx, q.Attr, q.ReadTs)
+	getMultiplePosting := q.DoCount || q.ExpandAll || listType || hasLang ||
+		q.FacetParam != nil || expiredTs > 0
 
 	calculate := func(start, end int) error {
 		x.AssertTrue(start%width == 0)
@@ -470,6 +572,7 @@ func (qs *queryState) handleValuePostings(ctx context.Context, args funcArgs) er
 				if err != nil {
 					return err
 				}
+				pl = hideExpired(pl, key, q.ReadTs, expiredTs)
 
 				// If count is being requested, there is no need to populate value and facets matrix.
 				if q.DoCount {
@@ -798,6 +901,18 @@ func (qs *queryState) handleUidPostings(
 	lang := langForFunc(q.Langs)
 	needFiltering := needsStringFiltering(srcFn, q.Langs, q.Attr)
 	isList := schema.State().IsList(q.Attr)
+	// The edges of a predicate with @ttl that have expired are hidden until they're deleted.
+	// The reverse edges are counted here, and filtered with the other lookups finding nodes
+	// of the predicate, see helpProcessTask.
+	var expiredTs, reverseExpiredTs uint64
+	switch srcFn.fnType {
+	case notAFunction, compareScalarFn, hasFn, uidInFn:
+		if q.Reverse {
+			reverseExpiredTs = ttlExpiredTs(ctx, q.Attr, q.ReadTs)
+		} else {
+			expiredTs = ttlExpiredTs(ctx, q.Attr, q.ReadTs)
+		}
+	}
 
 	errCh := make(chan error, numGo)
 	outputs := make([]*pb.Result, numGo)
@@ -824,7 +939,7 @@ func (qs *queryState) handleUidPostings(
 					key = x.DataKey(q.Attr, q.UidList.Uids[i])
 				}
 			case geoFn, regexFn, fullTextSearchFn, standardFn, customIndexFn, matchFn,
-				compareAttrFn:
+				compareAttrFn, compositeFn:
 				key = x.IndexKey(q.Attr, srcFn.tokens[i])
 			default:
 				return errors.Errorf("Unhandled function in handleUidPostings: %s", srcFn.fname)
@@ -835,8 +950,20 @@ func (qs *queryState) handleUidPostings(
 			if err != nil {
 				return err
 			}
+			pl = hideExpired(pl, key, q.ReadTs, expiredTs)
 
 			switch {
+			case q.DoCount && reverseExpiredTs > 0:
+				uids, _, err := retrieveUidsAndFacets(args, pl, facetsTree, opts)
+				if err != nil {
+					return err
+				}
+				live, err := qs.unexpired(q.Attr, q.ReadTs, reverseExpiredTs, uids.Uids, nil)
+				if err != nil {
+					return err
+				}
+				out.Counts = append(out.Counts, uint32(len(live)))
+				out.UidMatrix = append(out.UidMatrix, &pb.List{})
 			case q.DoCount:
 				if i == 0 {
 					span.Annotate(nil, "DoCount")
@@ -1167,7 +1294,28 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 		}
 	}
 
+	// The nodes found through the index or the reverse edges of a predicate with @ttl
+	// whose values have expired are dropped, as their values are hidden.
+	if (srcFn.fnType != notAFunction || q.Reverse) && !q.DoCount {
+		if expiredTs := ttlExpiredTs(ctx, attr, q.ReadTs); expiredTs > 0 {
+			span.Annotate(nil, "dropExpired")
+			if err := qs.dropExpired(out, attr, q.ReadTs, expiredTs); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	out.IntersectDest = srcFn.intersectDest
+	if q.TextScores && srcFn.fnType == fullTextSearchFn {
+		span.Annotate(nil, "textScores")
+		if out.TextScores, err = qs.textScores(ctx, q, srcFn, out); err != nil {
+			return nil, err
+		}
+	}
+	if q.Profile {
+		out.Index = indexUsed(ctx, q, srcFn)
+		out.GroupId = gid
+	}
 	return out, nil
 }
 
@@ -1763,6 +1911,10 @@ type functionContext struct {
 	atype          types.TypeID
 	vectorInfo     []float32
 	vectorUid      uint64
+	vectorOptions  vectorSearchOptions
+	// multiVectorInfo holds the query vectors of a similar_to on a predicate
+	// with a list of vectors per node, unless the query is given as a uid.
+	multiVectorInfo [][]float32
 }
 
 const (
@@ -1934,6 +2086,11 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		}
 		fc.threshold = thresholds
 		checkRoot(q, fc)
+	case compositeFn:
+		if fc.tokens, err = getCompositeTokens(ctx, q); err != nil {
+			return nil, err
+		}
+		fc.n = len(fc.tokens)
 	case geoFn:
 		// For geo functions, we get extra information used for filtering.
 		fc.tokens, fc.geoQuery, err = types.GetGeoTokens(q.SrcFunc)
@@ -2034,13 +2191,33 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		}
 		checkRoot(q, fc)
 	case similarToFn:
-		if err = ensureArgsCount(q.SrcFunc, 2); err != nil {
-			return nil, err
+		if len(q.SrcFunc.Args) < 2 {
+			return nil, errors.Errorf("Function '%s' requires at least 2 arguments, but got %d (%v)",
+				q.SrcFunc.Name, len(q.SrcFunc.Args), q.SrcFunc.Args)
+		}
+		if schema.State().IsList(attr) {
+			fc.multiVectorInfo, fc.vectorUid, err = interpretVFloatListOrUid(q.SrcFunc.Args[1])
+		} else {
+			fc.vectorInfo, fc.vectorUid, err = interpretVFloatOrUid(q.SrcFunc.Args[1])
 		}
-		fc.vectorInfo, fc.vectorUid, err = interpretVFloatOrUid(q.SrcFunc.Args[1])
 		if err != nil {
 			return nil, err
 		}
+		if t == types.VInt8ID || t == types.VBinaryID {
+			if fc.vectorInfo != nil {
+				if fc.vectorInfo, err = quantizeQueryVector(fc.vectorInfo, t); err != nil {
+					return nil, err
+				}
+			}
+			for i, vec := range fc.multiVectorInfo {
+				if fc.multiVectorInfo[i], err = quantizeQueryVector(vec, t); err != nil {
+					return nil, err
+				}
+			}
+		}
+		if fc.vectorOptions, err = parseVectorSearchOptions(q.SrcFunc.Args[2:]); err != nil {
+			return nil, err
+		}
 	case uidInFn:
 		for _, arg := range q.SrcFunc.Args {
 			uidParsed, err := strconv.ParseUint(arg, 0, 64)
@@ -2066,6 +2243,299 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 	return fc, nil
 }
 
+// vectorSearchOptions holds the per-query options of a similar_to function.
+// They follow the query vector as "name:value" arguments, as in
+// similar_to(embedding, 10, $vec, ef: 200, exact: true).
+type vectorSearchOptions struct {
+	// ef overrides the efSearch of an HNSW index, or the nprobe of an IVF
+	// index, for this query. Zero keeps the one of the schema.
+	ef int
+	// exact compares the query against every candidate instead of searching
+	// the index, which gives the true nearest neighbors to measure recall.
+	exact bool
+}
+
+func parseVectorSearchOptions(args []string) (vectorSearchOptions, error) {
+	var vo vectorSearchOptions
+	for _, arg := range args {
+		name, val, ok := strings.Cut(arg, ":")
+		if !ok {
+			return vo, errors.Errorf("Invalid option %q for similar_to, expected name:value", arg)
+		}
+		name, val = strings.TrimSpace(name), strings.TrimSpace(val)
+		var err error
+		switch name {
+		case "ef":
+			vo.ef, err = strconv.Atoi(val)
+			if err == nil && vo.ef <= 0 {
+				err = errors.Errorf("must be positive")
+			}
+		case "exact":
+			vo.exact, err = strconv.ParseBool(val)
+		default:
+			return vo, errors.Errorf("Unknown option %q for similar_to, expected ef or exact", name)
+		}
+		if err != nil {
+			return vo, errors.Errorf("Invalid value %q for option %s of similar_to: %v", val, name, err)
+		}
+	}
+	return vo, nil
+}
+
+// maxExactVectorCandidates is the largest number of candidates for which a
+// restricted similar_to skips the HNSW traversal and compares the query against
+// every candidate instead.
+const maxExactVectorCandidates = 1000
+
+// A restricted search which finds fewer than k nodes through the index is run
+// again, asking for vectorSearchWidening times more neighbors each time, until
+// it asks for maxVectorSearchWidth of them.
+const (
+	vectorSearchWidening = 4
+	maxVectorSearchWidth = 16 * maxExactVectorCandidates
+)
+
+// searchVectorIndex runs the similar_to function described by srcFn against
+// indexer. The search is restricted by a non-nil candidates list, which is either
+// the SrcUIDs of a similar_to used inside @filter or a small superset of the uids
+// matched by the @filter of a similar_to at root, and by vf, the filters of a
+// similar_to at root (see query.SubGraph.filteredVectorSearch). Small candidate
+// lists are searched exhaustively, like any with the exact option. Otherwise the
+// index is searched with a SearchFilter accepting the nodes which pass the
+// restrictions, and the search is widened while it finds fewer than k of them.
+func searchVectorIndex(ctx context.Context, indexer index.VectorIndex[float32],
+	qc index.CacheType, srcFn *functionContext, candidates *pb.List, vf *vectorFilter,
+	k int) (*index.SearchPathResult, error) {
+	if candidates != nil && (srcFn.vectorOptions.exact ||
+		len(candidates.Uids) <= max(k, maxExactVectorCandidates)) {
+		uids := candidates.Uids
+		if vf != nil {
+			var err error
+			if uids, err = vf.filter(uids); err != nil {
+				return nil, err
+			}
+		}
+		if srcFn.vectorInfo != nil {
+			return indexer.SearchExact(ctx, qc, srcFn.vectorInfo, uids, k)
+		}
+		return indexer.SearchExactWithUid(ctx, qc, srcFn.vectorUid, uids, k)
+	}
+
+	filter := index.AcceptAll[float32]
+	if candidates != nil || vf != nil {
+		filter = func(query, vec []float32, uid uint64) bool {
+			if candidates != nil && algo.IndexOf(candidates, uid) < 0 {
+				return false
+			}
+			return vf == nil || vf.accept(query, vec, uid)
+		}
+	}
+	search := func(width int) (*index.SearchPathResult, error) {
+		if srcFn.vectorInfo != nil {
+			return indexer.SearchWithPath(ctx, qc, srcFn.vectorInfo, width, filter)
+		}
+		return indexer.SearchWithUidAndPath(ctx, qc, srcFn.vectorUid, width, filter)
+	}
+	res, err := search(k)
+	restricted := candidates != nil || vf != nil
+	for width := k; err == nil && restricted && len(res.Neighbors) < k &&
+		width < maxVectorSearchWidth; {
+		width *= vectorSearchWidening
+		res, err = search(width)
+	}
+	if err == nil && vf != nil && vf.err != nil {
+		return nil, vf.err
+	}
+	if err == nil && len(res.Neighbors) > k {
+		res.Neighbors, res.Distances = res.Neighbors[:k], res.Distances[:k]
+	}
+	return res, err
+}
+
+// multiVectorCandidates is the number of vectors retrieved from the index by
+// each query vector of a similar_to on a list of vectors, for every result
+// requested. As a node holds several vectors, the nearest vectors of a query
+// vector are likely to belong to fewer nodes than requested.
+const multiVectorCandidates = 4
+
+// searchMultiVector runs the similar_to function described by srcFn on q.Attr,
+// a predicate holding a list of vectors per node. The nodes are ranked by
+// MaxSim: for every query vector, the best score against the vectors of the
+// node, summed over the query vectors. The scores are summed as distances
+// (see hnsw.SimilarityType.ToDistance), so the best node has the smallest
+// total. The nodes to rank are the owners of the vectors the index finds
+// nearest to each query vector. Like in searchVectorIndex, candidates and vf
+// restrict the search, small candidate lists, or the exact option, rank every
+// candidate instead, and the index is searched for more vectors while fewer
+// than k nodes pass the restrictions.
+func (qs *queryState) searchMultiVector(ctx context.Context, indexer index.VectorIndex[float32],
+	qc index.CacheType, cspec *tok.FactoryCreateSpec, q *pb.Query, srcFn *functionContext,
+	candidates *pb.List, vf *vectorFilter, k int) (*index.SearchPathResult, error) {
+	queries := srcFn.multiVectorInfo
+	if queries == nil {
+		var err error
+		if queries, err = qs.nodeVectors(q.Attr, srcFn.vectorUid, q.ReadTs); err != nil {
+			return nil, err
+		}
+		if len(queries) == 0 {
+			return nil, errors.Errorf("Node %#x has no vector for %s", srcFn.vectorUid,
+				x.ParseAttr(q.Attr))
+		}
+	}
+
+	res := index.NewSearchPathResult()
+	rank := func(uids []uint64) error {
+		simType := cspec.SimilarityType()
+		var ranked []uint64
+		distances := make(map[uint64]float64)
+		for _, uid := range uids {
+			vecs, err := qs.nodeVectors(q.Attr, uid, q.ReadTs)
+			if err != nil {
+				return err
+			}
+			if len(vecs) == 0 {
+				continue
+			}
+			var total float64
+			for _, query := range queries {
+				best := math.Inf(1)
+				for _, vec := range vecs {
+					score, err := simType.Score(query, vec, 32)
+					if err != nil {
+						return err
+					}
+					best = math.Min(best, simType.ToDistance(score))
+				}
+				total += best
+			}
+			ranked = append(ranked, uid)
+			distances[uid] = total
+		}
+		sort.Slice(ranked, func(i, j int) bool {
+			if distances[ranked[i]] != distances[ranked[j]] {
+				return distances[ranked[i]] < distances[ranked[j]]
+			}
+			return ranked[i] < ranked[j]
+		})
+		if len(ranked) > k {
+			ranked = ranked[:k]
+		}
+		res.Neighbors, res.Distances = res.Neighbors[:0], res.Distances[:0]
+		for _, uid := range ranked {
+			res.Neighbors = append(res.Neighbors, uid)
+			res.Distances = append(res.Distances, distances[uid])
+		}
+		return nil
+	}
+	if candidates != nil && (srcFn.vectorOptions.exact ||
+		len(candidates.Uids) <= max(k, maxExactVectorCandidates)) {
+		uids := candidates.Uids
+		if vf != nil {
+			var err error
+			if uids, err = vf.filter(uids); err != nil {
+				return nil, err
+			}
+		}
+		return res, rank(uids)
+	}
+
+	ownerAttr := hnsw.ConcatStrings(q.Attr, hnsw.VecOwner)
+	// owners returns the nodes holding the width nearest vectors of every query
+	// vector, which pass the restrictions of the search.
+	owners := func(width int) ([]uint64, error) {
+		found := make(map[uint64]struct{})
+		for _, query := range queries {
+			r, err := indexer.SearchWithPath(ctx, qc, query, width, index.AcceptAll[float32])
+			if err != nil {
+				return nil, err
+			}
+			for name, v := range r.Metrics {
+				res.Metrics[name] += v
+			}
+			for _, id := range r.Neighbors {
+				owner, err := qc.Get(x.DataKey(ownerAttr, id))
+				data, ok := owner.([]byte)
+				if err != nil || !ok || len(data) != 8 {
+					// The vector was deleted since it was indexed.
+					continue
+				}
+				uid := hnsw.BytesToUint64(data)
+				if candidates != nil && algo.IndexOf(candidates, uid) < 0 {
+					continue
+				}
+				if vf != nil && !vf.accept(nil, nil, uid) {
+					continue
+				}
+				found[uid] = struct{}{}
+			}
+		}
+		if vf != nil && vf.err != nil {
+			return nil, vf.err
+		}
+		uids := make([]uint64, 0, len(found))
+		for uid := range found {
+			uids = append(uids, uid)
+		}
+		return uids, nil
+	}
+	restricted := candidates != nil || vf != nil
+	for width := k * multiVectorCandidates; ; width *= vectorSearchWidening {
+		uids, err := owners(width)
+		if err != nil {
+			if strings.Contains(err.Error(), hnsw.EmptyHNSWTreeError) {
+				return res, nil
+			}
+			return nil, err
+		}
+		if !restricted || len(uids) >= k || width >= maxVectorSearchWidth {
+			return res, rank(uids)
+		}
+	}
+}
+
+// nodeVectors returns the vectors held by uid for attr, a predicate with a
+// list of vectors per node.
+func (qs *queryState) nodeVectors(attr string, uid, readTs uint64) ([][]float32, error) {
+	pl, err := qs.cache.Get(x.DataKey(attr, uid))
+	if err != nil {
+		return nil, err
+	}
+	vals, err := pl.AllValues(readTs)
+	if err != nil {
+		return nil, err
+	}
+	vecs := make([][]float32, 0, len(vals))
+	for _, val := range vals {
+		data, ok := val.Value.([]byte)
+		if !ok || !val.Tid.IsVector() {
+			continue
+		}
+		var vec []float32
+		index.DecodeVector(data, &vec, 32, tok.VectorEncoding(val.Tid))
+		vecs = append(vecs, vec)
+	}
+	return vecs, nil
+}
+
+// sortByUid sorts the neighbors returned by a vector search in increasing
+// order of uid, keeping each distance aligned with its uid.
+func sortByUid(uids []uint64, distances []float64) ([]uint64, []float64) {
+	x.AssertTrue(len(uids) == len(distances))
+	idx := make([]int, len(uids))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.Slice(idx, func(i, j int) bool { return uids[idx[i]] < uids[idx[j]] })
+
+	sortedUids := make([]uint64, len(uids))
+	sortedDistances := make([]float64, len(distances))
+	for i, j := range idx {
+		sortedUids[i] = uids[j]
+		sortedDistances[i] = distances[j]
+	}
+	return sortedUids, sortedDistances
+}
+
 func interpretVFloatOrUid(val string) ([]float32, uint64, error) {
 	vf, err := types.ParseVFloat(val)
 	if err == nil {
@@ -2078,6 +2548,38 @@ func interpretVFloatOrUid(val string) ([]float32, uint64, error) {
 	return nil, uid, errors.Errorf("Value %q is not a uid or vector", val)
 }
 
+// interpretVFloatListOrUid is interpretVFloatOrUid for a similar_to on a
+// predicate with a list of vectors per node, where the query may be a list
+// of vectors as well as a single one.
+func interpretVFloatListOrUid(val string) ([][]float32, uint64, error) {
+	if vfs, err := types.ParseVFloatList(val); err == nil {
+		return vfs, 0, nil
+	}
+	vf, uid, err := interpretVFloatOrUid(val)
+	if err != nil || vf == nil {
+		return nil, uid, err
+	}
+	return [][]float32{vf}, 0, nil
+}
+
+// quantizeQueryVector returns the query vector vec of a similar_to on an
+// int8vector or binaryvector predicate of type typ, as read by the vector
+// index of that predicate. The query must be given in the same form as the
+// values of the predicate, so that it is compared as they are.
+func quantizeQueryVector(vec []float32, typ types.TypeID) ([]float32, error) {
+	v, err := types.Convert(types.Val{Tid: types.VFloatID, Value: types.FloatArrayAsBytes(vec)}, typ)
+	if err != nil {
+		return nil, err
+	}
+	b := types.ValueForType(types.BinaryID)
+	if err := types.Marshal(v, &b); err != nil {
+		return nil, err
+	}
+	var quantized []float32
+	index.DecodeVector(b.Value.([]byte), &quantized, 32, tok.VectorEncoding(typ))
+	return quantized, nil
+}
+
 // ServeTask is used to respond to a query.
 func (w *grpcWorker) ServeTask(ctx context.Context, q *pb.Query) (*pb.Result, error) {
 	ctx, span := otrace.StartSpan(ctx, "worker.ServeTask")
@@ -0,0 +1,73 @@
+/*
+ * Copyright 2017-2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/types"
+)
+
+func TestParseVectorSearchOptions(t *testing.T) {
+	vo, err := parseVectorSearchOptions(nil)
+	require.NoError(t, err)
+	require.Equal(t, vectorSearchOptions{}, vo)
+
+	vo, err = parseVectorSearchOptions([]string{"ef:200", "exact: true"})
+	require.NoError(t, err)
+	require.Equal(t, vectorSearchOptions{ef: 200, exact: true}, vo)
+
+	for _, bad := range []string{"ef", "ef:0", "ef:many", "exact:maybe", "nprobe:4"} {
+		_, err := parseVectorSearchOptions([]string{bad})
+		require.Error(t, err, bad)
+	}
+}
+
+func TestQuantizeQueryVector(t *testing.T) {
+	vec, err := quantizeQueryVector([]float32{127, -127, 0}, types.VInt8ID)
+	require.NoError(t, err)
+	require.Equal(t, []float32{1, -1, 0}, vec)
+	_, err = quantizeQueryVector([]float32{0.5, 1, 0}, types.VInt8ID)
+	require.Error(t, err)
+
+	vec, err = quantizeQueryVector([]float32{1, 0, 0, 0, 0, 0, 1, 1}, types.VBinaryID)
+	require.NoError(t, err)
+	require.Equal(t, []float32{1, 0, 0, 0, 0, 0, 1, 1}, vec)
+	_, err = quantizeQueryVector([]float32{1, 0}, types.VBinaryID)
+	require.Error(t, err)
+}
+
+func TestInterpretVFloatListOrUid(t *testing.T) {
+	vfs, uid, err := interpretVFloatListOrUid("[[0.1, 0.2], [0.3, 0.4]]")
+	require.NoError(t, err)
+	require.Zero(t, uid)
+	require.Equal(t, [][]float32{{0.1, 0.2}, {0.3, 0.4}}, vfs)
+
+	vfs, _, err = interpretVFloatListOrUid("[0.1, 0.2]")
+	require.NoError(t, err)
+	require.Equal(t, [][]float32{{0.1, 0.2}}, vfs)
+
+	vfs, uid, err = interpretVFloatListOrUid("0x10")
+	require.NoError(t, err)
+	require.Nil(t, vfs)
+	require.Equal(t, uint64(0x10), uid)
+
+	_, _, err = interpretVFloatListOrUid("[[0.1], x]")
+	require.Error(t, err)
+}
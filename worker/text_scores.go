@@ -0,0 +1,195 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgraph/v24/algo"
+	"github.com/dgraph-io/dgraph/v24/posting"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/tok"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+const (
+	// bm25K1 and bm25B are the usual term frequency saturation and document
+	// length normalization parameters of BM25.
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// textStatsRefresh is how long the number of documents and their average
+// length are reused for a predicate before they are counted again. They are
+// collection-wide statistics, which change slowly compared to the matches.
+var textStatsRefresh = time.Minute
+
+// textCollection holds the collection statistics of a full-text predicate.
+type textCollection struct {
+	numDocs  int
+	totalLen int
+	at       time.Time
+}
+
+var textCollections = struct {
+	sync.Mutex
+	m map[string]textCollection
+}{m: make(map[string]textCollection)}
+
+// textCollectionStats returns the number of nodes with a value for the
+// full-text predicate attr and the total number of tokens of their values,
+// counted from the term counts kept next to the index (see
+// posting.TextStatsKey) and cached for textStatsRefresh.
+func textCollectionStats(ctx context.Context, attr string, readTs uint64) (int, int, error) {
+	textCollections.Lock()
+	c, ok := textCollections.m[attr]
+	textCollections.Unlock()
+	if ok && time.Since(c.at) < textStatsRefresh {
+		return c.numDocs, c.totalLen, nil
+	}
+
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.PrefetchValues = false
+	iterOpts.Prefix = x.IndexKey(attr, tok.FullTextStatsPrefix)
+	itr := txn.NewIterator(iterOpts)
+	defer itr.Close()
+
+	c = textCollection{at: time.Now()}
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		if err := ctx.Err(); err != nil {
+			return 0, 0, err
+		}
+		length, err := textStatsLength(itr.Item().KeyCopy(nil), readTs)
+		if err != nil {
+			return 0, 0, err
+		}
+		if length > 0 {
+			c.numDocs++
+			c.totalLen += length
+		}
+	}
+
+	textCollections.Lock()
+	textCollections.m[attr] = c
+	textCollections.Unlock()
+	return c.numDocs, c.totalLen, nil
+}
+
+// textStatsLength returns the number of tokens kept under the term counts key, or 0 if the
+// node has no value anymore.
+func textStatsLength(key []byte, readTs uint64) (int, error) {
+	pl, err := posting.GetNoStore(key, readTs)
+	if err != nil {
+		return 0, err
+	}
+	vals, err := pl.AllValues(readTs)
+	if err != nil || len(vals) == 0 {
+		return 0, err
+	}
+	return posting.TextStatsLength(vals[0].Value.([]byte))
+}
+
+// textScores scores the nodes matched by the full-text function of q with Okapi BM25. The
+// inverse document frequency of each term comes from the length of its index list and the
+// document lengths are compared with the average over the whole predicate, so the score of
+// a node doesn't depend on the other matches. The term counts of each match are read from
+// the key written along with the index instead of tokenizing its values again. A node
+// indexed before the counts were kept scores as if it held each of its matched terms once
+// and had an average length, until the index is rebuilt.
+func (qs *queryState) textScores(ctx context.Context, q *pb.Query, srcFn *functionContext,
+	out *pb.Result) ([]float64, error) {
+	var matches *pb.List
+	if out.IntersectDest {
+		matches = algo.IntersectSorted(out.UidMatrix)
+	} else {
+		matches = algo.MergeSorted(out.UidMatrix)
+	}
+	if len(matches.GetUids()) == 0 {
+		return nil, nil
+	}
+
+	numDocs, totalLen, err := textCollectionStats(ctx, q.Attr, q.ReadTs)
+	if err != nil {
+		return nil, err
+	}
+	docFreqs := make([]int, len(srcFn.tokens))
+	for i, token := range srcFn.tokens {
+		pl, err := qs.cache.Get(x.IndexKey(q.Attr, token))
+		if err != nil {
+			return nil, err
+		}
+		docFreqs[i] = pl.Length(q.ReadTs, 0)
+	}
+	// The statistics can lag behind the index, which shouldn't make a term
+	// more frequent than the documents.
+	for _, df := range docFreqs {
+		numDocs = max(numDocs, df)
+	}
+	avgLen := 1.0
+	if numDocs > 0 && totalLen > 0 {
+		avgLen = float64(totalLen) / float64(numDocs)
+	}
+
+	scores := make([]float64, len(matches.Uids))
+	for i, uid := range matches.Uids {
+		pl, err := qs.cache.Get(posting.TextStatsKey(q.Attr, uid))
+		if err != nil {
+			return nil, err
+		}
+		vals, err := pl.AllValues(q.ReadTs)
+		if err != nil {
+			return nil, err
+		}
+		var counts map[string]int
+		length := avgLen
+		if len(vals) > 0 {
+			c, n, err := posting.DecodeTextStats(vals[0].Value.([]byte))
+			if err != nil {
+				return nil, err
+			}
+			counts, length = c, float64(n)
+		}
+		for j, token := range srcFn.tokens {
+			var tf int
+			switch {
+			case counts != nil:
+				tf = counts[token]
+			case j < len(out.UidMatrix) && algo.IndexOf(out.UidMatrix[j], uid) >= 0:
+				tf = 1
+			}
+			if tf == 0 {
+				continue
+			}
+			scores[i] += bm25(tf, length, docFreqs[j], numDocs, avgLen)
+		}
+	}
+	return scores, nil
+}
+
+// bm25 returns the BM25 score of a term occurring tf times in a document of the given
+// length, when df of the numDocs documents hold the term and their average length is avgLen.
+func bm25(tf int, length float64, df, numDocs int, avgLen float64) float64 {
+	idf := math.Log(1 + (float64(numDocs-df)+0.5)/(float64(df)+0.5))
+	norm := 1 - bm25B + bm25B*length/avgLen
+	return idf * float64(tf) * (bm25K1 + 1) / (float64(tf) + bm25K1*norm)
+}
@@ -0,0 +1,35 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBM25(t *testing.T) {
+	// A rarer term scores more.
+	require.Greater(t, bm25(1, 2, 10, 1000, 5), bm25(1, 2, 100, 1000, 5))
+	// Repeated terms saturate instead of adding up.
+	require.Greater(t, bm25(3, 5, 10, 1000, 5), bm25(1, 5, 10, 1000, 5))
+	require.Less(t, bm25(3, 5, 10, 1000, 5), 3*bm25(1, 5, 10, 1000, 5))
+	// Longer documents score less for the same term frequency.
+	require.Greater(t, bm25(1, 2, 10, 1000, 5), bm25(1, 20, 10, 1000, 5))
+	// A term held by every document still scores a little.
+	require.Greater(t, bm25(1, 5, 1000, 1000, 5), 0.0)
+}
@@ -0,0 +1,390 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgo/v230/protos/api"
+	"github.com/dgraph-io/dgraph/v24/posting"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/schema"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+// The values of a predicate with @ttl expire ttl after the commit that last changed their
+// key. Any write to a list, even adding a single value, refreshes the expiry of all the
+// values of the list, which then expire together. The commit timestamps are logical, so they
+// are compared with the timestamps Zero marks in its state along with the wall clock, see
+// TimestampAt. Every Alpha looks up in the background the commit timestamp up to which the
+// values have expired, and keeps the ones it found along with the timestamps assigned when it
+// did, so that a read as of an earlier time hides the values that had expired by then. The
+// expired values are hidden from the reads of the predicate, the lookups of its index and
+// reverse edges and the counts of its reverse edges, until the leader of the group deletes
+// them. The count index of the reverse edges still counts them until then.
+
+var (
+	// ttlSweepInterval is how often the leader of a group looks for expired values of the
+	// predicates with @ttl.
+	ttlSweepInterval = time.Minute
+	// ttlSweepBatch is the maximum number of nodes whose values are deleted in a transaction.
+	ttlSweepBatch = 1000
+	// ttlCutoffRefresh is how often the commit timestamps up to which the values have expired
+	// are looked up, which delays the expiry by as much.
+	ttlCutoffRefresh = 10 * time.Second
+)
+
+// ttlMinAge is the minimum age of the values that can expire, whatever their ttl. Zero only
+// marks the timestamps every few seconds, so it can't tell which of the latest commits
+// happened before a time within that lag.
+const ttlMinAge = time.Minute
+
+// maxTTLCutoffs bounds the number of cutoffs kept by ttl. Once reached, every other cutoff
+// of the older half is forgotten, so the reads as of an old time hide the values which had
+// expired a while before it.
+const maxTTLCutoffs = 1 << 10
+
+// ttlCutoff is the commit timestamp ts up to which the values with a ttl had expired by the
+// time the timestamps up to readTs were assigned.
+type ttlCutoff struct {
+	readTs uint64
+	ts     uint64
+}
+
+// ttlCutoffCache holds the cutoffs found for every ttl, in order.
+type ttlCutoffCache struct {
+	sync.RWMutex
+	m map[time.Duration][]ttlCutoff
+}
+
+var ttlCutoffs = &ttlCutoffCache{m: make(map[time.Duration][]ttlCutoff)}
+
+// get returns the commit timestamp up to which the values with the given ttl have expired
+// for a read at readTs, or 0 if none of them have or it isn't known yet. That's the latest
+// cutoff found before readTs was assigned.
+func (c *ttlCutoffCache) get(ttl time.Duration, readTs uint64) uint64 {
+	c.RLock()
+	defer c.RUnlock()
+	cutoffs := c.m[ttl]
+	i := sort.Search(len(cutoffs), func(i int) bool { return cutoffs[i].readTs > readTs })
+	if i == 0 {
+		return 0
+	}
+	return cutoffs[i-1].ts
+}
+
+// refresh looks up the commit timestamps up to which the values with the given ttls have
+// expired at now, using timestampAt to find the timestamp assigned at a time, and keeps them
+// for the reads at readTs and after. readTs must be the max timestamp assigned at now.
+func (c *ttlCutoffCache) refresh(ttls []time.Duration, now time.Time, readTs uint64,
+	timestampAt func(t time.Time) (uint64, error)) {
+	for _, ttl := range ttls {
+		age := ttl
+		if age < ttlMinAge {
+			age = ttlMinAge
+		}
+		// Zero doesn't know the timestamps that far back if the cluster is younger than that,
+		// in which case nothing has expired yet.
+		ts, err := timestampAt(now.Add(-age))
+		if err != nil {
+			glog.V(2).Infof("Unable to get the timestamp %s ago: %v", age, err)
+			continue
+		}
+		c.add(ttl, ttlCutoff{readTs: readTs, ts: ts})
+	}
+}
+
+// add appends cutoff to the cutoffs of ttl, unless it doesn't come after the last one.
+func (c *ttlCutoffCache) add(ttl time.Duration, cutoff ttlCutoff) {
+	c.Lock()
+	defer c.Unlock()
+	cutoffs := c.m[ttl]
+	if n := len(cutoffs); n > 0 {
+		last := cutoffs[n-1]
+		if cutoff.ts <= last.ts || cutoff.readTs < last.readTs {
+			return
+		}
+	}
+	if len(cutoffs) >= maxTTLCutoffs {
+		half := len(cutoffs) / 2
+		kept := cutoffs[:0]
+		for i, cut := range cutoffs {
+			if i >= half || i%2 == 0 {
+				kept = append(kept, cut)
+			}
+		}
+		cutoffs = kept
+	}
+	c.m[ttl] = append(cutoffs, cutoff)
+}
+
+// ttlExpiredTs returns the commit timestamp up to which the values of attr have expired for
+// a read at readTs if it has a @ttl, or 0.
+func ttlExpiredTs(ctx context.Context, attr string, readTs uint64) uint64 {
+	su, ok := schema.State().Get(ctx, attr)
+	if !ok || su.Ttl == 0 {
+		return 0
+	}
+	return ttlCutoffs.get(time.Duration(su.Ttl)*time.Second, readTs)
+}
+
+// hideExpired returns pl, the list of the data key key, or an empty list if its values have
+// expired as of expiredTs, see ttlExpiredTs.
+func hideExpired(pl *posting.List, key []byte, readTs, expiredTs uint64) *posting.List {
+	if expiredTs == 0 || pl.CommitTs(readTs) > expiredTs {
+		return pl
+	}
+	return posting.NewList(key, new(pb.PostingList), 0)
+}
+
+// unexpired returns the nodes of uids whose values of attr haven't expired as of expiredTs,
+// for the lookups that find the nodes through the index or the reverse edges of attr. keep
+// is called with the positions of the nodes that are kept.
+func (qs *queryState) unexpired(attr string, readTs, expiredTs uint64, uids []uint64,
+	keep func(i int)) ([]uint64, error) {
+	out := make([]uint64, 0, len(uids))
+	for i, uid := range uids {
+		pl, err := qs.cache.Get(x.DataKey(attr, uid))
+		if err != nil {
+			return nil, err
+		}
+		if pl.CommitTs(readTs) > expiredTs {
+			out = append(out, uid)
+			if keep != nil {
+				keep(i)
+			}
+		}
+	}
+	return out, nil
+}
+
+// dropExpired removes from the uid lists of out the nodes whose values of attr have expired
+// as of expiredTs, along with their facets and vector distances.
+func (qs *queryState) dropExpired(out *pb.Result, attr string, readTs, expiredTs uint64) error {
+	for i, list := range out.UidMatrix {
+		var facets *pb.FacetsList
+		if i < len(out.FacetMatrix) && len(out.FacetMatrix[i].GetFacetsList()) == len(list.Uids) {
+			facets = out.FacetMatrix[i]
+		}
+		withDistances := i == 0 && len(out.VectorDistances) == len(list.Uids)
+		var keptFacets []*pb.Facets
+		var keptDistances []float64
+		uids, err := qs.unexpired(attr, readTs, expiredTs, list.Uids, func(j int) {
+			if facets != nil {
+				keptFacets = append(keptFacets, facets.FacetsList[j])
+			}
+			if withDistances {
+				keptDistances = append(keptDistances, out.VectorDistances[j])
+			}
+		})
+		if err != nil {
+			return err
+		}
+		list.Uids = uids
+		if facets != nil {
+			facets.FacetsList = keptFacets
+		}
+		if withDistances {
+			out.VectorDistances = keptDistances
+		}
+	}
+	return nil
+}
+
+// trackTTL looks up, every ttlCutoffRefresh, the commit timestamps up to which the values of
+// the predicates with @ttl served by the group have expired, see ttlCutoffCache.
+func (g *groupi) trackTTL() {
+	defer func() {
+		glog.Infoln("Closing trackTTL")
+		g.closer.Done() // CLOSER:1
+	}()
+
+	ticker := time.NewTicker(ttlCutoffRefresh)
+	defer ticker.Stop()
+	for {
+		seen := make(map[time.Duration]bool)
+		var ttls []time.Duration
+		ctx := context.WithValue(g.Ctx(), schema.IsWrite, false)
+		for _, pred := range ttlPredicates(g.groupId()) {
+			su, _ := schema.State().Get(ctx, pred)
+			if ttl := time.Duration(su.Ttl) * time.Second; !seen[ttl] {
+				seen[ttl] = true
+				ttls = append(ttls, ttl)
+			}
+		}
+		if len(ttls) > 0 {
+			zctx, cancel := context.WithTimeout(g.Ctx(), 10*time.Second)
+			ttlCutoffs.refresh(ttls, time.Now(), posting.Oracle().MaxAssigned(),
+				func(t time.Time) (uint64, error) { return TimestampAt(zctx, t) })
+			cancel()
+		}
+
+		select {
+		case <-g.closer.HasBeenClosed():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ttlPredicates returns the predicates with @ttl served by the group gid.
+func ttlPredicates(gid uint32) []string {
+	ctx := context.WithValue(context.Background(), schema.IsWrite, false)
+	var preds []string
+	for _, pred := range schema.State().Predicates() {
+		su, ok := schema.State().Get(ctx, pred)
+		if !ok || su.Ttl == 0 {
+			continue
+		}
+		// Only the tablets known locally are checked, as asking Zero could block the sweeper.
+		g := groups()
+		g.RLock()
+		tablet := g.tablets[pred]
+		g.RUnlock()
+		if tablet.GetGroupId() != gid {
+			continue
+		}
+		preds = append(preds, pred)
+	}
+	return preds
+}
+
+// processTTL deletes, on the leader, the values of the predicates with @ttl that have expired.
+// Index, reverse and count keys are cleaned up along with the values, as the deletes are
+// applied like any other mutation.
+func (n *node) processTTL() {
+	defer n.closer.Done() // CLOSER:1
+	tick := time.NewTicker(ttlSweepInterval)
+	defer tick.Stop()
+
+	for {
+		if n.AmLeader() {
+			for _, pred := range ttlPredicates(n.gid) {
+				// The values are deleted as of now, by the latest cutoff.
+				cutoff := ttlExpiredTs(n.closer.Ctx(), pred, math.MaxUint64)
+				if cutoff == 0 {
+					continue
+				}
+				if err := n.sweepExpired(pred, cutoff); err != nil {
+					glog.Errorf("Error while deleting expired values of %s: %v", pred, err)
+				}
+			}
+		}
+
+		select {
+		case <-n.closer.HasBeenClosed():
+			return
+		case <-tick.C:
+		}
+	}
+}
+
+// sweepExpired deletes the values of pred that haven't changed since the commit timestamp
+// cutoff. Each batch is deleted in its own transaction, which aborts if one of its values
+// changes in the meantime, leaving the value for a later sweep.
+func (n *node) sweepExpired(pred string, cutoff uint64) error {
+	ctx := n.closer.Ctx()
+	prefix := x.ParsedKey{Attr: pred}.DataPrefix()
+	seek := prefix
+	for seek != nil {
+		ts, err := Timestamps(ctx, &pb.Num{Val: 1})
+		if err != nil {
+			return err
+		}
+		startTs := ts.StartId
+		if err := posting.Oracle().WaitForTs(ctx, startTs); err != nil {
+			return err
+		}
+
+		var edges []*pb.DirectedEdge
+		if edges, seek, err = expiredEdges(pred, prefix, seek, startTs, cutoff); err != nil {
+			return err
+		}
+		if len(edges) == 0 {
+			return nil
+		}
+
+		m := &pb.Mutations{GroupId: n.gid, StartTs: startTs, Edges: edges, Expired: true}
+		tctx := &api.TxnContext{StartTs: startTs}
+		if err := (&grpcWorker{}).proposeAndWait(ctx, tctx, m); err != nil {
+			tctx.Aborted = true
+			_, _ = CommitOverNetwork(ctx, tctx)
+			return err
+		}
+		if _, err := CommitOverNetwork(ctx, tctx); err != nil {
+			glog.V(2).Infof("Couldn't delete %d expired values of %s: %v", len(edges), pred, err)
+			continue
+		}
+		glog.V(2).Infof("Deleted %d expired values of %s", len(edges), pred)
+	}
+	return nil
+}
+
+// expiredEdges returns the edges deleting the values of pred that haven't changed since the
+// commit timestamp cutoff, reading the data keys from seek at readTs. At most ttlSweepBatch
+// edges are returned, along with the key to continue from, or nil if there are no more keys.
+func expiredEdges(pred string, prefix, seek []byte, readTs, cutoff uint64) (
+	[]*pb.DirectedEdge, []byte, error) {
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	iterOpts := badger.DefaultIteratorOptions
+	iterOpts.PrefetchValues = false
+	iterOpts.Prefix = prefix
+	itr := txn.NewIterator(iterOpts)
+	defer itr.Close()
+
+	var edges []*pb.DirectedEdge
+	for itr.Seek(seek); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		if item.Version() > cutoff {
+			continue
+		}
+		key := item.KeyCopy(nil)
+		if len(edges) == ttlSweepBatch {
+			return edges, key, nil
+		}
+		pk, err := x.Parse(key)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "while parsing key %x", key)
+		}
+		pl, err := posting.GetNoStore(key, readTs)
+		if err != nil {
+			return nil, nil, err
+		}
+		if empty, err := pl.IsEmpty(readTs, 0); err != nil {
+			return nil, nil, err
+		} else if empty {
+			continue
+		}
+		edges = append(edges, &pb.DirectedEdge{
+			Entity: pk.Uid,
+			Attr:   pred,
+			Value:  []byte(x.Star),
+			Op:     pb.DirectedEdge_DEL,
+		})
+	}
+	return edges, nil, nil
+}
@@ -0,0 +1,70 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTTLCutoffCache(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var asked []time.Time
+	timestampAt := func(at time.Time) (uint64, error) {
+		asked = append(asked, at)
+		if at.Before(now.Add(-time.Hour)) {
+			return 0, errors.New("no timestamp known")
+		}
+		return uint64(at.Sub(now.Add(-time.Hour)) / time.Second), nil
+	}
+	c := &ttlCutoffCache{m: make(map[time.Duration][]ttlCutoff)}
+	ttls := []time.Duration{10 * time.Minute, 2 * time.Hour, time.Second}
+
+	c.refresh(ttls, now, 100, timestampAt)
+	require.Equal(t, []time.Time{now.Add(-10 * time.Minute), now.Add(-2 * time.Hour),
+		now.Add(-ttlMinAge)}, asked)
+	require.EqualValues(t, 50*60, c.get(10*time.Minute, 100))
+	require.EqualValues(t, 50*60, c.get(10*time.Minute, 1000))
+	// Nothing has expired for the reads before the cutoffs were found.
+	require.Zero(t, c.get(10*time.Minute, 99))
+	// Nor if Zero doesn't know the timestamps that far back.
+	require.Zero(t, c.get(2*time.Hour, 100))
+	// The latest commits can't expire.
+	require.EqualValues(t, 59*60, c.get(time.Second, 100))
+
+	// The reads as of an earlier time use the cutoffs found back then.
+	c.refresh(ttls, now.Add(20*time.Second), 200, timestampAt)
+	require.EqualValues(t, 50*60, c.get(10*time.Minute, 150))
+	require.EqualValues(t, 50*60+20, c.get(10*time.Minute, 200))
+
+	// The older cutoffs are thinned out.
+	c = &ttlCutoffCache{m: make(map[time.Duration][]ttlCutoff)}
+	for i := uint64(1); i <= maxTTLCutoffs+1; i++ {
+		c.add(time.Minute, ttlCutoff{readTs: 10 * i, ts: i})
+	}
+	require.Len(t, c.m[time.Minute], maxTTLCutoffs-maxTTLCutoffs/4+1)
+	require.EqualValues(t, 1, c.get(time.Minute, 25))
+	require.EqualValues(t, 3, c.get(time.Minute, 35))
+	require.EqualValues(t, maxTTLCutoffs+1, c.get(time.Minute, math.MaxUint64))
+	// A cutoff that doesn't come after the last one is ignored.
+	c.add(time.Minute, ttlCutoff{readTs: 10 * (maxTTLCutoffs + 2), ts: 1})
+	require.EqualValues(t, maxTTLCutoffs+1, c.get(time.Minute, math.MaxUint64))
+}
@@ -0,0 +1,118 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dgraph-io/dgraph/v24/algo"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+)
+
+// vectorFilter checks the filter tree of a similar_to function against the
+// nodes a vector search comes across, so that the search only returns nodes
+// which pass the filters. Every node is checked once, by running the tasks of
+// the leaves with the node as their uid list, like the filters of a query do
+// for the uids of their parent.
+type vectorFilter struct {
+	ctx  context.Context
+	tree *pb.VectorFilter
+	seen map[uint64]bool
+	// err is the first error met by a task. The nodes checked after it are
+	// rejected, and the search reports it.
+	err error
+}
+
+// newVectorFilter returns the vectorFilter of tree, or nil if tree is nil.
+func newVectorFilter(ctx context.Context, tree *pb.VectorFilter) *vectorFilter {
+	if tree == nil {
+		return nil
+	}
+	return &vectorFilter{ctx: ctx, tree: tree, seen: make(map[uint64]bool)}
+}
+
+// accept is the index.SearchFilter of vf.
+func (vf *vectorFilter) accept(_, _ []float32, uid uint64) bool {
+	if vf.err != nil {
+		return false
+	}
+	if ok, found := vf.seen[uid]; found {
+		return ok
+	}
+	ok, err := vf.eval(vf.tree, uid)
+	if err != nil {
+		vf.err = err
+		return false
+	}
+	vf.seen[uid] = ok
+	return ok
+}
+
+// filter returns the uids which pass vf.
+func (vf *vectorFilter) filter(uids []uint64) ([]uint64, error) {
+	out := make([]uint64, 0, len(uids))
+	for _, uid := range uids {
+		if vf.accept(nil, nil, uid) {
+			out = append(out, uid)
+		}
+	}
+	return out, vf.err
+}
+
+func (vf *vectorFilter) eval(f *pb.VectorFilter, uid uint64) (bool, error) {
+	switch f.Op {
+	case "and":
+		for _, child := range f.Children {
+			if ok, err := vf.eval(child, uid); err != nil || !ok {
+				return false, err
+			}
+		}
+		return true, nil
+	case "or":
+		for _, child := range f.Children {
+			if ok, err := vf.eval(child, uid); err != nil || ok {
+				return ok, err
+			}
+		}
+		return false, nil
+	case "not":
+		if len(f.Children) != 1 {
+			return false, nil
+		}
+		ok, err := vf.eval(f.Children[0], uid)
+		return !ok && err == nil, err
+	}
+
+	if f.Query == nil {
+		return algo.IndexOf(f.Uids, uid) >= 0, nil
+	}
+	q := *f.Query
+	q.UidList = &pb.List{Uids: []uint64{uid}}
+	result, err := ProcessTaskOverNetwork(vf.ctx, &q)
+	switch {
+	case err != nil && strings.Contains(err.Error(), ErrNonExistentTabletMessage):
+		// Nothing can match a predicate which doesn't exist.
+		return false, nil
+	case err != nil:
+		return false, err
+	case result.IntersectDest:
+		return algo.IndexOf(algo.IntersectSorted(result.UidMatrix), uid) >= 0, nil
+	default:
+		return algo.IndexOf(algo.MergeSorted(result.UidMatrix), uid) >= 0, nil
+	}
+}
@@ -0,0 +1,103 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/tok/index"
+)
+
+// widthTestIndex holds the uids 1 to n, the nearest first. Like a traversal
+// bounded by its width, a search only comes across twice as many nodes as
+// the neighbors it asks for.
+type widthTestIndex struct {
+	index.VectorIndex[float32]
+	n      uint64
+	widths []int
+}
+
+func (w *widthTestIndex) SearchWithPath(_ context.Context, _ index.CacheType, query []float32,
+	maxResults int, filter index.SearchFilter[float32]) (*index.SearchPathResult, error) {
+	w.widths = append(w.widths, maxResults)
+	res := index.NewSearchPathResult()
+	for uid := uint64(1); uid <= w.n && uid <= uint64(2*maxResults); uid++ {
+		if len(res.Neighbors) < maxResults && filter(query, nil, uid) {
+			res.Neighbors = append(res.Neighbors, uid)
+			res.Distances = append(res.Distances, float64(uid))
+		}
+	}
+	return res, nil
+}
+
+func TestVectorFilter(t *testing.T) {
+	uids := func(uids ...uint64) *pb.VectorFilter {
+		return &pb.VectorFilter{Uids: &pb.List{Uids: uids}}
+	}
+	vf := newVectorFilter(context.Background(), &pb.VectorFilter{Op: "and", Children: []*pb.VectorFilter{
+		{Op: "or", Children: []*pb.VectorFilter{uids(1, 2), uids(5)}},
+		{Op: "not", Children: []*pb.VectorFilter{uids(2)}},
+	}})
+	accepted, err := vf.filter([]uint64{1, 2, 3, 4, 5})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 5}, accepted)
+
+	require.Nil(t, newVectorFilter(context.Background(), nil))
+	accepted, err = newVectorFilter(context.Background(), &pb.VectorFilter{Op: "or"}).
+		filter([]uint64{1, 2})
+	require.NoError(t, err)
+	require.Empty(t, accepted)
+}
+
+func TestSearchVectorIndexWidening(t *testing.T) {
+	ctx := context.Background()
+	srcFn := &functionContext{vectorInfo: []float32{0}}
+	var multiples []uint64
+	for uid := uint64(100); uid <= 10000; uid += 100 {
+		multiples = append(multiples, uid)
+	}
+
+	// Only 1 in 100 nodes passes the filter, the search is widened until it
+	// comes across 3 of them.
+	indexer := &widthTestIndex{n: 100000}
+	vf := newVectorFilter(ctx, &pb.VectorFilter{Uids: &pb.List{Uids: multiples}})
+	res, err := searchVectorIndex(ctx, indexer, nil, srcFn, nil, vf, 3)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{100, 200, 300}, res.Neighbors)
+	require.Equal(t, []int{3, 12, 48, 192}, indexer.widths)
+
+	// The search isn't widened forever when too few nodes pass the filter.
+	indexer = &widthTestIndex{n: 100000}
+	vf = newVectorFilter(ctx, &pb.VectorFilter{Uids: &pb.List{Uids: []uint64{99999}}})
+	res, err = searchVectorIndex(ctx, indexer, nil, srcFn, nil, vf, 3)
+	require.NoError(t, err)
+	require.Empty(t, res.Neighbors)
+	last := len(indexer.widths) - 1
+	require.GreaterOrEqual(t, indexer.widths[last], maxVectorSearchWidth)
+	require.Less(t, indexer.widths[last-1], maxVectorSearchWidth)
+
+	// Unrestricted searches are run once.
+	indexer = &widthTestIndex{n: 2}
+	res, err = searchVectorIndex(ctx, indexer, nil, srcFn, nil, nil, 3)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2}, res.Neighbors)
+	require.Equal(t, []int{3}, indexer.widths)
+}
@@ -0,0 +1,336 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/dgraph/v24/posting"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/tok/hnsw"
+	"github.com/dgraph-io/dgraph/v24/tok/index"
+	"github.com/dgraph-io/dgraph/v24/x"
+)
+
+// defaultRecallK is the number of neighbors compared when measuring the
+// recall of a vector index, if the request does not set one.
+const defaultRecallK = 10
+
+var (
+	// maxRecallSample caps the number of queries used to measure the recall
+	// of a vector index.
+	maxRecallSample = 100
+	// maxRecallComparisons caps the number of vectors compared by brute force
+	// over all the recall queries. Every query is compared with all the indexed
+	// vectors, so larger indexes are measured with fewer queries, and not at
+	// all when a single query would go over it.
+	maxRecallComparisons = 100 * 100000
+	// recallChunk is the number of vectors compared at once with a recall query.
+	recallChunk = 100000
+)
+
+// graphStatser is implemented by the vector indexes that are stored as a
+// graph, see hnsw.GraphStats.
+type graphStatser interface {
+	GraphStats(c index.CacheType, uids []uint64) (hnsw.GraphStats, error)
+}
+
+// VectorIndexStatsOverNetwork computes the statistics of the vector index of
+// req.Predicate in the group serving the predicate.
+func VectorIndexStatsOverNetwork(ctx context.Context,
+	req *pb.VectorIndexStatsRequest) (*pb.VectorIndexStatsResponse, error) {
+	if req.ReadTs == 0 {
+		req.ReadTs = posting.Oracle().MaxAssigned()
+	}
+	gid, err := groups().BelongsToReadOnly(req.Predicate, req.ReadTs)
+	switch {
+	case err != nil:
+		return nil, err
+	case gid == 0:
+		return nil, errNonExistentTablet
+	}
+
+	if groups().ServesGroup(gid) {
+		return vectorIndexStats(ctx, req)
+	}
+
+	result, err := processWithBackupRequest(ctx, gid,
+		func(ctx context.Context, c pb.WorkerClient) (interface{}, error) {
+			return c.VectorIndexStats(ctx, req)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.VectorIndexStatsResponse), nil
+}
+
+// VectorIndexStats computes the statistics of a vector index served by this
+// group.
+func (w *grpcWorker) VectorIndexStats(ctx context.Context,
+	req *pb.VectorIndexStatsRequest) (*pb.VectorIndexStatsResponse, error) {
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if err := x.HealthCheck(); err != nil {
+		return nil, err
+	}
+
+	gid, err := groups().BelongsToReadOnly(req.Predicate, req.ReadTs)
+	switch {
+	case err != nil:
+		return nil, err
+	case gid == 0:
+		return nil, errNonExistentTablet
+	case !groups().ServesGroup(gid):
+		return nil, errUnservedTablet
+	}
+	return vectorIndexStats(ctx, req)
+}
+
+func vectorIndexStats(ctx context.Context,
+	req *pb.VectorIndexStatsRequest) (*pb.VectorIndexStatsResponse, error) {
+	if err := posting.Oracle().WaitForTs(ctx, req.ReadTs); err != nil {
+		return nil, err
+	}
+	cspec, err := pickFactoryCreateSpec(ctx, req.Predicate)
+	if err != nil {
+		return nil, err
+	}
+	indexer, err := cspec.CreateIndex(req.Predicate)
+	if err != nil {
+		return nil, err
+	}
+
+	qs := queryState{cache: posting.NoCache(req.ReadTs)}
+	qc := hnsw.NewQueryCache(posting.NewViLocalCache(qs.cache), req.ReadTs)
+
+	// The index holds the vectors of the predicate itself, or a vector per
+	// id of VecMulti for a multi-vector predicate.
+	vecAttr := req.Predicate
+	if cspec.IsMultiVector() {
+		vecAttr = hnsw.ConcatStrings(req.Predicate, hnsw.VecMulti)
+	}
+	all := &pb.Result{}
+	hasQuery := &pb.Query{Attr: vecAttr, ReadTs: req.ReadTs, First: math.MaxInt32}
+	if err := qs.handleHasFunction(ctx, hasQuery, all, &functionContext{fnType: hasFn}); err != nil {
+		return nil, err
+	}
+	ids := all.UidMatrix[0].GetUids()
+
+	stats := &pb.VectorIndexStatsResponse{
+		Predicate: req.Predicate,
+		Index:     cspec.Name(),
+		Vectors:   uint64(len(ids)),
+	}
+	if indexed, ok := posting.VectorIndexBuildProgress(req.Predicate); ok {
+		// The build goes over the nodes having a value for the predicate,
+		// which for a multi-vector predicate aren't the ids of its vectors.
+		nodes := len(ids)
+		if cspec.IsMultiVector() {
+			hasQuery.Attr = req.Predicate
+			all = &pb.Result{}
+			err := qs.handleHasFunction(ctx, hasQuery, all, &functionContext{fnType: hasFn})
+			if err != nil {
+				return nil, err
+			}
+			nodes = len(all.UidMatrix[0].GetUids())
+		}
+		stats.Building = true
+		stats.BuildProgress = 1
+		if nodes > 0 {
+			stats.BuildProgress = math.Min(1, float64(indexed)/float64(nodes))
+		}
+		return stats, nil
+	}
+	if len(ids) == 0 {
+		return stats, nil
+	}
+
+	vecBytes, err := vectorSize(qs.cache, vecAttr, ids[0], req.ReadTs)
+	if err != nil {
+		return nil, err
+	}
+	stats.EstimatedMemoryBytes = stats.Vectors * vecBytes
+	if g, ok := indexer.(graphStatser); ok {
+		graph, err := g.GraphStats(qc, ids)
+		if err != nil {
+			return nil, err
+		}
+		stats.Layers = uint32(graph.Layers)
+		stats.AvgDegree = graph.AvgDegree
+		// Every edge is stored as the uint64 of the neighbor.
+		stats.EstimatedMemoryBytes += graph.Edges * 8
+	}
+
+	if req.RecallSample == 0 {
+		return stats, nil
+	}
+	stats.K = req.K
+	if stats.K == 0 {
+		stats.K = defaultRecallK
+	}
+	stats.Recall, stats.RecallSample, err = sampleRecall(ctx, indexer, qc, ids,
+		int(req.RecallSample), int(stats.K))
+	if err != nil {
+		return nil, err
+	}
+	if stats.RecallSample > 0 {
+		stats.RecallCandidates = uint32(len(ids))
+	}
+	return stats, nil
+}
+
+// vectorSize returns the number of bytes taken by the vector of uid.
+func vectorSize(cache *posting.LocalCache, attr string, uid, readTs uint64) (uint64, error) {
+	pl, err := cache.Get(x.DataKey(attr, uid))
+	if err != nil {
+		return 0, err
+	}
+	val, err := pl.Value(readTs)
+	if err != nil {
+		return 0, err
+	}
+	data, ok := val.Value.([]byte)
+	if !ok {
+		return 0, errors.Errorf("Value of %s for %#x is not a vector", x.ParseAttr(attr), uid)
+	}
+	return uint64(len(data)), nil
+}
+
+// sampleRecall measures the recall@k of indexer, using (at most) sample of
+// the indexed ids, spread evenly over ids, as queries. The nearest neighbors
+// found by the index are compared with the ones of a brute force search over
+// all the ids. It returns the average fraction of the exact neighbors found, and
+// the number of queries used. The queries are capped by maxRecallSample and by
+// maxRecallComparisons, no query is used when the recall isn't measured.
+func sampleRecall(ctx context.Context, indexer index.VectorIndex[float32], qc index.CacheType,
+	ids []uint64, sample, k int) (float64, uint32, error) {
+	if len(ids) == 0 {
+		return 0, 0, nil
+	}
+	if sample > maxRecallSample {
+		sample = maxRecallSample
+	}
+	if budget := maxRecallComparisons / len(ids); sample > budget {
+		sample = budget
+	}
+
+	var total float64
+	var queries uint32
+	for _, id := range spread(ids, sample) {
+		if err := ctx.Err(); err != nil {
+			return 0, 0, err
+		}
+		exact, err := exactNeighbors(ctx, indexer, qc, id, ids, k)
+		if err != nil {
+			return 0, 0, err
+		}
+		if len(exact) == 0 {
+			continue
+		}
+		found, err := indexer.SearchWithUid(ctx, qc, id, k, index.AcceptAll[float32])
+		if err != nil {
+			return 0, 0, err
+		}
+		total += recall(found, exact)
+		queries++
+	}
+	if queries == 0 {
+		return 0, 0, nil
+	}
+	return total / float64(queries), queries, nil
+}
+
+// exactNeighbors returns the k nearest neighbors of id among ids, comparing id
+// with recallChunk of them at a time.
+func exactNeighbors(ctx context.Context, indexer index.VectorIndex[float32], qc index.CacheType,
+	id uint64, ids []uint64, k int) ([]uint64, error) {
+	if len(ids) <= recallChunk {
+		res, err := indexer.SearchExactWithUid(ctx, qc, id, ids, k)
+		if err != nil {
+			return nil, err
+		}
+		return res.Neighbors, nil
+	}
+
+	var neighbors []uint64
+	var distances []float64
+	for start := 0; start < len(ids); start += recallChunk {
+		end := start + recallChunk
+		if end > len(ids) {
+			end = len(ids)
+		}
+		res, err := indexer.SearchExactWithUid(ctx, qc, id, ids[start:end], k)
+		if err != nil {
+			return nil, err
+		}
+		if len(res.Distances) != len(res.Neighbors) {
+			return nil, errors.Errorf("Exact search returned %d distances for %d neighbors",
+				len(res.Distances), len(res.Neighbors))
+		}
+		neighbors = append(neighbors, res.Neighbors...)
+		distances = append(distances, res.Distances...)
+	}
+	order := make([]int, len(neighbors))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool { return distances[order[i]] < distances[order[j]] })
+	if len(order) > k {
+		order = order[:k]
+	}
+	out := make([]uint64, 0, len(order))
+	for _, i := range order {
+		out = append(out, neighbors[i])
+	}
+	return out, nil
+}
+
+// spread returns at most n of ids, spread evenly over ids.
+func spread(ids []uint64, n int) []uint64 {
+	if n >= len(ids) {
+		return ids
+	}
+	out := make([]uint64, n)
+	for i := range out {
+		out[i] = ids[i*len(ids)/n]
+	}
+	return out
+}
+
+// recall returns the fraction of the exact neighbors that are in found.
+func recall(found, exact []uint64) float64 {
+	if len(exact) == 0 {
+		return 0
+	}
+	in := make(map[uint64]struct{}, len(exact))
+	for _, uid := range exact {
+		in[uid] = struct{}{}
+	}
+	var hits int
+	for _, uid := range found {
+		if _, ok := in[uid]; ok {
+			hits++
+			delete(in, uid)
+		}
+	}
+	return float64(hits) / float64(len(exact))
+}
@@ -0,0 +1,129 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgraph/v24/tok/index"
+)
+
+// recallTestIndex finds the neighbors in found for every query, while the
+// exact neighbors of a query are the candidates with the closest uids.
+type recallTestIndex struct {
+	index.VectorIndex[float32]
+	found      map[uint64][]uint64
+	queries    []uint64
+	candidates [][]uint64
+}
+
+func (r *recallTestIndex) SearchWithUid(_ context.Context, _ index.CacheType, queryUid uint64,
+	_ int, filter index.SearchFilter[float32]) ([]uint64, error) {
+	var found []uint64
+	for _, uid := range r.found[queryUid] {
+		if filter(nil, nil, uid) {
+			found = append(found, uid)
+		}
+	}
+	return found, nil
+}
+
+func (r *recallTestIndex) SearchExactWithUid(_ context.Context, _ index.CacheType,
+	queryUid uint64, candidates []uint64, k int) (*index.SearchPathResult, error) {
+	if len(r.queries) == 0 || r.queries[len(r.queries)-1] != queryUid {
+		r.queries = append(r.queries, queryUid)
+		r.candidates = nil
+	}
+	r.candidates = append(r.candidates, candidates)
+	dist := func(uid uint64) float64 { return math.Abs(float64(uid) - float64(queryUid)) }
+	sorted := append([]uint64(nil), candidates...)
+	sort.SliceStable(sorted, func(i, j int) bool { return dist(sorted[i]) < dist(sorted[j]) })
+	if len(sorted) > k {
+		sorted = sorted[:k]
+	}
+	res := index.NewSearchPathResult()
+	for _, uid := range sorted {
+		res.Neighbors = append(res.Neighbors, uid)
+		res.Distances = append(res.Distances, dist(uid))
+	}
+	return res, nil
+}
+
+func TestRecall(t *testing.T) {
+	require.Equal(t, 1.0, recall([]uint64{3, 1, 2}, []uint64{1, 2, 3}))
+	require.Equal(t, 0.5, recall([]uint64{1, 4}, []uint64{1, 2}))
+	require.Equal(t, 0.5, recall([]uint64{1, 1}, []uint64{1, 2}))
+	require.Equal(t, 0.0, recall(nil, []uint64{1, 2}))
+	require.Equal(t, 0.0, recall([]uint64{1}, nil))
+}
+
+func TestSampleRecall(t *testing.T) {
+	ids := []uint64{10, 20, 30, 40, 50, 60}
+	indexer := &recallTestIndex{found: map[uint64][]uint64{
+		10: {10, 11},
+		30: {30, 20},
+		50: {98, 99},
+	}}
+	r, queries, err := sampleRecall(context.Background(), indexer, nil, ids, 3, 2)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 30, 50}, indexer.queries)
+	require.Equal(t, uint32(3), queries)
+	require.InDelta(t, 0.5, r, 1e-9)
+
+	// The sample is capped by the number of indexed vectors.
+	indexer.queries = nil
+	_, queries, err = sampleRecall(context.Background(), indexer, nil, ids, 100, 2)
+	require.NoError(t, err)
+	require.Equal(t, ids, indexer.queries)
+	require.Equal(t, uint32(len(ids)), queries)
+}
+
+func TestSampleRecallCaps(t *testing.T) {
+	defer func(sample, comparisons, chunk int) {
+		maxRecallSample, maxRecallComparisons, recallChunk = sample, comparisons, chunk
+	}(maxRecallSample, maxRecallComparisons, recallChunk)
+	maxRecallSample, maxRecallComparisons, recallChunk = 100, 12, 4
+
+	ids := []uint64{10, 20, 30, 40, 50, 60}
+	indexer := &recallTestIndex{found: map[uint64][]uint64{
+		10: {10, 20},
+		40: {40, 50},
+	}}
+	// Only 2 queries fit in the comparisons, and each of them is compared with
+	// all the ids, a chunk at a time.
+	r, queries, err := sampleRecall(context.Background(), indexer, nil, ids, 100, 2)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10, 40}, indexer.queries)
+	require.Equal(t, [][]uint64{{10, 20, 30, 40}, {50, 60}}, indexer.candidates)
+	require.Equal(t, uint32(2), queries)
+	// 30 and 50 are as close to 40, the exact neighbors of 40 are 40 and 30.
+	require.InDelta(t, 0.75, r, 1e-9)
+
+	// The recall isn't measured when a single query goes over the comparisons.
+	maxRecallComparisons = 5
+	indexer.queries = nil
+	r, queries, err = sampleRecall(context.Background(), indexer, nil, ids, 100, 2)
+	require.NoError(t, err)
+	require.Empty(t, indexer.queries)
+	require.Equal(t, uint32(0), queries)
+	require.Zero(t, r)
+}
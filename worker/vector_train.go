@@ -0,0 +1,114 @@
+/*
+ * Copyright 2024 Dgraph Labs, Inc. and Contributors
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+
+	"github.com/dgraph-io/dgo/v230/protos/api"
+	"github.com/dgraph-io/dgraph/v24/posting"
+	"github.com/dgraph-io/dgraph/v24/protos/pb"
+	"github.com/dgraph-io/dgraph/v24/schema"
+)
+
+// vectorTrainInterval is how often the leader of a group looks for vector indexes that have
+// to be trained, like an IVF index that has seen enough vectors to train its clusters.
+var vectorTrainInterval = 10 * time.Second
+
+// vectorPredicates returns the predicates with a vector index served by the group gid.
+func vectorPredicates(gid uint32) []string {
+	ctx := context.WithValue(context.Background(), schema.IsWrite, false)
+	var preds []string
+	for _, pred := range schema.State().Predicates() {
+		su, ok := schema.State().Get(ctx, pred)
+		if !ok || len(su.IndexSpecs) == 0 {
+			continue
+		}
+		// Only the tablets known locally are checked, as asking Zero could block the job.
+		g := groups()
+		g.RLock()
+		tablet := g.tablets[pred]
+		g.RUnlock()
+		if tablet.GetGroupId() != gid {
+			continue
+		}
+		preds = append(preds, pred)
+	}
+	return preds
+}
+
+// processVectorTraining trains, on the leader, the vector indexes that need it. Training runs
+// outside of the transactions inserting the vectors, in steps proposed as their own
+// transactions, see index.Trainer.
+func (n *node) processVectorTraining() {
+	defer n.closer.Done() // CLOSER:1
+	tick := time.NewTicker(vectorTrainInterval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-n.closer.HasBeenClosed():
+			return
+		case <-tick.C:
+		}
+		if !n.AmLeader() {
+			continue
+		}
+		for _, pred := range vectorPredicates(n.gid) {
+			if err := n.trainVectorIndex(pred); err != nil {
+				glog.Errorf("Error while training the vector index of %s: %v", pred, err)
+			}
+		}
+	}
+}
+
+// trainVectorIndex runs the training steps of the vector index of pred until it is trained.
+// A step aborted by a conflicting mutation is left for the next run.
+func (n *node) trainVectorIndex(pred string) error {
+	ctx := n.closer.Ctx()
+	for {
+		ts, err := Timestamps(ctx, &pb.Num{Val: 1})
+		if err != nil {
+			return err
+		}
+		startTs := ts.StartId
+		if err := posting.Oracle().WaitForTs(ctx, startTs); err != nil {
+			return err
+		}
+		needed, err := posting.NeedsVectorIndexTraining(ctx, pred, startTs)
+		if err != nil || !needed {
+			return err
+		}
+
+		m := &pb.Mutations{GroupId: n.gid, StartTs: startTs, TrainVectorIndex: pred}
+		tctx := &api.TxnContext{StartTs: startTs}
+		if err := (&grpcWorker{}).proposeAndWait(ctx, tctx, m); err != nil {
+			tctx.Aborted = true
+			_, _ = CommitOverNetwork(ctx, tctx)
+			return err
+		}
+		if _, err := CommitOverNetwork(ctx, tctx); err != nil {
+			glog.V(2).Infof("Couldn't commit a training step of the vector index of %s: %v",
+				pred, err)
+			return nil
+		}
+		glog.V(2).Infof("Ran a training step of the vector index of %s", pred)
+	}
+}
@@ -42,12 +42,14 @@ type Options struct {
 	// query-timeout duration - Maximum time after which a query execution will fail.
 	// max-retries int64 - maximum number of retries made by dgraph to commit a transaction to disk.
 	// shared-instance bool - if set to true, ACLs will be disabled for non-galaxy users.
+	// history-retention duration - How far back in time queries can read with as-of.
 	Limit                *z.SuperFlag
 	LimitMutationsNquad  int
 	LimitQueryEdge       uint64
 	BlockClusterWideDrop bool
 	LimitNormalizeNode   int
 	QueryTimeout         time.Duration
+	HistoryRetention     time.Duration
 	MaxRetries           int64
 	SharedInstance       bool
 
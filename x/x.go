@@ -140,6 +140,9 @@ const (
 		"Content-Type, Content-Length, Accept-Encoding, Cache-Control, " +
 		"X-CSRF-Token, X-Auth-Token, X-Requested-With"
 	DgraphCostHeader = "Dgraph-TouchedUids"
+	// DgraphProfileTrailer is the gRPC trailer holding the JSON of the plan or the
+	// profile of a query, when asked for with the "explain" or "profile" metadata.
+	DgraphProfileTrailer = "dgraph-profile-bin"
 
 	ManifestVersion = 2105
 )